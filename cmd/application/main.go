@@ -34,6 +34,12 @@ import (
 	attachmentController "assets/modules/attachment/controller"
 	authorizationController "assets/modules/authorization/controller"
 	countryController "assets/modules/country/controller"
+	documentController "assets/modules/document/controller"
+	investmentController "assets/modules/investment/controller"
+	maintenanceController "assets/modules/maintenance/controller"
+	notificationController "assets/modules/notification/controller"
+	reconciliationController "assets/modules/reconciliation/controller"
+	viewController "assets/modules/view/controller"
 	"github.com/gin-gonic/gin"
 )
 
@@ -62,6 +68,8 @@ func main() {
 	util.CheckedRun(server.NewHttpServer(func(router *gin.Engine) {
 		controller.Register(router, assetController.GetAssetController())
 		controller.Register(router, assetController.GetCashFlowController())
+		controller.Register(router, assetController.GetAnalyticsController())
+		controller.Register(router, assetController.GetTagController())
 
 		controller.Register(router, attachmentController.GetAttachmentController())
 
@@ -69,9 +77,33 @@ func main() {
 		controller.Register(router, authorizationController.GetRoleController())
 		controller.Register(router, authorizationController.GetUserController())
 		controller.Register(router, authorizationController.GetAuthorizationController())
+		controller.Register(router, authorizationController.GetOAuthClientController())
+		controller.Register(router, authorizationController.GetOpenIdController())
+		controller.Register(router, authorizationController.GetMfaController())
+		controller.Register(router, authorizationController.GetLoginProtectionController())
+		controller.Register(router, authorizationController.GetPasswordController())
+		controller.Register(router, authorizationController.GetApiTokenController())
+		controller.Register(router, authorizationController.GetSessionController())
 
 		controller.Register(router, countryController.GetCityController())
 		controller.Register(router, countryController.GetCurrencyController())
 		controller.Register(router, countryController.GetCountryController())
+		controller.Register(router, countryController.GetCpiController())
+
+		controller.Register(router, documentController.GetDocumentController())
+
+		controller.Register(router, investmentController.GetInstrumentController())
+		controller.Register(router, investmentController.GetHoldingController())
+
+		controller.Register(router, maintenanceController.GetWorkOrderController())
+		controller.Register(router, maintenanceController.GetPreventiveTaskController())
+
+		controller.Register(router, notificationController.GetNotificationController())
+		controller.Register(router, notificationController.GetNotificationRuleController())
+
+		controller.Register(router, reconciliationController.GetBankStatementController())
+		controller.Register(router, reconciliationController.GetReconciliationController())
+
+		controller.Register(router, viewController.GetSavedViewController())
 	}))
 }
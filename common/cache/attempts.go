@@ -0,0 +1,123 @@
+package cache
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/custom_error"
+	"assets/common/db"
+	"context"
+	"errors"
+	"github.com/go-redis/redis/v8"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+const (
+	attemptsPrefix     = "attempts:"
+	attemptsLockPrefix = "attemptsLocks:"
+)
+
+// countAttemptScript increments the count and starts its window at once, so the count never outlives the window
+var countAttemptScript = redis.NewScript(`
+local count = redis.call('INCR', KEYS[1])
+if count == 1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return count`)
+
+// CountAttempt counts the failed attempt of the key within the window started by its first attempt and
+// returns the count. The attempts aren't allowed when they can't be counted, so it panics when redis is unavailable
+func CountAttempt(ctx context.Context, key string, window time.Duration) int64 {
+	client, err := db.GetRedisCachePool()
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Couldn't get redis connection pool")
+		panic(custom_error.CouldNotConnectError)
+	}
+
+	count, err := countAttemptScript.Run(ctx, client, []string{attemptsPrefix + key}, window.Milliseconds()).Int64()
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Errorf("Couldn't count attempt of %s", key)
+		panic(custom_error.CouldNotConnectError)
+	}
+	return count
+}
+
+func GetAttempts(ctx context.Context, key string) int64 {
+	client, err := db.GetRedisCachePool()
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Couldn't get redis connection pool")
+		return 0
+	}
+	count, _ := client.Get(ctx, attemptsPrefix+key).Int64()
+	return count
+}
+
+// ResetAttempts forgets the failed attempts of the key and removes its lock
+func ResetAttempts(ctx context.Context, key string) {
+	client, err := db.GetRedisCachePool()
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Couldn't get redis connection pool")
+		return
+	}
+	if err := client.Del(ctx, attemptsPrefix+key, attemptsLockPrefix+key).Err(); err != nil {
+		log.WithContext(ctx).WithError(err).Errorf("Couldn't reset attempts of %s", key)
+	}
+}
+
+// LockAttempts rejects the attempts of the key for the duration, the reason tells why they are rejected.
+// It panics when redis is unavailable, since the attempts wouldn't be rejected then
+func LockAttempts(ctx context.Context, key string, reason string, duration time.Duration) {
+	client, err := db.GetRedisCachePool()
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Couldn't get redis connection pool")
+		panic(custom_error.CouldNotConnectError)
+	}
+	if err := client.Set(ctx, attemptsLockPrefix+key, reason, duration).Err(); err != nil {
+		log.WithContext(ctx).WithError(err).Errorf("Couldn't lock attempts of %s", key)
+		panic(custom_error.CouldNotConnectError)
+	}
+}
+
+// GetAttemptsLock returns the reason and the remaining duration of the lock of the key, the duration is zero
+// when the key isn't locked. It panics when redis is unavailable, so the attempts aren't let through unchecked
+func GetAttemptsLock(ctx context.Context, key string) (string, time.Duration) {
+	client, err := db.GetRedisCachePool()
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Couldn't get redis connection pool")
+		panic(custom_error.CouldNotConnectError)
+	}
+
+	pipe := client.Pipeline()
+	reason := pipe.Get(ctx, attemptsLockPrefix+key)
+	ttl := pipe.PTTL(ctx, attemptsLockPrefix+key)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		log.WithContext(ctx).WithError(err).Errorf("Couldn't get attempts lock of %s", key)
+		panic(custom_error.CouldNotConnectError)
+	}
+	if ttl.Val() <= 0 {
+		return "", 0
+	}
+	return reason.Val(), ttl.Val()
+}
@@ -30,6 +30,7 @@ import (
 	"assets/common/util"
 	"context"
 	"encoding/json"
+	"github.com/gin-gonic/gin"
 	log "github.com/sirupsen/logrus"
 	"time"
 )
@@ -54,7 +55,7 @@ func GetCache[T any](cacheName string) func(context context.Context) (T, error)
 		var result T
 		ctx := util.ConvertContext(context)
 
-		if resultFromCache, err := cache.Get(ctx, cacheName+":"+ctx.Request.URL.String()).Result(); err == nil {
+		if resultFromCache, err := cache.Get(ctx, getCacheKey(ctx, cacheName)).Result(); err == nil {
 			return result, json.Unmarshal([]byte(resultFromCache), &result)
 		}
 
@@ -70,7 +71,7 @@ func SetCache[T any](cacheName string, ttl time.Duration) func(context context.C
 
 	return func(context context.Context, obj T) T {
 		ctx := util.ConvertContext(context)
-		log.WithContext(context).WithError(cache.Set(ctx, cacheName+":"+ctx.Request.URL.String(), string(util.MustOne(json.Marshal(obj))), ttl).Err())
+		log.WithContext(context).WithError(cache.Set(ctx, getCacheKey(ctx, cacheName), string(util.MustOne(json.Marshal(obj))), ttl).Err())
 		return obj
 	}
 }
@@ -95,3 +96,11 @@ func EvictCache(cacheNames ...string) func(context context.Context) {
 		}
 	}
 }
+
+// getCacheKey separates cached responses of different users, because the content may depend on the user rights
+func getCacheKey(ctx *gin.Context, cacheName string) string {
+	if tokenInfo, ok := util.GetCurrentTokenInfo(ctx); ok {
+		return cacheName + ":" + tokenInfo.UserId.String() + ":" + ctx.Request.URL.String()
+	}
+	return cacheName + ":" + ctx.Request.URL.String()
+}
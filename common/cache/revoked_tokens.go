@@ -0,0 +1,154 @@
+package cache
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/config"
+	"assets/common/custom_error"
+	"assets/common/db"
+	"assets/common/model"
+	"context"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"strconv"
+	"time"
+)
+
+const (
+	revokedUserPrefix      = "revokedTokens:user:"
+	revokedTokenPrefix     = "revokedTokens:jti:"
+	revokedSessionPrefix   = "revokedTokens:sid:"
+	usedRefreshTokenPrefix = "usedRefreshTokens:"
+)
+
+// RevokeUserTokens invalidates all tokens issued to the user so far. The mark is the revocation time in milliseconds,
+// it is kept as long as the longest living token issued before it may be valid
+func RevokeUserTokens(ctx context.Context, userId uuid.UUID) {
+	setRevocation(ctx, revokedUserPrefix+userId.String(), time.Now().UnixMilli(), config.CoreConfig.AuthorizationServer.GetMaxTokenValidity())
+}
+
+// RevokeToken puts the token into the denylist until it expires, the access token of the refresh token
+// is revoked as well
+func RevokeToken(ctx context.Context, tokenInfo model.TokenInfo) {
+	ttl := time.Until(time.Unix(tokenInfo.ExpiresAt, 0))
+	if ttl <= 0 {
+		return
+	}
+	setRevocation(ctx, revokedTokenPrefix+tokenInfo.Id, 1, ttl)
+	if tokenInfo.TokenType == model.RefreshTokenType && tokenInfo.ParentId != "" {
+		setRevocation(ctx, revokedTokenPrefix+tokenInfo.ParentId, 1, ttl)
+	}
+}
+
+// RevokeSession invalidates all tokens issued within the session including the rotated ones
+func RevokeSession(ctx context.Context, sessionId string) {
+	if sessionId != "" {
+		setRevocation(ctx, revokedSessionPrefix+sessionId, 1, config.CoreConfig.AuthorizationServer.GetMaxTokenValidity())
+	}
+}
+
+// IsTokenRevoked checks whether the token, its session or all tokens of its user are revoked. It fails closed,
+// the token which revocation can't be checked is rejected with CouldNotConnectError
+func IsTokenRevoked(ctx context.Context, tokenInfo model.TokenInfo) bool {
+	return AreTokensRevoked(ctx, []model.TokenInfo{tokenInfo})[0]
+}
+
+// AreTokensRevoked checks the revocation of the tokens with one redis lookup, the result is by the token index.
+// It fails closed like IsTokenRevoked
+func AreTokensRevoked(ctx context.Context, tokenInfos []model.TokenInfo) []bool {
+	result := make([]bool, len(tokenInfos))
+	if len(tokenInfos) == 0 {
+		return result
+	}
+	client, err := db.GetRedisCachePool()
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Couldn't get redis connection pool")
+		panic(custom_error.CouldNotConnectError)
+	}
+
+	keys := make([]string, 0, len(tokenInfos)*3)
+	for _, tokenInfo := range tokenInfos {
+		keys = append(keys,
+			revokedUserPrefix+tokenInfo.UserId.String(),
+			revokedTokenPrefix+tokenInfo.Id,
+			revokedSessionPrefix+tokenInfo.SessionId,
+		)
+	}
+	values, err := client.MGet(ctx, keys...).Result()
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Couldn't check token revocation")
+		panic(custom_error.CouldNotConnectError)
+	}
+	for i, tokenInfo := range tokenInfos {
+		result[i] = isRevoked(tokenInfo, values[i*3:i*3+3])
+	}
+	return result
+}
+
+// isRevoked checks the token by the marks of its user, the token itself and its session
+func isRevoked(tokenInfo model.TokenInfo, values []any) bool {
+	if (tokenInfo.Id != "" && values[1] != nil) || (tokenInfo.SessionId != "" && values[2] != nil) {
+		return true
+	}
+	revokedAt, ok := values[0].(string)
+	if !ok {
+		return false
+	}
+	revokedAtMs, err := strconv.ParseInt(revokedAt, 10, 64)
+	return err == nil && tokenInfo.GetIssueTime().UnixMilli() <= revokedAtMs
+}
+
+// MarkRefreshTokenUsed marks the refresh token as exchanged for new tokens, false is returned
+// when it is already used, so the refresh token is replayed. It fails closed, the refresh token
+// which can't be marked isn't exchanged and CouldNotConnectError is raised
+func MarkRefreshTokenUsed(ctx context.Context, tokenInfo model.TokenInfo) bool {
+	client, err := db.GetRedisCachePool()
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Couldn't get redis connection pool")
+		panic(custom_error.CouldNotConnectError)
+	}
+
+	ttl := time.Until(time.Unix(tokenInfo.ExpiresAt, 0))
+	marked, err := client.SetNX(ctx, usedRefreshTokenPrefix+tokenInfo.Id, tokenInfo.SessionId, max(ttl, time.Second)).Result()
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Couldn't mark refresh token as used")
+		panic(custom_error.CouldNotConnectError)
+	}
+	return marked
+}
+
+// setRevocation stores the revocation mark, it panics when redis is unavailable, since the tokens
+// would stay valid while the caller reports them revoked
+func setRevocation(ctx context.Context, key string, value any, ttl time.Duration) {
+	client, err := db.GetRedisCachePool()
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Couldn't get redis connection pool")
+		panic(custom_error.CouldNotConnectError)
+	}
+	if err := client.Set(ctx, key, value, ttl).Err(); err != nil {
+		log.WithContext(ctx).WithError(err).Errorf("Couldn't revoke tokens by %s", key)
+		panic(custom_error.CouldNotConnectError)
+	}
+}
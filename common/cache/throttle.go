@@ -0,0 +1,51 @@
+package cache
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/db"
+	"context"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+const throttlePrefix = "throttles:"
+
+// Throttle tells whether the action of the key may run now, it runs once within the interval across
+// all instances. The action is skipped when redis is unavailable
+func Throttle(ctx context.Context, key string, interval time.Duration) bool {
+	client, err := db.GetRedisCachePool()
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Couldn't get redis connection pool")
+		return false
+	}
+
+	acquired, err := client.SetNX(ctx, throttlePrefix+key, 1, interval).Result()
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Errorf("Couldn't throttle %s", key)
+		return false
+	}
+	return acquired
+}
@@ -1,5 +1,10 @@
 package config
 
+import (
+	"strings"
+	"time"
+)
+
 /*
  * Copyright © 2024, "DEADLINE TEAM" LLC
  *
@@ -25,8 +30,53 @@ package config
  */
 
 type AuthorizationServerProperty struct {
-	EncodingAlg                 string `yaml:"encodingAlg,omitempty"`
-	SignKey                     string `yaml:"signKey,omitempty"`
-	AccessTokenValiditySeconds  int    `yaml:"accessTokenValiditySeconds,omitempty"`
-	RefreshTokenValiditySeconds int    `yaml:"refreshTokenValiditySeconds,omitempty"`
+	Issuer                      string        `yaml:"issuer,omitempty"`
+	EncodingAlg                 string        `yaml:"encodingAlg,omitempty"`
+	SignKey                     string        `yaml:"signKey,omitempty"`
+	AccessTokenValiditySeconds  int           `yaml:"accessTokenValiditySeconds,omitempty"`
+	RefreshTokenValiditySeconds int           `yaml:"refreshTokenValiditySeconds,omitempty"`
+	KeyRotationInterval         time.Duration `yaml:"keyRotationInterval,omitempty"`
+	// IdentityProviders are keyed by the lower case names used in the login urls
+	IdentityProviders map[string]IdentityProviderProperty `yaml:"identityProviders,omitempty"`
+	LoginProtection   LoginProtectionProperty             `yaml:"loginProtection,omitempty"`
+	PasswordPolicy    PasswordPolicyProperty              `yaml:"passwordPolicy,omitempty"`
+	// PasswordResetUrl is the page of the password reset, the token is added as the token query parameter
+	PasswordResetUrl      string        `yaml:"passwordResetUrl,omitempty"`
+	PasswordResetValidity time.Duration `yaml:"passwordResetValidity,omitempty"`
+	// AdminPassword is the initial password of the seeded administrator, a random one is generated
+	// and printed once to stderr when it isn't set. It has to be changed on the first login anyway
+	AdminPassword string `yaml:"adminPassword,omitempty"`
+	// PersonalAccessTokenValidity is the longest validity of the personal access tokens
+	PersonalAccessTokenValidity time.Duration `yaml:"personalAccessTokenValidity,omitempty"`
+}
+
+// GetIssuer returns the issuer of the tokens, it is the base url of the OpenID Connect endpoints as well
+func (prop AuthorizationServerProperty) GetIssuer() string {
+	if prop.Issuer == "" {
+		return "DeadlineTeam"
+	}
+	return strings.TrimSuffix(prop.Issuer, "/")
+}
+
+// IsLegacyAlg tells whether the tokens are signed by the shared sign key instead of the rotated key pairs
+func (prop AuthorizationServerProperty) IsLegacyAlg() bool {
+	return strings.HasPrefix(prop.EncodingAlg, "HS")
+}
+
+func (prop AuthorizationServerProperty) GetMaxTokenValidity() time.Duration {
+	return time.Duration(max(prop.AccessTokenValiditySeconds, prop.RefreshTokenValiditySeconds)) * time.Second
+}
+
+func (prop AuthorizationServerProperty) GetPasswordResetValidity() time.Duration {
+	if prop.PasswordResetValidity == 0 {
+		return time.Hour
+	}
+	return prop.PasswordResetValidity
+}
+
+func (prop AuthorizationServerProperty) GetPersonalAccessTokenValidity() time.Duration {
+	if prop.PersonalAccessTokenValidity == 0 {
+		return 365 * 24 * time.Hour
+	}
+	return prop.PersonalAccessTokenValidity
 }
@@ -33,4 +33,8 @@ type baseProperty struct {
 	AuthorizationServer AuthorizationServerProperty `yaml:"authorizationServer,omitempty"`
 	Ldap                LdapProperty                `yaml:"ldap,omitempty"`
 	Attachment          AttachmentProperty          `yaml:"attachment,omitempty"`
+	Maintenance         MaintenanceProperty         `yaml:"maintenance,omitempty"`
+	Notification        NotificationProperty        `yaml:"notification,omitempty"`
+	Reconciliation      ReconciliationProperty      `yaml:"reconciliation,omitempty"`
+	Geocoder            GeocoderProperty            `yaml:"geocoder,omitempty"`
 }
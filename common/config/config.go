@@ -61,6 +61,9 @@ func Load(config interface{}) error {
 	}
 
 	updateConfig(config, viperConfig)
+	if _, err := CoreConfig.Database.GetEncryptionKey(); err != nil {
+		return err
+	}
 	return nil
 }
 
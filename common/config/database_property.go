@@ -24,14 +24,24 @@ package config
  * © "DEADLINE TEAM" LLC, All rights reserved.
  */
 
-import "time"
+import (
+	"encoding/base64"
+	"errors"
+	"time"
+)
 
 type DatabaseProperty struct {
-	Postgres        PostgresProperty `yaml:"postgres,omitempty"`
-	Redis           RedisProperty    `yaml:"redis,omitempty"`
-	Jaeger          JaegerProperty   `yaml:"Jaeger,omitempty"`
-	RetryMaxTimeSec time.Duration    `yaml:"retryMaxTimeSec,omitempty"`
-	BatchSize       int              `yaml:"batchSize,omitempty"`
+	Postgres           PostgresProperty `yaml:"postgres,omitempty"`
+	Redis              RedisProperty    `yaml:"redis,omitempty"`
+	Jaeger             JaegerProperty   `yaml:"Jaeger,omitempty"`
+	RetryMaxTimeSec    time.Duration    `yaml:"retryMaxTimeSec,omitempty"`
+	BatchSize          int              `yaml:"batchSize,omitempty"`
+	MaxBulkSize        int              `yaml:"maxBulkSize,omitempty"`
+	TrashRetention     time.Duration    `yaml:"trashRetention,omitempty"`
+	TrashPurgeInterval time.Duration    `yaml:"trashPurgeInterval,omitempty"`
+	// EncryptionKey is the base64 encoded 256-bit key encrypting the secrets stored in the database, e.g. the private
+	// keys signing the tokens. It must come from the environment and never be committed
+	EncryptionKey string `yaml:"encryptionKey,omitempty"`
 }
 
 type PostgresProperty struct {
@@ -66,3 +76,29 @@ type JaegerProperty struct {
 	ContainerExpirationSec uint   `yaml:"containerExpirationSec,omitempty"`
 	DynamicPort            bool   `yaml:"dynamicPort,omitempty"`
 }
+
+// GetEncryptionKey returns the decoded encryption key, it fails if the key is missing or isn't 32 bytes long
+func (prop DatabaseProperty) GetEncryptionKey() ([]byte, error) {
+	if prop.EncryptionKey == "" {
+		return nil, errors.New("database encryption key isn't configured")
+	}
+	key, err := base64.StdEncoding.DecodeString(prop.EncryptionKey)
+	if err != nil || len(key) != 32 {
+		return nil, errors.New("database encryption key must be 32 bytes encoded by base64")
+	}
+	return key, nil
+}
+
+func (prop DatabaseProperty) GetTrashRetention() time.Duration {
+	if prop.TrashRetention == 0 {
+		return 30 * 24 * time.Hour
+	}
+	return prop.TrashRetention
+}
+
+func (prop DatabaseProperty) GetTrashPurgeInterval() time.Duration {
+	if prop.TrashPurgeInterval == 0 {
+		return time.Hour
+	}
+	return prop.TrashPurgeInterval
+}
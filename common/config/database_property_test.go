@@ -0,0 +1,56 @@
+package config
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestDatabaseGetEncryptionKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		wantErr bool
+	}{
+		{"missing", "", true},
+		{"not base64", "not a key", true},
+		{"too short", base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 16))), true},
+		{"too long", base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 33))), true},
+		{"256 bits", base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32))), false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key, err := DatabaseProperty{EncryptionKey: test.key}.GetEncryptionKey()
+			if (err != nil) != test.wantErr {
+				t.Fatalf("GetEncryptionKey() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if err == nil && len(key) != 32 {
+				t.Errorf("len(GetEncryptionKey()) = %d, want 32", len(key))
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package config
+
+import (
+	"strings"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+// IdentityProviderProperty is the external OpenID Connect provider the users sign in with. The claim values
+// of the role mapping are compared ignoring case, the roles are the names of the local roles
+type IdentityProviderProperty struct {
+	Issuer       string              `yaml:"issuer,omitempty"`
+	ClientId     string              `yaml:"clientId,omitempty"`
+	ClientSecret string              `yaml:"clientSecret,omitempty"`
+	Scopes       []string            `yaml:"scopes,omitempty"`
+	RoleClaim    string              `yaml:"roleClaim,omitempty"`
+	RoleMapping  map[string][]string `yaml:"roleMapping,omitempty"`
+	DefaultRoles []string            `yaml:"defaultRoles,omitempty"`
+	CreateUsers  bool                `yaml:"createUsers,omitempty"`
+	// TrustEmail links the external account to the existing user of the same verified email on the first login,
+	// it is set for the providers which verify the emails themselves only
+	TrustEmail bool          `yaml:"trustEmail,omitempty"`
+	Timeout    time.Duration `yaml:"timeout,omitempty"`
+}
+
+func (prop IdentityProviderProperty) GetIssuer() string {
+	return strings.TrimSuffix(prop.Issuer, "/")
+}
+
+func (prop IdentityProviderProperty) GetScopes() []string {
+	if len(prop.Scopes) == 0 {
+		return []string{"openid", "profile", "email"}
+	}
+	return prop.Scopes
+}
+
+func (prop IdentityProviderProperty) GetTimeout() time.Duration {
+	if prop.Timeout == 0 {
+		return 10 * time.Second
+	}
+	return prop.Timeout
+}
+
+// GetMappedRoles returns the local roles of the claim values, the default roles are always granted
+func (prop IdentityProviderProperty) GetMappedRoles(values []string) []string {
+	roles := append([]string{}, prop.DefaultRoles...)
+	for key, mappedRoles := range prop.RoleMapping {
+		for _, value := range values {
+			if strings.EqualFold(key, value) {
+				roles = append(roles, mappedRoles...)
+			}
+		}
+	}
+	return roles
+}
+
+// GetManagedRoles returns all local roles the provider grants, they are revoked once the claims don't map to them
+func (prop IdentityProviderProperty) GetManagedRoles() []string {
+	roles := append([]string{}, prop.DefaultRoles...)
+	for _, mappedRoles := range prop.RoleMapping {
+		roles = append(roles, mappedRoles...)
+	}
+	return roles
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"math"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+// LoginProtectionProperty throttles the failed logins. The failures of the username and of the ip are
+// counted within the window, the next attempt is delayed exponentially after the free failures
+// and the account is locked once the failures of the username reach the maximum
+type LoginProtectionProperty struct {
+	Window          time.Duration `yaml:"window,omitempty"`
+	FreeFailures    int64         `yaml:"freeFailures,omitempty"`
+	InitialDelay    time.Duration `yaml:"initialDelay,omitempty"`
+	MaxDelay        time.Duration `yaml:"maxDelay,omitempty"`
+	MaxUserFailures int64         `yaml:"maxUserFailures,omitempty"`
+	MaxIpFailures   int64         `yaml:"maxIpFailures,omitempty"`
+	LockoutDuration time.Duration `yaml:"lockoutDuration,omitempty"`
+}
+
+func (prop LoginProtectionProperty) GetWindow() time.Duration {
+	if prop.Window == 0 {
+		return time.Hour
+	}
+	return prop.Window
+}
+
+func (prop LoginProtectionProperty) GetFreeFailures() int64 {
+	if prop.FreeFailures == 0 {
+		return 3
+	}
+	return prop.FreeFailures
+}
+
+func (prop LoginProtectionProperty) GetInitialDelay() time.Duration {
+	if prop.InitialDelay == 0 {
+		return time.Second
+	}
+	return prop.InitialDelay
+}
+
+func (prop LoginProtectionProperty) GetMaxDelay() time.Duration {
+	if prop.MaxDelay == 0 {
+		return 5 * time.Minute
+	}
+	return prop.MaxDelay
+}
+
+func (prop LoginProtectionProperty) GetMaxUserFailures() int64 {
+	if prop.MaxUserFailures == 0 {
+		return 10
+	}
+	return prop.MaxUserFailures
+}
+
+func (prop LoginProtectionProperty) GetMaxIpFailures() int64 {
+	if prop.MaxIpFailures == 0 {
+		return 100
+	}
+	return prop.MaxIpFailures
+}
+
+func (prop LoginProtectionProperty) GetLockoutDuration() time.Duration {
+	if prop.LockoutDuration == 0 {
+		return 15 * time.Minute
+	}
+	return prop.LockoutDuration
+}
+
+// GetDelay returns the delay before the next attempt after the failures, it doubles with every failure
+// beyond the free ones and doesn't exceed the max delay
+func (prop LoginProtectionProperty) GetDelay(failures int64) time.Duration {
+	if failures <= prop.GetFreeFailures() {
+		return 0
+	}
+	delay := float64(prop.GetInitialDelay()) * math.Pow(2, float64(failures-prop.GetFreeFailures()-1))
+	return time.Duration(min(delay, float64(prop.GetMaxDelay())))
+}
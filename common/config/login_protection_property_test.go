@@ -0,0 +1,60 @@
+package config
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoginProtectionGetDelay(t *testing.T) {
+	custom := LoginProtectionProperty{FreeFailures: 1, InitialDelay: 2 * time.Second, MaxDelay: 10 * time.Second}
+
+	tests := []struct {
+		name     string
+		prop     LoginProtectionProperty
+		failures int64
+		want     time.Duration
+	}{
+		{"no failures", LoginProtectionProperty{}, 0, 0},
+		{"last free failure", LoginProtectionProperty{}, 3, 0},
+		{"first delayed failure", LoginProtectionProperty{}, 4, time.Second},
+		{"doubled delay", LoginProtectionProperty{}, 5, 2 * time.Second},
+		{"doubled twice", LoginProtectionProperty{}, 6, 4 * time.Second},
+		{"default max delay", LoginProtectionProperty{}, 20, 5 * time.Minute},
+		{"many failures don't overflow", LoginProtectionProperty{}, 1000, 5 * time.Minute},
+		{"custom free failures", custom, 1, 0},
+		{"custom initial delay", custom, 2, 2 * time.Second},
+		{"custom delay doubled", custom, 4, 8 * time.Second},
+		{"custom max delay", custom, 5, 10 * time.Second},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.prop.GetDelay(test.failures); got != test.want {
+				t.Errorf("GetDelay(%d) = %v, want %v", test.failures, got, test.want)
+			}
+		})
+	}
+}
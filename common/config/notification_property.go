@@ -0,0 +1,54 @@
+package config
+
+import "time"
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+type NotificationProperty struct {
+	CheckInterval time.Duration                   `yaml:"checkInterval,omitempty"`
+	MaxAttempts   int                             `yaml:"maxAttempts,omitempty"`
+	RetryDelay    time.Duration                   `yaml:"retryDelay,omitempty"`
+	Smtp          SmtpProperty                    `yaml:"smtp,omitempty"`
+	Telegram      TelegramProperty                `yaml:"telegram,omitempty"`
+	Templates     map[string]NotificationTemplate `yaml:"templates,omitempty"`
+}
+
+type SmtpProperty struct {
+	Host     string `yaml:"host,omitempty"`
+	Port     int    `yaml:"port,omitempty"`
+	Username string `yaml:"username,omitempty"`
+	Password string `yaml:"password,omitempty"`
+	From     string `yaml:"from,omitempty"`
+}
+
+type TelegramProperty struct {
+	Url   string `yaml:"url,omitempty"`
+	Token string `yaml:"token,omitempty"`
+}
+
+type NotificationTemplate struct {
+	Subject string `yaml:"subject,omitempty"`
+	Body    string `yaml:"body,omitempty"`
+}
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"strings"
+	"unicode"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+// PasswordPolicyProperty is the policy of the passwords chosen by the users, the passwords generated
+// by the system aren't checked
+type PasswordPolicyProperty struct {
+	MinLength        int  `yaml:"minLength,omitempty"`
+	MaxLength        int  `yaml:"maxLength,omitempty"`
+	RequireUppercase bool `yaml:"requireUppercase,omitempty"`
+	RequireLowercase bool `yaml:"requireLowercase,omitempty"`
+	RequireDigit     bool `yaml:"requireDigit,omitempty"`
+	RequireSpecial   bool `yaml:"requireSpecial,omitempty"`
+}
+
+func (prop PasswordPolicyProperty) GetMinLength() int {
+	if prop.MinLength == 0 {
+		return 8
+	}
+	return prop.MinLength
+}
+
+func (prop PasswordPolicyProperty) GetMaxLength() int {
+	if prop.MaxLength == 0 {
+		return 128
+	}
+	return prop.MaxLength
+}
+
+// IsSatisfied tells whether the password meets the policy, the password containing the username never does
+func (prop PasswordPolicyProperty) IsSatisfied(password string, username string) bool {
+	length := len([]rune(password))
+	if length < prop.GetMinLength() || length > prop.GetMaxLength() {
+		return false
+	}
+	if username != "" && strings.Contains(strings.ToLower(password), strings.ToLower(username)) {
+		return false
+	}
+
+	var hasUppercase, hasLowercase, hasDigit, hasSpecial bool
+	for _, char := range password {
+		switch {
+		case unicode.IsUpper(char):
+			hasUppercase = true
+		case unicode.IsLower(char):
+			hasLowercase = true
+		case unicode.IsDigit(char):
+			hasDigit = true
+		case unicode.IsPunct(char) || unicode.IsSymbol(char) || unicode.IsSpace(char):
+			hasSpecial = true
+		}
+	}
+	return (hasUppercase || !prop.RequireUppercase) &&
+		(hasLowercase || !prop.RequireLowercase) &&
+		(hasDigit || !prop.RequireDigit) &&
+		(hasSpecial || !prop.RequireSpecial)
+}
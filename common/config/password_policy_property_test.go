@@ -0,0 +1,60 @@
+package config
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import "testing"
+
+func TestPasswordPolicyIsSatisfied(t *testing.T) {
+	strict := PasswordPolicyProperty{MinLength: 8, MaxLength: 16, RequireUppercase: true, RequireLowercase: true, RequireDigit: true, RequireSpecial: true}
+
+	tests := []struct {
+		name     string
+		policy   PasswordPolicyProperty
+		password string
+		username string
+		want     bool
+	}{
+		{"default length", PasswordPolicyProperty{}, "abcdefgh", "", true},
+		{"shorter than default", PasswordPolicyProperty{}, "abcdefg", "", false},
+		{"all classes", strict, "Abcdef1!", "", true},
+		{"no uppercase", strict, "abcdef1!", "", false},
+		{"no lowercase", strict, "ABCDEF1!", "", false},
+		{"no digit", strict, "Abcdefg!", "", false},
+		{"no special", strict, "Abcdefg1", "", false},
+		{"space is special", strict, "Abcd ef1", "", true},
+		{"too long", strict, "Abcdef1!Abcdef1!x", "", false},
+		{"length in characters", PasswordPolicyProperty{MinLength: 4, MaxLength: 4}, "паро", "", true},
+		{"contains username", strict, "xJohn1!xx", "john", false},
+		{"contains username ignoring case", strict, "Abc1!JOHN", "john", false},
+		{"no username", strict, "Abcdef1!", "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.policy.IsSatisfied(test.password, test.username); got != test.want {
+				t.Errorf("IsSatisfied(%q, %q) = %v, want %v", test.password, test.username, got, test.want)
+			}
+		})
+	}
+}
@@ -28,4 +28,7 @@ type ServerProperty struct {
 	Port       int    `yaml:"port,omitempty"`
 	SslKeyPath string `yaml:"sslKeyPath,omitempty"`
 	SslCrtPath string `yaml:"sslCrtPath,omitempty"`
+	// TrustedProxies are the addresses or networks of the proxies the client ip is taken from
+	// the X-Forwarded-For header of, the remote address is the client ip when the list is empty
+	TrustedProxies []string `yaml:"trustedProxies,omitempty"`
 }
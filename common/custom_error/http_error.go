@@ -27,6 +27,7 @@ package custom_error
 import (
 	"assets/common/iface"
 	"net/http"
+	"time"
 )
 
 type baseHttpError struct {
@@ -38,6 +39,17 @@ func NewHttpError(err string, code int) iface.HttpError { return &baseHttpError{
 func (httpErr baseHttpError) Error() string             { return httpErr.err }
 func (httpErr baseHttpError) Code() int                 { return httpErr.code }
 
+type retryableHttpError struct {
+	iface.HttpError
+	retryAfter time.Duration
+}
+
+// WithRetryAfter adds the delay the request may be repeated after, it is sent in the Retry-After header
+func WithRetryAfter(err iface.HttpError, retryAfter time.Duration) iface.HttpError {
+	return &retryableHttpError{err, retryAfter}
+}
+func (httpErr retryableHttpError) RetryAfter() time.Duration { return httpErr.retryAfter }
+
 var (
 	NeedAuthorizationHeaderError          = NewHttpError("need authorization header", http.StatusUnauthorized)
 	UnknownGrantTypeError                 = NewHttpError("unknown grant_type", http.StatusBadRequest)
@@ -45,9 +57,25 @@ var (
 	NotFoundError                         = NewHttpError("not found", http.StatusNotFound)
 	TokenExpiredError                     = NewHttpError("token is expired", http.StatusUnauthorized)
 	TokenInvalidError                     = NewHttpError("for this operation need authorization header with valid bearer token", http.StatusUnauthorized)
+	TokenRevokedError                     = NewHttpError("token is revoked", http.StatusUnauthorized)
 	UsernameAndPasswordMastNotBeNullError = NewHttpError("username and password mast not be null", http.StatusBadRequest)
 	UsernameOrPasswordIsIncorrectError    = NewHttpError("username or password is incorrect", http.StatusBadRequest)
 	UserBlockedError                      = NewHttpError("user is blocked", http.StatusForbidden)
+	TooManyAttemptsError                  = NewHttpError("too many attempts", http.StatusTooManyRequests)
+	AccountLockedError                    = NewHttpError("account is temporarily locked", http.StatusLocked)
+	CurrentPasswordIsIncorrectError       = NewHttpError("current password is incorrect", http.StatusBadRequest)
+	PasswordPolicyViolationError          = NewHttpError("password doesn't meet the password policy", http.StatusBadRequest)
+	PasswordResetTokenInvalidError        = NewHttpError("password reset token is invalid or expired", http.StatusBadRequest)
+	MfaCodeIsIncorrectError               = NewHttpError("mfa code is incorrect", http.StatusBadRequest)
+	MfaAlreadyEnabledError                = NewHttpError("mfa is already enabled", http.StatusConflict)
+	MfaNotEnabledError                    = NewHttpError("mfa is not enabled", http.StatusConflict)
+	ExternalLoginFailedError              = NewHttpError("external login failed", http.StatusUnauthorized)
+	ExternalAccountNotLinkedError         = NewHttpError("external account is not linked to any user", http.StatusForbidden)
+	InvalidClientError                    = NewHttpError("invalid client", http.StatusUnauthorized)
+	InvalidGrantError                     = NewHttpError("invalid grant", http.StatusBadRequest)
+	UnauthorizedClientError               = NewHttpError("unauthorized client", http.StatusBadRequest)
+	InvalidRedirectUriError               = NewHttpError("invalid redirect uri", http.StatusBadRequest)
+	ConsentTokenInvalidError              = NewHttpError("consent token is invalid or expired", http.StatusForbidden)
 	NotEnoughRightsError                  = NewHttpError("not enough rights", http.StatusForbidden)
 	ParseZeroValueError                   = NewHttpError("parse zero value", http.StatusBadRequest)
 	IllegalArgumentError                  = NewHttpError("illegal argument", http.StatusBadRequest)
@@ -57,4 +85,15 @@ var (
 	NotImplementedError                   = NewHttpError("not implemented", http.StatusNotImplemented)
 	LicenseNotActivated                   = NewHttpError("license not activated", http.StatusConflict)
 	LicenseAlreadyActivated               = NewHttpError("license already activated", http.StatusConflict)
+	InvalidAssetSharesError               = NewHttpError("asset shares must be positive and not exceed 100 percent at any time", http.StatusBadRequest)
+	IllegalStatusTransitionError          = NewHttpError("illegal status transition", http.StatusConflict)
+	InvalidStatementFileError             = NewHttpError("bank statement file couldn't be parsed", http.StatusBadRequest)
+	AddressNotInCityError                 = NewHttpError("address doesn't belong to the city of the asset", http.StatusBadRequest)
+	UnbalancedMatchError                  = NewHttpError("amounts of matched transactions and cash flows differ", http.StatusBadRequest)
+	InvalidCpiFileError                   = NewHttpError("cpi series file couldn't be parsed", http.StatusBadRequest)
+	InvalidPriceFileError                 = NewHttpError("price history file couldn't be parsed", http.StatusBadRequest)
+	InsufficientQuantityError             = NewHttpError("sold quantity exceeds the held one", http.StatusBadRequest)
+	InstrumentInUseError                  = NewHttpError("instrument is used by holdings", http.StatusConflict)
+	PreconditionRequiredError             = NewHttpError("if-match header is required", http.StatusPreconditionRequired)
+	PreconditionFailedError               = NewHttpError("entity version doesn't match", http.StatusPreconditionFailed)
 )
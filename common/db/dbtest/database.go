@@ -0,0 +1,241 @@
+package dbtest
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/db"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Result is the answer of the fake database to the statement, the rows are returned to the queries
+// and the number of the affected rows to the other statements
+type Result struct {
+	Columns      []string
+	Rows         [][]driver.Value
+	RowsAffected int64
+	Err          error
+}
+
+// Responder answers the statement run against the fake database
+type Responder func(query string, args []any) Result
+
+// Database records the statements of the data source, including the ones managing the transactions,
+// so the tests check what is run against the database without running it
+type Database struct {
+	mutex      sync.Mutex
+	responder  Responder
+	statements []string
+}
+
+// NewDataSource returns the data source backed by the fake database answering by the responder,
+// the statements are answered with no rows when the responder is nil
+func NewDataSource(t *testing.T, responder Responder) (*db.DataSource, *Database) {
+	database := &Database{responder: responder}
+	sqlDB := sql.OpenDB(connector{database})
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &db.DataSource{DB: gormDB}, database
+}
+
+// Statements returns the statements run so far
+func (database *Database) Statements() []string {
+	database.mutex.Lock()
+	defer database.mutex.Unlock()
+	return append([]string(nil), database.statements...)
+}
+
+// Find returns the statements run so far which contain the text
+func (database *Database) Find(text string) []string {
+	var result []string
+	for _, statement := range database.Statements() {
+		if strings.Contains(statement, text) {
+			result = append(result, statement)
+		}
+	}
+	return result
+}
+
+// Reset forgets the statements run so far, e.g. the ones migrating the schema
+func (database *Database) Reset() {
+	database.mutex.Lock()
+	defer database.mutex.Unlock()
+	database.statements = nil
+}
+
+func (database *Database) run(query string, args []driver.NamedValue) Result {
+	database.mutex.Lock()
+	database.statements = append(database.statements, query)
+	database.mutex.Unlock()
+
+	if database.responder == nil {
+		return Result{}
+	}
+	values := make([]any, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+	return database.responder(query, values)
+}
+
+type connector struct {
+	database *Database
+}
+
+func (c connector) Connect(context.Context) (driver.Conn, error) {
+	return &conn{database: c.database}, nil
+}
+
+func (c connector) Driver() driver.Driver {
+	return fakeDriver{c.database}
+}
+
+type fakeDriver struct {
+	database *Database
+}
+
+func (d fakeDriver) Open(string) (driver.Conn, error) {
+	return &conn{database: d.database}, nil
+}
+
+type conn struct {
+	database *Database
+}
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return &stmt{conn: c, query: query}, nil
+}
+
+func (c *conn) Close() error {
+	return nil
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *conn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	c.database.run("BEGIN", nil)
+	return tx{c}, nil
+}
+
+func (c *conn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	result := c.database.run(query, args)
+	if result.Err != nil {
+		return nil, result.Err
+	}
+	return driver.RowsAffected(result.RowsAffected), nil
+}
+
+func (c *conn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	result := c.database.run(query, args)
+	if result.Err != nil {
+		return nil, result.Err
+	}
+	return &rows{columns: result.Columns, values: result.Rows}, nil
+}
+
+// CheckNamedValue passes the arguments as they are, so the responder gets the values gorm binds
+func (c *conn) CheckNamedValue(*driver.NamedValue) error {
+	return nil
+}
+
+type tx struct {
+	conn *conn
+}
+
+func (t tx) Commit() error {
+	return t.conn.database.run("COMMIT", nil).Err
+}
+
+func (t tx) Rollback() error {
+	return t.conn.database.run("ROLLBACK", nil).Err
+}
+
+type stmt struct {
+	conn  *conn
+	query string
+}
+
+func (s *stmt) Close() error {
+	return nil
+}
+
+func (s *stmt) NumInput() int {
+	return -1
+}
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.conn.ExecContext(context.Background(), s.query, namedValues(args))
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.conn.QueryContext(context.Background(), s.query, namedValues(args))
+}
+
+func namedValues(args []driver.Value) []driver.NamedValue {
+	result := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		result[i] = driver.NamedValue{Ordinal: i + 1, Value: arg}
+	}
+	return result
+}
+
+type rows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *rows) Columns() []string {
+	return r.columns
+}
+
+func (r *rows) Close() error {
+	return nil
+}
+
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
@@ -0,0 +1,137 @@
+package db
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/config"
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"gorm.io/gorm/schema"
+	"reflect"
+)
+
+// encryptedPrefix marks the encrypted values, the values stored before the encryption was introduced have none
+var encryptedPrefix = []byte("enc1:")
+
+func init() {
+	schema.RegisterSerializer("encrypted", EncryptedSerializer{})
+}
+
+// EncryptedSerializer stores the []byte and string fields tagged with serializer:encrypted encrypted by AES-GCM
+// with the database encryption key, the encrypted strings are encoded by base64 to fit the text columns.
+// The values stored unencrypted are read as is
+type EncryptedSerializer struct{}
+
+func (EncryptedSerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue any) error {
+	if dbValue == nil {
+		return nil
+	}
+	var value []byte
+	switch it := dbValue.(type) {
+	case []byte:
+		value = it
+	case string:
+		value = []byte(it)
+	default:
+		return fmt.Errorf("unsupported value of encrypted field %s", field.Name)
+	}
+
+	isString := field.FieldType.Kind() == reflect.String
+	if bytes.HasPrefix(value, encryptedPrefix) {
+		var err error
+		if isString {
+			if value, err = base64.StdEncoding.DecodeString(string(value[len(encryptedPrefix):])); err != nil {
+				return err
+			}
+		}
+		if value, err = Decrypt(value); err != nil {
+			return err
+		}
+	}
+	if isString {
+		return field.Set(ctx, dst, string(value))
+	}
+	return field.Set(ctx, dst, value)
+}
+
+func (EncryptedSerializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue any) (any, error) {
+	switch value := fieldValue.(type) {
+	case []byte:
+		return Encrypt(value)
+	case string:
+		encrypted, err := Encrypt([]byte(value))
+		if err != nil {
+			return nil, err
+		}
+		return string(encryptedPrefix) + base64.StdEncoding.EncodeToString(encrypted[len(encryptedPrefix):]), nil
+	default:
+		return nil, fmt.Errorf("unsupported value of encrypted field %s", field.Name)
+	}
+}
+
+// Encrypt encrypts the value by the database encryption key, the random nonce precedes the cipher text
+func Encrypt(value []byte) ([]byte, error) {
+	aead, err := newAead()
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	result := append(append([]byte{}, encryptedPrefix...), nonce...)
+	return aead.Seal(result, nonce, value, nil), nil
+}
+
+// Decrypt decrypts the value encrypted by Encrypt
+func Decrypt(value []byte) ([]byte, error) {
+	aead, err := newAead()
+	if err != nil {
+		return nil, err
+	}
+	value = bytes.TrimPrefix(value, encryptedPrefix)
+	if len(value) < aead.NonceSize() {
+		return nil, errors.New("encrypted value is too short")
+	}
+	return aead.Open(nil, value[:aead.NonceSize()], value[aead.NonceSize():], nil)
+}
+
+func newAead() (cipher.AEAD, error) {
+	key, err := config.CoreConfig.Database.GetEncryptionKey()
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
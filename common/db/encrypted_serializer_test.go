@@ -0,0 +1,88 @@
+package db
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/config"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"gorm.io/gorm/schema"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type encryptedEntity struct {
+	Secret     string `gorm:"serializer:encrypted"`
+	PrivateKey []byte `gorm:"serializer:encrypted"`
+}
+
+func TestEncryptedSerializer(t *testing.T) {
+	config.CoreConfig.Database.EncryptionKey = base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))
+	entitySchema, err := schema.Parse(&encryptedEntity{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		field  string
+		value  any
+		stored any
+	}{
+		{"string", "Secret", "JBSWY3DPEHPK3PXP", nil},
+		{"bytes", "PrivateKey", []byte("private key"), nil},
+		{"legacy string", "Secret", "JBSWY3DPEHPK3PXP", "JBSWY3DPEHPK3PXP"},
+		{"legacy bytes", "PrivateKey", []byte("private key"), []byte("private key")},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			field := entitySchema.LookUpField(test.field)
+			stored := test.stored
+			if stored == nil {
+				if stored, err = field.Serializer.Value(ctx, field, reflect.Value{}, test.value); err != nil {
+					t.Fatalf("Value() error = %v", err)
+				}
+				if reflect.TypeOf(stored) != reflect.TypeOf(test.value) {
+					t.Fatalf("Value() type = %T, want %T", stored, test.value)
+				}
+				if !strings.HasPrefix(fmt.Sprintf("%s", stored), string(encryptedPrefix)) {
+					t.Fatalf("Value() = %v, isn't encrypted", stored)
+				}
+			}
+
+			entity := &encryptedEntity{}
+			if err := field.Serializer.Scan(ctx, field, reflect.ValueOf(entity).Elem(), stored); err != nil {
+				t.Fatalf("Scan() error = %v", err)
+			}
+			if got := field.ReflectValueOf(ctx, reflect.ValueOf(entity).Elem()).Interface(); !reflect.DeepEqual(got, test.value) {
+				t.Errorf("Scan() = %v, want %v", got, test.value)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package db
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/util"
+	"context"
+	"gorm.io/gorm"
+)
+
+type transactionKey struct{}
+
+// InTransaction runs the function in one database transaction, the queries made by the repositories
+// with the context passed to the function are the part of the transaction. The transaction is rolled
+// back if the function panics
+func (ds *DataSource) InTransaction(ctx context.Context, fn func(ctx context.Context)) {
+	if _, ok := ctx.Value(transactionKey{}).(*gorm.DB); ok {
+		fn(ctx)
+		return
+	}
+	util.Must(ds.DB.Transaction(func(tx *gorm.DB) error {
+		fn(context.WithValue(ctx, transactionKey{}, tx))
+		return nil
+	}))
+}
+
+// For returns the transaction the context is bound to or the data source outside transactions
+func (ds *DataSource) For(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value(transactionKey{}).(*gorm.DB); ok {
+		return tx
+	}
+	return ds.DB
+}
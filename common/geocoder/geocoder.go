@@ -0,0 +1,82 @@
+package geocoder
+
+import (
+	"assets/common/config"
+	"context"
+	"errors"
+	"strings"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const (
+	NominatimProvider = "nominatim"
+	StubProvider      = "stub"
+)
+
+var ErrAddressNotFound = errors.New("address not found")
+
+var geocoderInstance Geocoder
+
+type Location struct {
+	Latitude    float64
+	Longitude   float64
+	City        string
+	CountryCode string
+	DisplayName string
+}
+
+// Geocoder resolves a free-text address to coordinates and the city it belongs to
+type Geocoder interface {
+	Geocode(ctx context.Context, address string) (Location, error)
+}
+
+// GetGeocoder returns the geocoder of the configured provider or nil if geocoding is disabled
+func GetGeocoder() Geocoder {
+	if geocoderInstance != nil {
+		return geocoderInstance
+	}
+
+	property := config.CoreConfig.Geocoder
+	switch strings.ToLower(property.Provider) {
+	case NominatimProvider:
+		geocoderInstance = newNominatimGeocoder(property)
+	case StubProvider:
+		geocoderInstance = newStubGeocoder(property)
+	}
+	return geocoderInstance
+}
+
+// IsSameCity compares city names ignoring case and the common prefixes like "г."
+func (location Location) IsSameCity(city string) bool {
+	normalize := func(name string) string {
+		name = strings.ToLower(strings.TrimSpace(name))
+		for _, prefix := range []string{"город ", "г. ", "г.", "city of "} {
+			name = strings.TrimPrefix(name, prefix)
+		}
+		return strings.ReplaceAll(name, "ё", "е")
+	}
+	return location.City == "" || normalize(location.City) == normalize(city)
+}
@@ -0,0 +1,124 @@
+package geocoder
+
+import (
+	"assets/common/config"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+type nominatimGeocoder struct {
+	url       string
+	userAgent string
+	email     string
+	client    *http.Client
+}
+
+type nominatimPlace struct {
+	Lat         string            `json:"lat"`
+	Lon         string            `json:"lon"`
+	DisplayName string            `json:"display_name"`
+	Address     map[string]string `json:"address"`
+}
+
+func newNominatimGeocoder(property config.GeocoderProperty) Geocoder {
+	geocoder := &nominatimGeocoder{
+		url:       strings.TrimSuffix(property.Url, "/"),
+		userAgent: property.UserAgent,
+		email:     property.Email,
+		client:    &http.Client{Timeout: property.Timeout},
+	}
+	if geocoder.url == "" {
+		geocoder.url = "https://nominatim.openstreetmap.org"
+	}
+	if geocoder.userAgent == "" {
+		geocoder.userAgent = "assets"
+	}
+	if geocoder.client.Timeout == 0 {
+		geocoder.client.Timeout = 10 * time.Second
+	}
+	return geocoder
+}
+
+func (geocoder *nominatimGeocoder) Geocode(ctx context.Context, address string) (Location, error) {
+	query := url.Values{}
+	query.Set("q", address)
+	query.Set("format", "jsonv2")
+	query.Set("addressdetails", "1")
+	query.Set("limit", "1")
+	if geocoder.email != "" {
+		query.Set("email", geocoder.email)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, geocoder.url+"/search?"+query.Encode(), nil)
+	if err != nil {
+		return Location{}, err
+	}
+	request.Header.Set("User-Agent", geocoder.userAgent)
+	request.Header.Set("Accept-Language", "ru,en")
+
+	response, err := geocoder.client.Do(request)
+	if err != nil {
+		return Location{}, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return Location{}, fmt.Errorf("nominatim responded with %d", response.StatusCode)
+	}
+
+	var places []nominatimPlace
+	if err = json.NewDecoder(response.Body).Decode(&places); err != nil {
+		return Location{}, err
+	}
+	if len(places) == 0 {
+		return Location{}, ErrAddressNotFound
+	}
+
+	place := places[0]
+	location := Location{
+		DisplayName: place.DisplayName,
+		CountryCode: strings.ToUpper(place.Address["country_code"]),
+	}
+	for _, key := range []string{"city", "town", "village", "municipality", "state"} {
+		if city, ok := place.Address[key]; ok {
+			location.City = city
+			break
+		}
+	}
+	if location.Latitude, err = strconv.ParseFloat(place.Lat, 64); err != nil {
+		return Location{}, err
+	}
+	if location.Longitude, err = strconv.ParseFloat(place.Lon, 64); err != nil {
+		return Location{}, err
+	}
+	return location, nil
+}
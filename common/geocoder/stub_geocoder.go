@@ -0,0 +1,59 @@
+package geocoder
+
+import (
+	"assets/common/config"
+	"context"
+	"strings"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+// stubGeocoder resolves only the addresses listed in the configuration, it's used locally and in tests
+// instead of the external service. Configuration keys are lowercased by viper, so the lookup is case-insensitive
+type stubGeocoder struct {
+	locations map[string]config.GeocodeLocation
+}
+
+func newStubGeocoder(property config.GeocoderProperty) Geocoder {
+	locations := make(map[string]config.GeocodeLocation, len(property.StubLocations))
+	for address, location := range property.StubLocations {
+		locations[strings.ToLower(strings.TrimSpace(address))] = location
+	}
+	return &stubGeocoder{locations: locations}
+}
+
+func (geocoder *stubGeocoder) Geocode(ctx context.Context, address string) (Location, error) {
+	location, ok := geocoder.locations[strings.ToLower(strings.TrimSpace(address))]
+	if !ok {
+		return Location{}, ErrAddressNotFound
+	}
+	return Location{
+		Latitude:    location.Latitude,
+		Longitude:   location.Longitude,
+		City:        location.City,
+		CountryCode: strings.ToUpper(location.CountryCode),
+		DisplayName: address,
+	}, nil
+}
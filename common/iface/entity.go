@@ -28,4 +28,5 @@ import "github.com/google/uuid"
 
 type Entity interface {
 	GetID() uuid.UUID
+	GetVersion() int64
 }
@@ -1,5 +1,7 @@
 package iface
 
+import "time"
+
 /*
  * Copyright © 2024, "DEADLINE TEAM" LLC
  *
@@ -28,3 +30,9 @@ type HttpError interface {
 	Error() string
 	Code() int
 }
+
+// RetryableHttpError tells the client when the request may be repeated
+type RetryableHttpError interface {
+	HttpError
+	RetryAfter() time.Duration
+}
@@ -29,8 +29,10 @@ import (
 	"assets/common/iface"
 	"github.com/gin-gonic/gin"
 	log "github.com/sirupsen/logrus"
+	"math"
 	"net/http"
 	"net/http/httputil"
+	"strconv"
 )
 
 func HttpRequestLogger(ctx *gin.Context) {
@@ -43,6 +45,9 @@ func HttpRequestLogger(ctx *gin.Context) {
 
 var Recovery = gin.CustomRecovery(func(ctx *gin.Context, payload any) {
 	if err, ok := payload.(error); ok {
+		if retryableError, ok := err.(iface.RetryableHttpError); ok {
+			ctx.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryableError.RetryAfter().Seconds()))))
+		}
 		if httpError, ok := err.(iface.HttpError); ok {
 			ctx.AbortWithStatusJSON(httpError.Code(), gin.H{"message": httpError.Error()})
 		} else {
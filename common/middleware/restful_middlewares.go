@@ -25,9 +25,13 @@ package middleware
  */
 
 import (
+	"assets/common/custom_error"
+	"assets/common/iface"
 	"assets/common/model"
 	"assets/common/util"
+	"context"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 func PaginationHandler(ctx *gin.Context) {
@@ -36,3 +40,38 @@ func PaginationHandler(ctx *gin.Context) {
 	sortStr, _ := ctx.GetQuery("sort")
 	util.SetPageable(ctx, model.NewPageable(size, page).WithSortString(sortStr))
 }
+
+func FilterHandler[T any](ctx *gin.Context) {
+	var filter T
+	util.Must(ctx.ShouldBindQuery(&filter))
+	util.SetFilterObject(ctx, filter)
+}
+
+// IfMatchHandler requires the If-Match header to hold the strong ETag of the stored entity found by the path id,
+// weak ETags never match (RFC 9110 section 13.1.1). The matching version is kept in the context to lock the entity
+// against concurrent updates and deletion
+func IfMatchHandler[T iface.Entity](getById func(ctx context.Context, id uuid.UUID) T) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		header := ctx.GetHeader("If-Match")
+		if header == "" {
+			panic(custom_error.PreconditionRequiredError)
+		}
+		if header == util.AnyETag {
+			return
+		}
+		versions, ok := util.ParseStrongETags(header)
+		if !ok {
+			panic(custom_error.PreconditionFailedError)
+		}
+
+		version, entityId := versions[0], uuid.Nil
+		if id := ctx.Param("id"); id != "" {
+			entityId = uuid.MustParse(id)
+			version = getById(ctx, entityId).GetVersion()
+			if !util.ArrayContains(versions, version) {
+				panic(custom_error.PreconditionFailedError)
+			}
+		}
+		util.SetIfMatch(ctx, entityId, version)
+	}
+}
@@ -25,14 +25,55 @@ package middleware
  */
 
 import (
+	"assets/common/cache"
 	"assets/common/custom_error"
+	"assets/common/model"
 	"assets/common/util"
+	"context"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"strings"
 )
 
+// ApiTokenResolver returns the token info of the personal access token or the api key, it panics
+// when the token is unknown or expired
+type ApiTokenResolver func(ctx context.Context, token string) model.TokenInfo
+
+var apiTokenResolver ApiTokenResolver
+
+// SetApiTokenResolver registers the source of the tokens of the Token authorization scheme
+func SetApiTokenResolver(resolver ApiTokenResolver) {
+	apiTokenResolver = resolver
+}
+
+// SessionTracker records the use of the session of the access token
+type SessionTracker func(ctx context.Context, tokenInfo model.TokenInfo)
+
+var sessionTracker SessionTracker
+
+// SetSessionTracker registers the tracker of the sessions used by the requests
+func SetSessionTracker(tracker SessionTracker) {
+	sessionTracker = tracker
+}
+
+// SecurityHandler accepts the access token and the api tokens of the integrations
 func SecurityHandler(ctx *gin.Context) {
+	authorize(ctx, model.AccessTokenType, model.ApiTokenType)
+}
+
+// SessionSecurityHandler accepts the access token of the signed in user only, the api tokens can't
+// be used to manage the credentials
+func SessionSecurityHandler(ctx *gin.Context) {
+	authorize(ctx, model.AccessTokenType)
+}
+
+// MfaSecurityHandler accepts the MFA token of the login waiting for the second factor besides the access token,
+// so the users obliged to use MFA can enrol before their first login
+func MfaSecurityHandler(ctx *gin.Context) {
+	authorize(ctx, model.AccessTokenType, model.MfaTokenType)
+}
+
+func authorize(ctx *gin.Context, tokenTypes ...string) {
 	convertQueryToAccessToken(ctx)
 	convertCookieToAccessToken(ctx)
 
@@ -46,7 +87,23 @@ func SecurityHandler(ctx *gin.Context) {
 
 	switch strings.ToLower(tokenType) {
 	case "bearer":
-		util.SetCurrentTokenInfo(ctx, util.ParseJwtToken(token))
+		tokenInfo := util.ParseJwtToken(token)
+		if !util.ArrayContains(tokenTypes, tokenInfo.TokenType) {
+			panic(custom_error.TokenInvalidError)
+		}
+		if cache.IsTokenRevoked(ctx, tokenInfo) {
+			panic(custom_error.TokenRevokedError)
+		}
+		util.SetCurrentTokenInfo(ctx, tokenInfo)
+		if sessionTracker != nil && tokenInfo.SessionId != "" {
+			sessionTracker(ctx, tokenInfo)
+		}
+
+	case "token":
+		if apiTokenResolver == nil || !util.ArrayContains(tokenTypes, model.ApiTokenType) {
+			panic(custom_error.UnsupportedTokenTypeError)
+		}
+		util.SetCurrentTokenInfo(ctx, apiTokenResolver(ctx, token))
 
 	default:
 		panic(custom_error.UnsupportedTokenTypeError)
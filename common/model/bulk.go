@@ -0,0 +1,84 @@
+package model
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import "github.com/google/uuid"
+
+const (
+	AllOrNothingBulkMode = "ALL_OR_NOTHING"
+	PartialBulkMode      = "PARTIAL"
+)
+
+// RolledBackMessage is the error of the items which were valid, but rolled back with the whole request
+const RolledBackMessage = "rolled back"
+
+// BulkItemResult is the outcome of one item of a bulk request, the item is referred by its position in the request
+type BulkItemResult struct {
+	Index   int        `json:"index"`
+	ID      *uuid.UUID `json:"id,omitempty"`
+	Success bool       `json:"success"`
+	Error   string     `json:"error,omitempty"`
+}
+
+// BulkResult holds the outcome of every item of a bulk request. RolledBack is set when the request was
+// to be applied as a whole and nothing was stored because of the failed items
+type BulkResult struct {
+	Items      []BulkItemResult `json:"items"`
+	Succeeded  int              `json:"succeeded"`
+	Failed     int              `json:"failed"`
+	RolledBack bool             `json:"rolledBack,omitempty"`
+}
+
+func NewBulkResult(size int) *BulkResult {
+	result := &BulkResult{Items: make([]BulkItemResult, size)}
+	for i := range result.Items {
+		result.Items[i].Index = i
+	}
+	return result
+}
+
+func (result *BulkResult) Succeed(index int, id uuid.UUID) {
+	result.Items[index].ID, result.Items[index].Success, result.Items[index].Error = &id, true, ""
+	result.Succeeded++
+}
+
+func (result *BulkResult) Fail(index int, err error) {
+	result.Items[index].Success, result.Items[index].Error = false, err.Error()
+	result.Failed++
+}
+
+func (result *BulkResult) IsFailed(index int) bool {
+	return result.Items[index].Error != ""
+}
+
+// RollBack marks all the items but the failed ones as rolled back
+func (result *BulkResult) RollBack() {
+	for i := range result.Items {
+		if !result.IsFailed(i) {
+			result.Items[i].Success, result.Items[i].Error = false, RolledBackMessage
+		}
+	}
+	result.Succeeded, result.RolledBack = 0, true
+}
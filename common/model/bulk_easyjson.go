@@ -0,0 +1,236 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	uuid "github.com/google/uuid"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson96d41fe8DecodeAssetsCommonModel(in *jlexer.Lexer, out *BulkResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]BulkItemResult, 0, 1)
+					} else {
+						out.Items = []BulkItemResult{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 BulkItemResult
+					(v1).UnmarshalEasyJSON(in)
+					out.Items = append(out.Items, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "succeeded":
+			out.Succeeded = int(in.Int())
+		case "failed":
+			out.Failed = int(in.Int())
+		case "rolledBack":
+			out.RolledBack = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson96d41fe8EncodeAssetsCommonModel(out *jwriter.Writer, in BulkResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix[1:])
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Items {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"succeeded\":"
+		out.RawString(prefix)
+		out.Int(int(in.Succeeded))
+	}
+	{
+		const prefix string = ",\"failed\":"
+		out.RawString(prefix)
+		out.Int(int(in.Failed))
+	}
+	if in.RolledBack {
+		const prefix string = ",\"rolledBack\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.RolledBack))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BulkResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson96d41fe8EncodeAssetsCommonModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BulkResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson96d41fe8EncodeAssetsCommonModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BulkResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson96d41fe8DecodeAssetsCommonModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BulkResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson96d41fe8DecodeAssetsCommonModel(l, v)
+}
+func easyjson96d41fe8DecodeAssetsCommonModel1(in *jlexer.Lexer, out *BulkItemResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "index":
+			out.Index = int(in.Int())
+		case "id":
+			if in.IsNull() {
+				in.Skip()
+				out.ID = nil
+			} else {
+				if out.ID == nil {
+					out.ID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.ID).UnmarshalText(data))
+				}
+			}
+		case "success":
+			out.Success = bool(in.Bool())
+		case "error":
+			out.Error = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson96d41fe8EncodeAssetsCommonModel1(out *jwriter.Writer, in BulkItemResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"index\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Index))
+	}
+	if in.ID != nil {
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.RawText((*in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Success))
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BulkItemResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson96d41fe8EncodeAssetsCommonModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BulkItemResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson96d41fe8EncodeAssetsCommonModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BulkItemResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson96d41fe8DecodeAssetsCommonModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BulkItemResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson96d41fe8DecodeAssetsCommonModel1(l, v)
+}
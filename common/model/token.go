@@ -28,8 +28,20 @@ import (
 	"fmt"
 	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
+	"time"
 )
 
+const (
+	AccessTokenType  = "access"
+	RefreshTokenType = "refresh"
+	// MfaTokenType is the token of the login waiting for the second factor, it only allows MFA enrolment
+	MfaTokenType = "mfa"
+	// ApiTokenType is the personal access token or the api key of the Token authorization scheme
+	ApiTokenType = "api"
+)
+
+// TokenInfo is the claims of the issued tokens. The tokens issued at one login share the session id,
+// it is kept when the refresh token is rotated. ParentId of the refresh token is the id of its access token
 type TokenInfo struct {
 	jwt.StandardClaims
 	UserId      uuid.UUID `json:"userId,omitempty"`
@@ -41,8 +53,23 @@ type TokenInfo struct {
 	Roles       []string  `json:"roles,omitempty"`
 	Authorities []string  `json:"authorities,omitempty"`
 	ParentId    string    `json:"ati,omitempty"`
+	TokenType   string    `json:"typ,omitempty"`
+	SessionId   string    `json:"sid,omitempty"`
+	ClientId    string    `json:"client_id,omitempty"`
+	Scope       string    `json:"scope,omitempty"`
+	// IssuedAtMs is the issue time in milliseconds, so the revocation tells apart the tokens issued within its second
+	IssuedAtMs int64 `json:"iat_ms,omitempty"`
 }
 
 func (tokenInfo TokenInfo) GetFullName() string {
 	return fmt.Sprintf("%s %s", tokenInfo.FirstName, tokenInfo.LastName)
 }
+
+// GetIssueTime returns the issue time of the token, the tokens without the milliseconds are taken
+// as issued at the start of their second
+func (tokenInfo TokenInfo) GetIssueTime() time.Time {
+	if tokenInfo.IssuedAtMs != 0 {
+		return time.UnixMilli(tokenInfo.IssuedAtMs)
+	}
+	return time.Unix(tokenInfo.IssuedAt, 0)
+}
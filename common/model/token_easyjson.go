@@ -98,6 +98,16 @@ func easyjsonF041b085DecodeAssetsCommonModel(in *jlexer.Lexer, out *TokenInfo) {
 			}
 		case "ati":
 			out.ParentId = string(in.String())
+		case "typ":
+			out.TokenType = string(in.String())
+		case "sid":
+			out.SessionId = string(in.String())
+		case "client_id":
+			out.ClientId = string(in.String())
+		case "scope":
+			out.Scope = string(in.String())
+		case "iat_ms":
+			out.IssuedAtMs = int64(in.Int64())
 		case "aud":
 			out.Audience = string(in.String())
 		case "exp":
@@ -230,6 +240,56 @@ func easyjsonF041b085EncodeAssetsCommonModel(out *jwriter.Writer, in TokenInfo)
 		}
 		out.String(string(in.ParentId))
 	}
+	if in.TokenType != "" {
+		const prefix string = ",\"typ\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.TokenType))
+	}
+	if in.SessionId != "" {
+		const prefix string = ",\"sid\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.SessionId))
+	}
+	if in.ClientId != "" {
+		const prefix string = ",\"client_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ClientId))
+	}
+	if in.Scope != "" {
+		const prefix string = ",\"scope\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Scope))
+	}
+	if in.IssuedAtMs != 0 {
+		const prefix string = ",\"iat_ms\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.IssuedAtMs))
+	}
 	if in.Audience != "" {
 		const prefix string = ",\"aud\":"
 		if first {
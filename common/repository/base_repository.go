@@ -31,8 +31,8 @@ import (
 	"assets/common/model"
 	"assets/common/util"
 	"context"
-	"fmt"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
@@ -45,14 +45,47 @@ type Repository[T iface.Entity] interface {
 	DeleteById(ctx context.Context, ids ...uuid.UUID)
 }
 
+// Scope restricts queries of the repository, e.g. to the rows available to the current user
+type Scope func(ctx context.Context, db *gorm.DB) *gorm.DB
+
 type baseRepository[T iface.Entity] struct {
-	ds *db.DataSource
+	ds         *db.DataSource
+	readScope  Scope
+	writeScope Scope
+	// readOnlyColumns are never written on create and update, they are changed by the dedicated queries
+	readOnlyColumns []string
+}
+
+type RepositoryOption[T iface.Entity] func(*baseRepository[T])
+
+func WithReadScope[T iface.Entity](scope Scope) RepositoryOption[T] {
+	return func(baseRepo *baseRepository[T]) {
+		baseRepo.readScope = scope
+	}
+}
+
+func WithWriteScope[T iface.Entity](scope Scope) RepositoryOption[T] {
+	return func(baseRepo *baseRepository[T]) {
+		baseRepo.writeScope = scope
+	}
+}
+
+// WithReadOnlyColumns keeps the columns and associations unchanged on create and update
+func WithReadOnlyColumns[T iface.Entity](columns ...string) RepositoryOption[T] {
+	return func(baseRepo *baseRepository[T]) {
+		baseRepo.readOnlyColumns = append(baseRepo.readOnlyColumns, columns...)
+	}
 }
 
-func NewBaseRepository[T iface.Entity](dataSource *db.DataSource) Repository[T] {
+func NewBaseRepository[T iface.Entity](dataSource *db.DataSource, opts ...RepositoryOption[T]) Repository[T] {
 	var entity T
 	util.Must(dataSource.AutoMigrate(&entity))
-	return &baseRepository[T]{ds: dataSource}
+
+	baseRepo := &baseRepository[T]{ds: dataSource}
+	for _, opt := range opts {
+		opt(baseRepo)
+	}
+	return baseRepo
 }
 
 func (baseRepo *baseRepository[T]) GetById(ctx context.Context, ids []uuid.UUID) []T {
@@ -61,29 +94,37 @@ func (baseRepo *baseRepository[T]) GetById(ctx context.Context, ids []uuid.UUID)
 	}
 
 	var result []T
-	util.Must(baseRepo.ds.Preload(clause.Associations).Where("id in ?", util.Map(ids, func(it uuid.UUID) string { return it.String() })).Find(&result).Error)
+	util.Must(baseRepo.read(ctx).Preload(clause.Associations).Where("id in ?", util.Map(ids, func(it uuid.UUID) string { return it.String() })).Find(&result).Error)
 	return result
 }
 
 func (baseRepo *baseRepository[T]) GetAll(ctx context.Context) []T {
 	var result []T
-	util.Must(baseRepo.ds.Preload(clause.Associations).Find(&result).Error)
+	util.Must(baseRepo.read(ctx).Preload(clause.Associations).Find(&result).Error)
 	return result
 }
 
 func (baseRepo *baseRepository[T]) GetAllWithPage(ctx context.Context, page model.Pageable) model.Page[T] {
 	var result []T
-	util.Must(baseRepo.ds.Preload(clause.Associations).Limit(page.Size).Offset(page.Page * page.Size).Order(fmt.Sprintf("%s %s", page.Sort.Field, page.Sort.Order)).Find(&result).Error)
+	query := baseRepo.read(ctx).Preload(clause.Associations).Limit(page.Size).Offset(page.Page * page.Size)
+	query = OrderBy[T](query, page.Sort, "")
+	util.Must(query.Find(&result).Error)
 	return model.NewPage[T](result, page)
 }
 
 func (baseRepo *baseRepository[T]) Create(ctx context.Context, entities []T) []T {
-	util.Must(baseRepo.ds.Preload(clause.Associations).Create(&entities).Error)
+	util.Must(baseRepo.ds.For(ctx).Omit(baseRepo.readOnlyColumns...).Preload(clause.Associations).Create(&entities).Error)
 	return entities
 }
 
 func (baseRepo *baseRepository[T]) Update(ctx context.Context, entities []T) []T {
-	util.Must(baseRepo.ds.Preload(clause.Associations).Save(&entities).Error)
+	baseRepo.checkWriteAccess(ctx, util.Map(entities, func(it T) uuid.UUID { return it.GetID() }))
+	util.Must(baseRepo.ds.For(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := LockVersions(tx, entities); err != nil {
+			return err
+		}
+		return tx.Omit(baseRepo.readOnlyColumns...).Preload(clause.Associations).Save(&entities).Error
+	}))
 	return entities
 }
 
@@ -91,7 +132,89 @@ func (baseRepo *baseRepository[T]) DeleteById(ctx context.Context, ids ...uuid.U
 	if len(ids) == 0 {
 		panic(custom_error.IllegalArgumentError)
 	}
+	baseRepo.checkWriteAccess(ctx, ids)
 
 	var entity []T
-	util.Must(baseRepo.ds.Where("id in ?", util.Map(ids, func(it uuid.UUID) string { return it.String() })).Delete(&entity).Error)
+	query, locked := LockDeleteVersion(ctx, baseRepo.ds.For(ctx).Where("id in ?", util.Map(ids, func(it uuid.UUID) string { return it.String() })), ids)
+	util.Must(CheckDeleted(query.Delete(&entity), locked))
+}
+
+// LockDeleteVersion deletes the entity only if it has the version required by If-Match, so the entity
+// changed after the If-Match was checked isn't deleted. It tells whether the version is locked
+func LockDeleteVersion(ctx context.Context, query *gorm.DB, ids []uuid.UUID) (*gorm.DB, bool) {
+	if len(ids) != 1 {
+		return query, false
+	}
+	if version, ok := util.GetIfMatchOf(ctx, ids[0]); ok {
+		return query.Where("version = ?", version), true
+	}
+	return query, false
+}
+
+// CheckDeleted returns the error of the delete, or PreconditionFailedError if the entity locked by its version
+// is changed in the meantime
+func CheckDeleted(result *gorm.DB, locked bool) error {
+	if result.Error != nil {
+		return result.Error
+	}
+	if locked && result.RowsAffected == 0 {
+		return custom_error.PreconditionFailedError
+	}
+	return nil
+}
+
+func (baseRepo *baseRepository[T]) read(ctx context.Context) *gorm.DB {
+	if baseRepo.readScope == nil {
+		return baseRepo.ds.For(ctx)
+	}
+	return baseRepo.readScope(ctx, baseRepo.ds.For(ctx))
+}
+
+// checkWriteAccess panics if any of the existing entities is outside the write scope,
+// ids which are not stored yet are left to be created
+func (baseRepo *baseRepository[T]) checkWriteAccess(ctx context.Context, ids []uuid.UUID) {
+	if baseRepo.writeScope == nil || len(ids) == 0 {
+		return
+	}
+
+	var entity T
+	idStrings := util.Unique(util.Map(ids, func(it uuid.UUID) string { return it.String() }))
+
+	var existing, writable int64
+	util.Must(baseRepo.ds.For(ctx).Model(&entity).Where("id in ?", idStrings).Count(&existing).Error)
+	util.Must(baseRepo.writeScope(ctx, baseRepo.ds.For(ctx).Model(&entity)).Where("id in ?", idStrings).Count(&writable).Error)
+	if writable < existing {
+		panic(custom_error.NotEnoughRightsError)
+	}
+}
+
+// LockVersions increases the stored versions of the entities and sets the new ones to the entities, it fails
+// if the stored version differs from the entity one. Entities without version are updated unconditionally
+// and the entities which aren't stored yet are left to be created
+func LockVersions[T iface.Entity](tx *gorm.DB, entities []T) error {
+	for i := range entities {
+		query := tx.Model(new(T)).Where("id = ?", entities[i].GetID())
+		if version := entities[i].GetVersion(); version != 0 {
+			query = query.Where("version = ?", version)
+		}
+		result := query.UpdateColumn("version", gorm.Expr("version + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+
+		var versions []int64
+		if err := tx.Model(new(T)).Where("id = ?", entities[i].GetID()).Pluck("version", &versions).Error; err != nil {
+			return err
+		}
+		if len(versions) == 0 {
+			continue
+		}
+		if result.RowsAffected == 0 {
+			return custom_error.PreconditionFailedError
+		}
+		if versioned, ok := any(&entities[i]).(interface{ SetVersion(version int64) }); ok {
+			versioned.SetVersion(versions[0])
+		}
+	}
+	return nil
 }
@@ -0,0 +1,73 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/custom_error"
+	"assets/common/util"
+	"context"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLockDeleteVersion(t *testing.T) {
+	db := newDryRunDB(t)
+	id := uuid.New()
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	util.SetIfMatch(ctx, id, 3)
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		ids        []uuid.UUID
+		wantLocked bool
+		wantErr    error
+	}{
+		{"entity checked by If-Match", ctx, []uuid.UUID{id}, true, custom_error.PreconditionFailedError},
+		{"other entity", ctx, []uuid.UUID{uuid.New()}, false, nil},
+		{"several entities", ctx, []uuid.UUID{id, uuid.New()}, false, nil},
+		{"no If-Match", context.Background(), []uuid.UUID{id}, false, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			query, locked := LockDeleteVersion(test.ctx, db.Where("id in ?", test.ids), test.ids)
+			if locked != test.wantLocked {
+				t.Fatalf("LockDeleteVersion() locked = %v, want %v", locked, test.wantLocked)
+			}
+			// the dry run deletes no rows, as if the entity is changed in the meantime
+			result := query.Delete(&sortedEntity{})
+			if sql := result.Statement.SQL.String(); strings.Contains(sql, "version") != test.wantLocked {
+				t.Errorf("LockDeleteVersion() sql = %s", sql)
+			}
+			if err := CheckDeleted(result, locked); !errors.Is(err, test.wantErr) {
+				t.Errorf("CheckDeleted() = %v, want %v", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/db"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+)
+
+const defaultBatchSize = 100
+
+var errRollBack = errors.New("roll back failed batches")
+
+// InBatches applies the action to the batches of the items within one transaction and returns the failures
+// by the item index. A failed batch is rolled back to its savepoint and retried item by item to find out the
+// failed items. Unless partial, any failure rolls back the whole transaction
+func InBatches[T any](ds *db.DataSource, items []T, batchSize int, partial bool, action func(tx *gorm.DB, batch []T) error) map[int]error {
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	failures := make(map[int]error)
+	err := ds.Transaction(func(tx *gorm.DB) error {
+		for from := 0; from < len(items); from += batchSize {
+			to := min(from+batchSize, len(items))
+			if err := inSavePoint(tx, fmt.Sprintf("batch_%d", from), func() error { return action(tx, items[from:to]) }); err == nil {
+				continue
+			}
+			for i := from; i < to; i++ {
+				if err := inSavePoint(tx, fmt.Sprintf("item_%d", i), func() error { return action(tx, items[i:i+1]) }); err != nil {
+					failures[i] = err
+				}
+			}
+		}
+		if !partial && len(failures) != 0 {
+			return errRollBack
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errRollBack) {
+		panic(err)
+	}
+	return failures
+}
+
+func inSavePoint(tx *gorm.DB, name string, action func() error) error {
+	if err := tx.SavePoint(name).Error; err != nil {
+		return err
+	}
+	if err := action(); err != nil {
+		if rollbackErr := tx.RollbackTo(name).Error; rollbackErr != nil {
+			return rollbackErr
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/db/dbtest"
+	"errors"
+	"gorm.io/gorm"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestInBatches(t *testing.T) {
+	errInsert := errors.New("insert failed")
+	items := []int{0, 1, 2, 3, 4}
+
+	tests := []struct {
+		name         string
+		failed       int
+		partial      bool
+		wantFailures []int
+		wantEnd      string
+		wantRollback []string
+	}{
+		{"all stored", -1, false, nil, "COMMIT", nil},
+		{"failed batch rolls back all batches", 3, false, []int{3}, "ROLLBACK", []string{"ROLLBACK TO SAVEPOINT batch_2", "ROLLBACK TO SAVEPOINT item_3"}},
+		{"failed item is skipped in partial mode", 3, true, []int{3}, "COMMIT", []string{"ROLLBACK TO SAVEPOINT batch_2", "ROLLBACK TO SAVEPOINT item_3"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ds, database := dbtest.NewDataSource(t, func(query string, args []any) dbtest.Result {
+				if slices.Contains(args, any(test.failed)) {
+					return dbtest.Result{Err: errInsert}
+				}
+				return dbtest.Result{RowsAffected: 1}
+			})
+
+			failures := InBatches(ds, items, 2, test.partial, func(tx *gorm.DB, batch []int) error {
+				for _, item := range batch {
+					if err := tx.Exec("insert into items values (?)", item).Error; err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+
+			var failed []int
+			for index, err := range failures {
+				if !errors.Is(err, errInsert) {
+					t.Errorf("InBatches() failure %d = %v, want %v", index, err, errInsert)
+				}
+				failed = append(failed, index)
+			}
+			if !reflect.DeepEqual(failed, test.wantFailures) {
+				t.Errorf("InBatches() failures = %v, want %v", failed, test.wantFailures)
+			}
+			statements := database.Statements()
+			if end := statements[len(statements)-1]; end != test.wantEnd {
+				t.Errorf("InBatches() ended by %s, want %s, statements %v", end, test.wantEnd, statements)
+			}
+			if rollbacks := database.Find("ROLLBACK TO"); !reflect.DeepEqual(rollbacks, test.wantRollback) {
+				t.Errorf("InBatches() rollbacks = %v, want %v", rollbacks, test.wantRollback)
+			}
+		})
+	}
+}
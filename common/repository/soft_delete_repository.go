@@ -0,0 +1,136 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/custom_error"
+	"assets/common/db"
+	"assets/common/iface"
+	"assets/common/model"
+	"assets/common/util"
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// SoftDeleteRepository moves the entities embedding model.SoftDeletable to the trash instead of deleting them,
+// they are restored from there or purged for good
+type SoftDeleteRepository[T iface.Entity] interface {
+	Repository[T]
+	FindDeleted(ctx context.Context, page model.Pageable) model.Page[T]
+	FindDeletedBefore(ctx context.Context, date time.Time) []T
+	Restore(ctx context.Context, ids ...uuid.UUID)
+	Purge(ctx context.Context, ids ...uuid.UUID)
+}
+
+type softDeleteRepository[T iface.Entity] struct {
+	*baseRepository[T]
+}
+
+func NewSoftDeleteRepository[T iface.Entity](dataSource *db.DataSource, opts ...RepositoryOption[T]) SoftDeleteRepository[T] {
+	baseRepo := NewBaseRepository[T](dataSource, opts...).(*baseRepository[T])
+	baseRepo.readOnlyColumns = append(baseRepo.readOnlyColumns, "deleted_at", "deleted_by")
+	return &softDeleteRepository[T]{baseRepo}
+}
+
+// DeleteById moves the entities to the trash marking them with the current user
+func (repo *softDeleteRepository[T]) DeleteById(ctx context.Context, ids ...uuid.UUID) {
+	if len(ids) == 0 {
+		panic(custom_error.IllegalArgumentError)
+	}
+	repo.checkWriteAccess(ctx, ids)
+	query, locked := LockDeleteVersion(ctx, repo.ds.For(ctx).Model(new(T)).Where("id in ?", ids), ids)
+	util.Must(CheckDeleted(softDelete(ctx, query), locked))
+}
+
+func (repo *softDeleteRepository[T]) FindDeleted(ctx context.Context, page model.Pageable) model.Page[T] {
+	query := repo.trash(ctx, repo.readScope)
+
+	var total int64
+	util.Must(query.Count(&total).Error)
+
+	var result []T
+	query = query.Preload(clause.Associations).Limit(page.Size).Offset(page.Page * page.Size)
+	query = OrderBy[T](query, page.Sort, "deleted_at desc")
+	util.Must(query.Find(&result).Error)
+	return model.NewPage[T](result, page).WithTotal(int(total))
+}
+
+// FindDeletedBefore returns the entities of all users moved to the trash before the date
+func (repo *softDeleteRepository[T]) FindDeletedBefore(ctx context.Context, date time.Time) []T {
+	var result []T
+	util.Must(repo.ds.For(ctx).Unscoped().Where("deleted_at < ?", date).Find(&result).Error)
+	return result
+}
+
+// Restore takes the entities out of the trash, all of them must be in the trash and writable by the current user
+func (repo *softDeleteRepository[T]) Restore(ctx context.Context, ids ...uuid.UUID) {
+	if len(ids) == 0 {
+		panic(custom_error.IllegalArgumentError)
+	}
+
+	var existing, writable int64
+	util.Must(repo.trash(ctx, nil).Where("id in ?", ids).Count(&existing).Error)
+	util.Must(repo.trash(ctx, repo.writeScope).Where("id in ?", ids).Count(&writable).Error)
+	if existing < int64(len(util.Unique(ids))) {
+		panic(custom_error.NotFoundError)
+	}
+	if writable < existing {
+		panic(custom_error.NotEnoughRightsError)
+	}
+	util.Must(repo.ds.For(ctx).Unscoped().Model(new(T)).Where("id in ?", ids).
+		Updates(map[string]any{"deleted_at": nil, "deleted_by": nil, "version": gorm.Expr("version + 1")}).Error)
+}
+
+// Purge deletes the entities for good
+func (repo *softDeleteRepository[T]) Purge(ctx context.Context, ids ...uuid.UUID) {
+	if len(ids) == 0 {
+		return
+	}
+	util.Must(repo.ds.For(ctx).Unscoped().Where("id in ?", ids).Delete(new(T)).Error)
+}
+
+func (repo *softDeleteRepository[T]) trash(ctx context.Context, scope Scope) *gorm.DB {
+	query := repo.ds.For(ctx).Unscoped().Model(new(T)).Where("deleted_at is not null")
+	if scope == nil {
+		return query
+	}
+	return scope(ctx, query)
+}
+
+// SoftDelete moves the rows matched by the query to the trash marking them with the current user
+func SoftDelete(ctx context.Context, query *gorm.DB) error {
+	return softDelete(ctx, query).Error
+}
+
+func softDelete(ctx context.Context, query *gorm.DB) *gorm.DB {
+	var deletedBy *uuid.UUID
+	if tokenInfo, ok := util.GetCurrentTokenInfo(ctx); ok {
+		deletedBy = &tokenInfo.UserId
+	}
+	return query.Updates(map[string]any{"deleted_at": time.Now(), "deleted_by": deletedBy})
+}
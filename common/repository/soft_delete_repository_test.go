@@ -0,0 +1,138 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/custom_error"
+	"assets/common/db/dbtest"
+	"assets/common/model"
+	"assets/common/util"
+	"context"
+	"database/sql/driver"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type trashedEntity struct {
+	ID      uuid.UUID `gorm:"type:uuid;primaryKey"`
+	OwnerID uuid.UUID `gorm:"type:uuid"`
+	model.Versioned
+	model.SoftDeletable
+}
+
+func (entity trashedEntity) GetID() uuid.UUID {
+	return entity.ID
+}
+
+// trashTable answers the counts of the soft delete repository like the rows would be stored,
+// the rows restricted by the owner are counted for their owner only
+type trashTable map[uuid.UUID]trashedEntity
+
+func (table trashTable) respond(query string, args []any) dbtest.Result {
+	if !strings.HasPrefix(query, "SELECT count(*)") {
+		return dbtest.Result{RowsAffected: 1}
+	}
+	var count int64
+	for _, arg := range args {
+		id, _ := arg.(uuid.UUID)
+		entity, ok := table[id]
+		if !ok || strings.Contains(query, "deleted_at is not null") && !entity.DeletedAt.Valid {
+			continue
+		}
+		if !strings.Contains(query, "owner_id") || util.ArrayContains(args, any(entity.OwnerID)) {
+			count++
+		}
+	}
+	return dbtest.Result{Columns: []string{"count"}, Rows: [][]driver.Value{{count}}}
+}
+
+func ownerScope(ctx context.Context, db *gorm.DB) *gorm.DB {
+	tokenInfo, _ := util.GetCurrentTokenInfo(ctx)
+	return db.Where("owner_id = ?", tokenInfo.UserId)
+}
+
+func TestSoftDeleteRepository(t *testing.T) {
+	owner, stranger := uuid.New(), uuid.New()
+	active := trashedEntity{ID: uuid.New(), OwnerID: owner}
+	trashed := trashedEntity{ID: uuid.New(), OwnerID: owner, SoftDeletable: model.SoftDeletable{DeletedAt: gorm.DeletedAt{Valid: true}}}
+	table := trashTable{active.ID: active, trashed.ID: trashed}
+
+	tests := []struct {
+		name      string
+		userId    uuid.UUID
+		run       func(ctx context.Context, repo SoftDeleteRepository[trashedEntity])
+		wantErr   any
+		wantSQL   string
+		unwantSQL string
+	}{
+		{"delete moves to the trash", owner, func(ctx context.Context, repo SoftDeleteRepository[trashedEntity]) {
+			repo.DeleteById(ctx, active.ID)
+		}, nil, `UPDATE "trashed_entities" SET "deleted_at"=$1,"deleted_by"=$2 WHERE`, "DELETE"},
+		{"restore of the trashed entity", owner, func(ctx context.Context, repo SoftDeleteRepository[trashedEntity]) {
+			repo.Restore(ctx, trashed.ID)
+		}, nil, `UPDATE "trashed_entities" SET "deleted_at"=$1,"deleted_by"=$2,"version"=version + 1`, "DELETE"},
+		{"restore of the entity outside the trash", owner, func(ctx context.Context, repo SoftDeleteRepository[trashedEntity]) {
+			repo.Restore(ctx, active.ID, trashed.ID)
+		}, custom_error.NotFoundError, "", "UPDATE"},
+		{"restore of the entity of another user", stranger, func(ctx context.Context, repo SoftDeleteRepository[trashedEntity]) {
+			repo.Restore(ctx, trashed.ID)
+		}, custom_error.NotEnoughRightsError, "", "UPDATE"},
+		{"purge deletes for good", owner, func(ctx context.Context, repo SoftDeleteRepository[trashedEntity]) {
+			repo.Purge(ctx, trashed.ID)
+		}, nil, `DELETE FROM "trashed_entities" WHERE id in`, "UPDATE"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ds, database := dbtest.NewDataSource(t, table.respond)
+			repo := NewSoftDeleteRepository[trashedEntity](ds, WithWriteScope[trashedEntity](ownerScope))
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			util.SetCurrentTokenInfo(ctx, model.TokenInfo{UserId: test.userId})
+			database.Reset()
+
+			err := catchPanic(func() { test.run(ctx, repo) })
+			if err != test.wantErr {
+				t.Fatalf("error = %v, want %v", err, test.wantErr)
+			}
+			if test.wantSQL != "" && len(database.Find(test.wantSQL)) == 0 {
+				t.Errorf("statements %v, want %s", database.Statements(), test.wantSQL)
+			}
+			if unwanted := database.Find(test.unwantSQL); len(unwanted) != 0 {
+				t.Errorf("statements %v, want no %s", unwanted, test.unwantSQL)
+			}
+		})
+	}
+}
+
+func catchPanic(run func()) (err any) {
+	defer func() {
+		err = recover()
+	}()
+	run()
+	return nil
+}
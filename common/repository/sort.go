@@ -0,0 +1,77 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/custom_error"
+	"assets/common/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+	"strings"
+	"sync"
+)
+
+var sortSchemas sync.Map
+
+// OrderBy orders the query by the sort of the page, or by the default order when the page isn't sorted.
+// The sort comes from the query string, so it is never put into the SQL as is: the field must be a column
+// of the model, named as the column or as the JSON field, and the order must be asc or desc
+func OrderBy[T any](query *gorm.DB, sort *model.Sort, defaultOrder string) *gorm.DB {
+	if sort == nil {
+		if defaultOrder == "" {
+			return query
+		}
+		return query.Order(defaultOrder)
+	}
+
+	column := SortColumn[T](sort.Field)
+	if column == "" || (sort.Order != "" && sort.Order != model.Asc && sort.Order != model.Desc) {
+		panic(custom_error.IllegalArgumentError)
+	}
+	return query.Order(clause.OrderByColumn{
+		Column: clause.Column{Table: clause.CurrentTable, Name: column},
+		Desc:   sort.Order == model.Desc,
+	})
+}
+
+// SortColumn returns the column of the model the field is sorted by, it is empty when the model has no such
+// column. The fields hidden from JSON, e.g. the password hashes, can't be sorted by
+func SortColumn[T any](field string) string {
+	entitySchema, err := schema.Parse(new(T), &sortSchemas, schema.NamingStrategy{})
+	if err != nil || field == "" {
+		return ""
+	}
+	for _, it := range entitySchema.Fields {
+		jsonName, _, _ := strings.Cut(it.Tag.Get("json"), ",")
+		if it.DBName == "" || jsonName == "-" {
+			continue
+		}
+		if field == it.DBName || field == it.Name || field == jsonName {
+			return it.DBName
+		}
+	}
+	return ""
+}
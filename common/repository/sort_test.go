@@ -0,0 +1,111 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/model"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"strings"
+	"testing"
+)
+
+type sortedEntity struct {
+	ID         string `json:"id,omitempty"`
+	CreateDate string `json:"createDate,omitempty"`
+	Password   string `json:"-"`
+	Parent     *sortedEntity
+	ParentID   *string `json:"parentId,omitempty"`
+}
+
+func TestSortColumn(t *testing.T) {
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{"create_date", "create_date"},
+		{"createDate", "create_date"},
+		{"CreateDate", "create_date"},
+		{"parentId", "parent_id"},
+		{"password", ""},
+		{"Password", ""},
+		{"Parent", ""},
+		{"unknown", ""},
+		{"", ""},
+		{"create_date; drop table users", ""},
+		{"(select 1)", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.field, func(t *testing.T) {
+			if got := SortColumn[sortedEntity](test.field); got != test.want {
+				t.Errorf("SortColumn(%q) = %q, want %q", test.field, got, test.want)
+			}
+		})
+	}
+}
+
+// newDryRunDB returns the database building the SQL without running it
+func newDryRunDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestOrderBy(t *testing.T) {
+	db := newDryRunDB(t)
+
+	tests := []struct {
+		name      string
+		sort      *model.Sort
+		wantOrder string
+		wantPanic bool
+	}{
+		{"default order", nil, "ORDER BY create_date desc", false},
+		{"ascending", model.NewSort("createDate", model.Asc), `ORDER BY "sorted_entities"."create_date"`, false},
+		{"descending", model.NewSort("id", model.Desc), `ORDER BY "sorted_entities"."id" DESC`, false},
+		{"unknown field", model.NewSort("name", model.Asc), "", true},
+		{"injected order", model.NewSort("id", "desc, (select 1)"), "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if recovered := recover(); (recovered != nil) != test.wantPanic {
+					t.Errorf("OrderBy() panic = %v, wantPanic %v", recovered, test.wantPanic)
+				}
+			}()
+			query := OrderBy[sortedEntity](db.Model(&sortedEntity{}), test.sort, "create_date desc")
+			sql := query.Find(&[]sortedEntity{}).Statement.SQL.String()
+			if !strings.HasSuffix(sql, test.wantOrder) {
+				t.Errorf("OrderBy() sql = %s, want suffix %s", sql, test.wantOrder)
+			}
+		})
+	}
+}
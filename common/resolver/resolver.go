@@ -28,16 +28,27 @@ import (
 	commonError "assets/common/custom_error"
 	"assets/common/util"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"io"
 	"reflect"
 )
 
+const (
+	MergePatchContentType = "application/merge-patch+json"
+	JsonPatchContentType  = "application/json-patch+json"
+)
+
 func Resolver[T any](ctx *gin.Context) {
 	var obj T
 	util.Must(ctx.ShouldBindJSON(&obj))
 	if reflect.ValueOf(obj).IsZero() {
 		panic(commonError.ParseZeroValueError)
 	}
+	setIfMatchVersion(ctx, &obj)
 	ctx.Set("RequestBody", obj)
 }
 
@@ -46,3 +57,73 @@ func BaseResolver[T any](context context.Context) T {
 	Resolver[T](ctx)
 	return ctx.MustGet("RequestBody").(T)
 }
+
+// UpdateResolver sets the entity of the request body replacing the stored one as the request body,
+// the path id wins over the one of the body and the entity is validated before saving
+func UpdateResolver[T any](ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	document := util.MustOne(io.ReadAll(ctx.Request.Body))
+
+	var obj T
+	if err := json.Unmarshal(document, &obj); err != nil {
+		panic(commonError.IllegalArgumentError)
+	}
+	if reflect.ValueOf(obj).IsZero() {
+		panic(commonError.ParseZeroValueError)
+	}
+	ctx.Set("RequestBody", resolveEntity[T](ctx, id, document))
+}
+
+// PatchResolver applies the request body to the stored entity found by the path id and sets the result as
+// the request body. The body is an RFC 6902 JSON patch if sent as such or being an array, otherwise it's
+// an RFC 7396 merge patch which must be an object. The path id wins over the one of the patch and
+// the result is validated before saving
+func PatchResolver[T any](getById func(ctx context.Context, id uuid.UUID) T) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := uuid.MustParse(ctx.Param("id"))
+		patch := util.MustOne(io.ReadAll(ctx.Request.Body))
+		document := util.MustOne(json.Marshal(getById(ctx, id)))
+
+		var err error
+		contentType := ctx.ContentType()
+		if contentType == JsonPatchContentType || contentType != MergePatchContentType && util.IsJsonArray(patch) {
+			document, err = util.ApplyJsonPatch(document, patch)
+		} else if util.IsJsonObject(patch) {
+			document, err = util.ApplyMergePatch(document, patch)
+		} else {
+			err = errors.New("merge patch isn't an object")
+		}
+		if err != nil {
+			panic(commonError.IllegalArgumentError)
+		}
+		ctx.Set("RequestBody", resolveEntity[T](ctx, id, document))
+	}
+}
+
+// resolveEntity decodes the entity of the JSON object with the path id, the entity is validated if it
+// implements the validation. The version required by If-Match is set to the entity
+func resolveEntity[T any](ctx *gin.Context, id uuid.UUID, document []byte) T {
+	if !util.IsJsonObject(document) {
+		panic(commonError.IllegalArgumentError)
+	}
+	document = util.MustOne(util.ApplyMergePatch(document, []byte(fmt.Sprintf(`{"id":%q}`, id))))
+
+	var obj T
+	if err := json.Unmarshal(document, &obj); err != nil {
+		panic(commonError.IllegalArgumentError)
+	}
+	if validatable, ok := any(obj).(interface{ IsValid() bool }); ok && !validatable.IsValid() {
+		panic(commonError.IllegalArgumentError)
+	}
+	setIfMatchVersion(ctx, &obj)
+	return obj
+}
+
+// setIfMatchVersion sets the version required by If-Match to the entity, so that it is updated only if not changed
+func setIfMatchVersion(ctx *gin.Context, obj any) {
+	if version, ok := util.GetIfMatch(ctx); ok {
+		if versioned, ok := obj.(interface{ SetVersion(version int64) }); ok {
+			versioned.SetVersion(version)
+		}
+	}
+}
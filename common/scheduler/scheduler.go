@@ -0,0 +1,84 @@
+package scheduler
+
+import (
+	"assets/common/db"
+	"assets/common/util"
+	"context"
+	log "github.com/sirupsen/logrus"
+	"github.com/xlab/closer"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+// Schedule runs the job periodically in background. The redis schedule database is used as a lock,
+// so when several application instances are started the job is run by only one of them per interval.
+func Schedule(name string, interval time.Duration, job func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+
+	closer.Bind(func() {
+		ticker.Stop()
+		close(done)
+		log.Infof("Scheduler %s stopped", name)
+	})
+
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				runJob(name, interval, job)
+			}
+		}
+	}()
+}
+
+func runJob(name string, interval time.Duration, job func(ctx context.Context)) {
+	ctx := context.Background()
+	defer util.DefaultRecovery(ctx)
+
+	// the lock lives a bit less than the interval, so it is free for the next tick
+	if !Lock(ctx, name, interval*9/10) {
+		return
+	}
+
+	log.Debugf("Scheduler %s: Start", name)
+	job(ctx)
+	log.Debugf("Scheduler %s: End", name)
+}
+
+// Lock takes the lock shared by the application instances for the ttl, false is returned when another instance
+// holds it. The work is done without the lock when the redis schedule database is unavailable
+func Lock(ctx context.Context, name string, ttl time.Duration) bool {
+	scheduleClient, err := db.GetRedisSchedulePool()
+	if err != nil {
+		log.WithError(err).Warnf("Couldn't get redis schedule pool, job %s is run without lock", name)
+		return true
+	}
+	acquired, err := scheduleClient.SetNX(ctx, "schedule:"+name, util.ApplicationName, ttl).Result()
+	return err == nil && acquired
+}
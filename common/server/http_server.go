@@ -63,6 +63,7 @@ func (server *httpServer) Run() {
 	}
 
 	router := gin.New()
+	util.Must(router.SetTrustedProxies(config.CoreConfig.Server.TrustedProxies))
 	router.Use(middleware.Recovery)
 	router.Use(util.AddCorsHeaders)
 	router.Use(util.TraceAppender)
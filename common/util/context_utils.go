@@ -25,10 +25,13 @@ package util
  */
 
 import (
+	"assets/common/custom_error"
 	"assets/common/model"
 	"context"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"strconv"
+	"time"
 )
 
 const (
@@ -36,6 +39,8 @@ const (
 	pageableKey     = "Pageable"
 	queryFieldsKey  = "QueryFields"
 	filterObjectKey = "FilterObject"
+	ifMatchKey      = "IfMatch"
+	ifMatchIdKey    = "IfMatchId"
 )
 
 func SetToContext[T any](ctx context.Context, key string, value T) {
@@ -43,9 +48,14 @@ func SetToContext[T any](ctx context.Context, key string, value T) {
 }
 
 func GetFromContext[T any](ctx context.Context, key string) (T, bool) {
-	value, ok := ConvertContext(ctx).Get(key)
+	var zeroValue T
+	ginCtx, ok := ctx.(*gin.Context)
+	if !ok {
+		return zeroValue, ok
+	}
+
+	value, ok := ginCtx.Get(key)
 	if !ok {
-		var zeroValue T
 		return zeroValue, ok
 	}
 
@@ -57,11 +67,11 @@ func SetCurrentTokenInfo(ctx context.Context, tokenInfo model.TokenInfo) {
 }
 
 func GetCurrentTokenInfo(ctx context.Context) (model.TokenInfo, bool) {
-	return GetFromContext[model.TokenInfo](ConvertContext(ctx), tokenInfoKey)
+	return GetFromContext[model.TokenInfo](ctx, tokenInfoKey)
 }
 
 func MustGetCurrentTokenInfo(ctx context.Context) model.TokenInfo {
-	result, _ := GetFromContext[model.TokenInfo](ConvertContext(ctx), tokenInfoKey)
+	result, _ := GetFromContext[model.TokenInfo](ctx, tokenInfoKey)
 	return result
 }
 
@@ -99,6 +109,33 @@ func GetIntQuery(ctx context.Context, key string) int {
 	return MustOne(strconv.Atoi(valStr))
 }
 
+func GetFloatQuery(ctx context.Context, key string) float64 {
+	valStr, ok := ConvertContext(ctx).GetQuery(key)
+	if !ok || valStr == "" {
+		panic(custom_error.IllegalArgumentError)
+	}
+
+	value, err := strconv.ParseFloat(valStr, 64)
+	if err != nil {
+		panic(custom_error.IllegalArgumentError)
+	}
+	return value
+}
+
+func GetTimeQuery(ctx context.Context, key string) *time.Time {
+	valStr, ok := ConvertContext(ctx).GetQuery(key)
+	if !ok || valStr == "" {
+		return nil
+	}
+
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if value, err := time.Parse(layout, valStr); err == nil {
+			return &value
+		}
+	}
+	panic(custom_error.IllegalArgumentError)
+}
+
 func SetFilterObject[T any](ctx context.Context, filterObject T) {
 	SetToContext(ConvertContext(ctx), filterObjectKey, filterObject)
 }
@@ -115,3 +152,27 @@ func MustGetFilterObject[T any](ctx context.Context) T {
 func ConvertContext(ctx context.Context) *gin.Context {
 	return ctx.(*gin.Context)
 }
+
+// SetIfMatch keeps the entity version required by If-Match, the id is the one of the entity it is checked
+// against, it is nil when the request path has no id
+func SetIfMatch(ctx context.Context, id uuid.UUID, version int64) {
+	SetToContext(ConvertContext(ctx), ifMatchKey, version)
+	if id != uuid.Nil {
+		SetToContext(ConvertContext(ctx), ifMatchIdKey, id)
+	}
+}
+
+// GetIfMatch returns the entity version required by If-Match, there is none for "*"
+func GetIfMatch(ctx context.Context) (int64, bool) {
+	return GetFromContext[int64](ctx, ifMatchKey)
+}
+
+// GetIfMatchOf returns the version required by If-Match when it is checked against the entity, it is found
+// within the transactions started by the request as well
+func GetIfMatchOf(ctx context.Context, id uuid.UUID) (int64, bool) {
+	if matchedId, ok := ctx.Value(ifMatchIdKey).(uuid.UUID); !ok || matchedId != id {
+		return 0, false
+	}
+	version, ok := ctx.Value(ifMatchKey).(int64)
+	return version, ok
+}
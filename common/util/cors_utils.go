@@ -31,4 +31,5 @@ func AddCorsHeaders(ctx *gin.Context) {
 	ctx.Header("Access-Control-Allow-Credentials", "true")
 	ctx.Header("Access-Control-Allow-Methods", "*")
 	ctx.Header("Access-Control-Allow-Headers", "*")
+	ctx.Header("Access-Control-Expose-Headers", "ETag")
 }
@@ -0,0 +1,96 @@
+package util
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/iface"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const AnyETag = "*"
+
+func FormatETag(version int64) string {
+	return fmt.Sprintf("%q", strconv.FormatInt(version, 10))
+}
+
+// ParseETags returns the versions of the comma separated ETags, weak ones are compared as strong,
+// as If-None-Match does
+func ParseETags(header string) ([]int64, bool) {
+	return parseETags(header, true)
+}
+
+// ParseStrongETags returns the versions of the comma separated ETags, it fails on weak ones, since If-Match
+// requires the strong comparison
+func ParseStrongETags(header string) ([]int64, bool) {
+	return parseETags(header, false)
+}
+
+func parseETags(header string, allowWeak bool) ([]int64, bool) {
+	var result []int64
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if strings.HasPrefix(tag, "W/") {
+			if !allowWeak {
+				return nil, false
+			}
+			tag = strings.TrimPrefix(tag, "W/")
+		}
+		unquoted, err := strconv.Unquote(tag)
+		if err != nil {
+			return nil, false
+		}
+		version, err := strconv.ParseInt(unquoted, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		result = append(result, version)
+	}
+	return result, true
+}
+
+// AbortWithEntity responds with the entity and its ETag, a GET request gets 304 if the ETag matches If-None-Match
+func AbortWithEntity[T iface.Entity](ctx *gin.Context, code int, entity T) {
+	ctx.Header("ETag", FormatETag(entity.GetVersion()))
+	if ctx.Request.Method == http.MethodGet && matchesETag(ctx.GetHeader("If-None-Match"), entity.GetVersion()) {
+		ctx.AbortWithStatus(http.StatusNotModified)
+		return
+	}
+	ctx.AbortWithStatusJSON(code, entity)
+}
+
+func matchesETag(header string, version int64) bool {
+	if header == "" {
+		return false
+	}
+	if strings.TrimSpace(header) == AnyETag {
+		return true
+	}
+	versions, ok := ParseETags(header)
+	return ok && ArrayContains(versions, version)
+}
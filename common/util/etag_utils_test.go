@@ -0,0 +1,141 @@
+package util
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestParseETags(t *testing.T) {
+	tests := []struct {
+		header string
+		want   []int64
+		wantOk bool
+	}{
+		{`"3"`, []int64{3}, true},
+		{`W/"3"`, []int64{3}, true},
+		{` "1" , W/"2","3" `, []int64{1, 2, 3}, true},
+		{`3`, nil, false},
+		{`"v3"`, nil, false},
+		{`"1",`, nil, false},
+		{``, nil, false},
+		{`*`, nil, false},
+	}
+	for _, test := range tests {
+		got, ok := ParseETags(test.header)
+		if ok != test.wantOk || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ParseETags(%q) = (%v, %v), want (%v, %v)", test.header, got, ok, test.want, test.wantOk)
+		}
+	}
+}
+
+func TestParseStrongETags(t *testing.T) {
+	tests := []struct {
+		header string
+		want   []int64
+		wantOk bool
+	}{
+		{`"3"`, []int64{3}, true},
+		{` "1" ,"2" `, []int64{1, 2}, true},
+		{`W/"3"`, nil, false},
+		{`"1", W/"2"`, nil, false},
+		{`3`, nil, false},
+	}
+	for _, test := range tests {
+		got, ok := ParseStrongETags(test.header)
+		if ok != test.wantOk || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ParseStrongETags(%q) = (%v, %v), want (%v, %v)", test.header, got, ok, test.want, test.wantOk)
+		}
+	}
+}
+
+func TestGetIfMatchOf(t *testing.T) {
+	id := uuid.New()
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	SetIfMatch(ctx, id, 5)
+	// the transactions started by the request wrap its context
+	txCtx := context.WithValue(ctx, struct{}{}, "tx")
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		id          uuid.UUID
+		wantVersion int64
+		wantOk      bool
+	}{
+		{"checked entity", ctx, id, 5, true},
+		{"checked entity within transaction", txCtx, id, 5, true},
+		{"other entity", ctx, uuid.New(), 0, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			version, ok := GetIfMatchOf(test.ctx, test.id)
+			if version != test.wantVersion || ok != test.wantOk {
+				t.Errorf("GetIfMatchOf() = (%d, %v), want (%d, %v)", version, ok, test.wantVersion, test.wantOk)
+			}
+		})
+	}
+
+	collectionCtx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	SetIfMatch(collectionCtx, uuid.Nil, 5)
+	if _, ok := GetIfMatchOf(collectionCtx, uuid.Nil); ok {
+		t.Error("GetIfMatchOf() found the version checked without the entity")
+	}
+}
+
+func TestFormatETag(t *testing.T) {
+	for _, version := range []int64{0, 1, 42, 1 << 40} {
+		versions, ok := ParseETags(FormatETag(version))
+		if !ok || len(versions) != 1 || versions[0] != version {
+			t.Errorf("ParseETags(FormatETag(%d)) = (%v, %v)", version, versions, ok)
+		}
+	}
+}
+
+func TestMatchesETag(t *testing.T) {
+	tests := []struct {
+		header  string
+		version int64
+		want    bool
+	}{
+		{``, 1, false},
+		{`*`, 1, true},
+		{` * `, 7, true},
+		{`"1"`, 1, true},
+		{`"1"`, 2, false},
+		{`"1", W/"2"`, 2, true},
+		{`"1", broken`, 1, false},
+	}
+	for _, test := range tests {
+		if got := matchesETag(test.header, test.version); got != test.want {
+			t.Errorf("matchesETag(%q, %d) = %v, want %v", test.header, test.version, got, test.want)
+		}
+	}
+}
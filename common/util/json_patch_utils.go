@@ -0,0 +1,322 @@
+package util
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// JsonPatchOperation is an operation of the RFC 6902 JSON patch
+type JsonPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// ApplyMergePatch applies the RFC 7396 merge patch to the JSON document
+func ApplyMergePatch(document []byte, patch []byte) ([]byte, error) {
+	target, err := decodeJson(document)
+	if err != nil {
+		return nil, err
+	}
+	patchValue, err := decodeJson(patch)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(mergePatch(target, patchValue))
+}
+
+// ApplyJsonPatch applies the RFC 6902 JSON patch to the JSON document, the document is left
+// unchanged unless all the operations succeed
+func ApplyJsonPatch(document []byte, patch []byte) ([]byte, error) {
+	target, err := decodeJson(document)
+	if err != nil {
+		return nil, err
+	}
+	var operations []JsonPatchOperation
+	if err = json.Unmarshal(patch, &operations); err != nil {
+		return nil, err
+	}
+	for _, operation := range operations {
+		if target, err = applyOperation(target, operation); err != nil {
+			return nil, fmt.Errorf("%s %s: %w", operation.Op, operation.Path, err)
+		}
+	}
+	return json.Marshal(target)
+}
+
+// IsJsonArray returns whether the JSON value is an array, e.g. to tell the JSON patch from the merge patch
+func IsJsonArray(value []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(value), []byte("["))
+}
+
+// IsJsonObject returns whether the JSON value is an object, e.g. the merge patch of an entity must be one
+func IsJsonObject(value []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(value), []byte("{"))
+}
+
+func mergePatch(target any, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = make(map[string]any)
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+		} else {
+			targetObject[key] = mergePatch(targetObject[key], value)
+		}
+	}
+	return targetObject
+}
+
+func applyOperation(document any, operation JsonPatchOperation) (any, error) {
+	path, err := parseJsonPointer(operation.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch operation.Op {
+	case "add", "replace", "test":
+		if operation.Value == nil {
+			return nil, errors.New("value is missing")
+		}
+		value, err := decodeJson(operation.Value)
+		if err != nil {
+			return nil, err
+		}
+		switch operation.Op {
+		case "add":
+			return addValue(document, path, value)
+		case "replace":
+			if len(path) == 0 {
+				return value, nil
+			}
+			if document, err = removeValue(document, path); err != nil {
+				return nil, err
+			}
+			return addValue(document, path, value)
+		default:
+			current, err := getValue(document, path)
+			if err != nil {
+				return nil, err
+			}
+			if !reflect.DeepEqual(normalizeJson(current), normalizeJson(value)) {
+				return nil, errors.New("test failed")
+			}
+			return document, nil
+		}
+	case "remove":
+		return removeValue(document, path)
+	case "move", "copy":
+		from, err := parseJsonPointer(operation.From)
+		if err != nil {
+			return nil, err
+		}
+		value, err := getValue(document, from)
+		if err != nil {
+			return nil, err
+		}
+		if operation.Op == "move" {
+			if isJsonPointerPrefix(from, path) && len(from) < len(path) {
+				return nil, errors.New("value can't be moved into itself")
+			}
+			if document, err = removeValue(document, from); err != nil {
+				return nil, err
+			}
+		} else if value, err = decodeJson(MustOne(json.Marshal(value))); err != nil {
+			return nil, err
+		}
+		return addValue(document, path, value)
+	default:
+		return nil, fmt.Errorf("unknown operation %q", operation.Op)
+	}
+}
+
+func getValue(document any, path []string) (any, error) {
+	for _, token := range path {
+		switch node := document.(type) {
+		case map[string]any:
+			value, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("member %q not found", token)
+			}
+			document = value
+		case []any:
+			index, err := arrayIndex(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			document = node[index]
+		default:
+			return nil, fmt.Errorf("%q isn't a container", token)
+		}
+	}
+	return document, nil
+}
+
+func addValue(document any, path []string, value any) (any, error) {
+	return updateParent(document, path, func(parent any, token string) (any, error) {
+		switch node := parent.(type) {
+		case map[string]any:
+			node[token] = value
+			return node, nil
+		case []any:
+			if token == "-" {
+				return append(node, value), nil
+			}
+			index, err := arrayIndex(token, len(node))
+			if err != nil {
+				return nil, err
+			}
+			return append(node[:index], append([]any{value}, node[index:]...)...), nil
+		default:
+			return nil, fmt.Errorf("%q isn't a container", token)
+		}
+	}, value)
+}
+
+func removeValue(document any, path []string) (any, error) {
+	if len(path) == 0 {
+		return nil, errors.New("document can't be removed")
+	}
+	return updateParent(document, path, func(parent any, token string) (any, error) {
+		switch node := parent.(type) {
+		case map[string]any:
+			if _, ok := node[token]; !ok {
+				return nil, fmt.Errorf("member %q not found", token)
+			}
+			delete(node, token)
+			return node, nil
+		case []any:
+			index, err := arrayIndex(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			return append(node[:index], node[index+1:]...), nil
+		default:
+			return nil, fmt.Errorf("%q isn't a container", token)
+		}
+	}, nil)
+}
+
+// updateParent replaces the parent of the path target with the result of the update, the root path
+// targets the whole document which is replaced with the root value
+func updateParent(document any, path []string, update func(parent any, token string) (any, error), root any) (any, error) {
+	if len(path) == 0 {
+		return root, nil
+	}
+	if len(path) == 1 {
+		return update(document, path[0])
+	}
+
+	child, err := getValue(document, path[:1])
+	if err != nil {
+		return nil, err
+	}
+	if child, err = updateParent(child, path[1:], update, root); err != nil {
+		return nil, err
+	}
+	switch node := document.(type) {
+	case map[string]any:
+		node[path[0]] = child
+	case []any:
+		node[MustOne(arrayIndex(path[0], len(node)-1))] = child
+	}
+	return document, nil
+}
+
+func parseJsonPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid pointer %q", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+func isJsonPointerPrefix(prefix []string, path []string) bool {
+	return len(prefix) <= len(path) && reflect.DeepEqual(prefix, path[:len(prefix)])
+}
+
+func arrayIndex(token string, maxIndex int) (int, error) {
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 || index > maxIndex || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	return index, nil
+}
+
+func decodeJson(value []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+	var result any
+	if err := decoder.Decode(&result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// normalizeJson converts the numbers to float64, so that equal numbers written differently compare equal
+func normalizeJson(value any) any {
+	switch node := value.(type) {
+	case json.Number:
+		number, err := node.Float64()
+		if err != nil {
+			return node.String()
+		}
+		return number
+	case map[string]any:
+		result := make(map[string]any, len(node))
+		for key, item := range node {
+			result[key] = normalizeJson(item)
+		}
+		return result
+	case []any:
+		result := make([]any, len(node))
+		for i, item := range node {
+			result[i] = normalizeJson(item)
+		}
+		return result
+	default:
+		return value
+	}
+}
@@ -33,13 +33,35 @@ import (
 	"strings"
 )
 
+// JwtKeyResolver returns the algorithm and the public key of the signing key with the id
+type JwtKeyResolver func(kid string) (alg string, key any, err error)
+
+var jwtKeyResolver JwtKeyResolver
+
+// SetJwtKeyResolver registers the source of the keys verifying the tokens signed by the key pairs
+func SetJwtKeyResolver(resolver JwtKeyResolver) {
+	jwtKeyResolver = resolver
+}
+
+// ParseJwtToken verifies the token by the key referred by its kid header. Tokens without kid are verified
+// by the shared sign key, they are accepted in the legacy mode only
 func ParseJwtToken(tokenString string) model.TokenInfo {
 	conf := config.CoreConfig.AuthorizationServer
 	accessToken := strings.TrimPrefix(tokenString, "Bearer ")
 	tokenInto := model.TokenInfo{}
 
 	jwtToken, err := jwt.ParseWithClaims(accessToken, &tokenInto, func(token *jwt.Token) (interface{}, error) {
-		if token.Method.Alg() != conf.EncodingAlg {
+		if kid, ok := token.Header["kid"].(string); ok && jwtKeyResolver != nil {
+			alg, key, err := jwtKeyResolver(kid)
+			if err != nil {
+				return nil, err
+			}
+			if token.Method.Alg() != alg {
+				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+			}
+			return key, nil
+		}
+		if !conf.IsLegacyAlg() || token.Method.Alg() != conf.EncodingAlg {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
 		return []byte(conf.SignKey), nil
@@ -0,0 +1,119 @@
+package util
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"encoding/base64"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"strings"
+	"testing"
+)
+
+func TestVerifyPassword(t *testing.T) {
+	argon2Hash, err := HashPassword("Secret#1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("Secret#1"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the hash made by the parameters used before, it is verified by the parameters it keeps
+	salt := []byte("saltsaltsaltsalt")
+	weakerHash := "$argon2id$v=19$m=4096,t=1,p=1$" + base64.RawStdEncoding.EncodeToString(salt) + "$" +
+		base64.RawStdEncoding.EncodeToString(argon2.IDKey([]byte("Secret#1"), salt, 1, 4096, 1, 32))
+
+	tests := []struct {
+		name     string
+		hash     string
+		password string
+		want     bool
+	}{
+		{"argon2id", argon2Hash, "Secret#1", true},
+		{"argon2id wrong password", argon2Hash, "Secret#2", false},
+		{"bcrypt", string(bcryptHash), "Secret#1", true},
+		{"bcrypt wrong password", string(bcryptHash), "Secret#2", false},
+		{"other version", strings.Replace(argon2Hash, "v=19", "v=16", 1), "Secret#1", false},
+		{"truncated hash", argon2Hash[:strings.LastIndex(argon2Hash, "$")], "Secret#1", false},
+		{"broken salt", strings.Replace(argon2Hash, "$m=", "$m=x", 1), "Secret#1", false},
+		{"other parameters", weakerHash, "Secret#1", true},
+		{"other parameters wrong password", weakerHash, "Secret#2", false},
+		{"empty hash", "", "", false},
+		{"unknown scheme", "$argon2i$v=19$m=19456,t=2,p=1$c2FsdA$a2V5", "Secret#1", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := VerifyPassword(test.hash, test.password); got != test.want {
+				t.Errorf("VerifyPassword() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestHashPasswordSalt(t *testing.T) {
+	first, err := HashPassword("Secret#1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := HashPassword("Secret#1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first == second {
+		t.Error("HashPassword() returned the same hash twice, the salt isn't random")
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	current, err := HashPassword("Secret#1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("Secret#1"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		hash string
+		want bool
+	}{
+		{"current parameters", current, false},
+		{"bcrypt", string(bcryptHash), true},
+		{"less memory", strings.Replace(current, "m=19456", "m=4096", 1), true},
+		{"fewer iterations", strings.Replace(current, "t=2", "t=1", 1), true},
+		{"more threads", strings.Replace(current, "p=1", "p=4", 1), true},
+		{"empty hash", "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := NeedsRehash(test.hash); got != test.want {
+				t.Errorf("NeedsRehash() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package controller
+
+import (
+	commonController "assets/common/controller"
+	commonMiddleware "assets/common/middleware"
+	commonUtil "assets/common/util"
+	"assets/modules/asset/model"
+	"assets/modules/asset/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var analyticsCntr commonController.HttpController
+
+type analyticsController struct {
+	service service.AnalyticsService
+}
+
+func GetAnalyticsController() commonController.HttpController {
+	if analyticsCntr != nil {
+		return analyticsCntr
+	}
+	analyticsCntr = &analyticsController{service: service.GetAnalyticsService()}
+	return analyticsCntr
+}
+
+func (controller *analyticsController) RegisterHttpController(router *gin.Engine) {
+	analyticsRouter := router.Group("/api/asset/analytics", commonMiddleware.SecurityHandler)
+
+	analyticsRouter.GET(
+		"/assets/:id",
+		commonMiddleware.HasAnyAuthorities("READ_ASSET"),
+		controller.getAssetSummary,
+	)
+
+	analyticsRouter.GET(
+		"/portfolio",
+		commonMiddleware.HasAnyAuthorities("READ_ASSET"),
+		commonMiddleware.FilterHandler[model.TagFilter],
+		controller.getPortfolioSummary,
+	)
+
+	analyticsRouter.GET(
+		"/tags",
+		commonMiddleware.HasAnyAuthorities("READ_ASSET"),
+		controller.getTagSummaries,
+	)
+}
+
+// analyticsController godoc
+// @Security BearerAuth
+// @Summary      getAssetSummary
+// @Description  Get nominal and inflation-adjusted incomes and expenses of asset split by ownership shares
+// @Tags         Analytics controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Asset.ID"
+// @Param        from	query	string  false  "Period start (2006-01-02 or RFC3339)"
+// @Param        to		query	string  false  "Period end (2006-01-02 or RFC3339)"
+// @Param        baseDate	query	string  false  "Date of the money of inflation-adjusted totals, now by default (2006-01-02 or RFC3339)"
+// @Success      200	{object}  model.AssetSummary
+// @Failure      400
+// @Failure      500
+// @Router       /api/asset/analytics/assets/{id} [GET]
+func (controller *analyticsController) getAssetSummary(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	from, to := commonUtil.GetTimeQuery(ctx, "from"), commonUtil.GetTimeQuery(ctx, "to")
+	baseDate := commonUtil.GetTimeQuery(ctx, "baseDate")
+	log.WithContext(ctx).Infof("AnalyticsController: GetAssetSummary(id: %s): Start", id)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetAssetSummary(ctx, id, from, to, baseDate))
+	log.WithContext(ctx).Info("AnalyticsController: GetAssetSummary(): End")
+}
+
+// analyticsController godoc
+// @Security BearerAuth
+// @Summary      getPortfolioSummary
+// @Description  Get current user share of nominal and inflation-adjusted incomes and expenses of owned and co-owned assets
+// @Tags         Analytics controller
+// @Accept       json
+// @Produce      json
+// @Param        from	query	string  false  "Period start (2006-01-02 or RFC3339)"
+// @Param        to		query	string  false  "Period end (2006-01-02 or RFC3339)"
+// @Param        baseDate	query	string  false  "Date of the money of inflation-adjusted totals, now by default (2006-01-02 or RFC3339)"
+// @Param        tagId	query	string  false  "Tag.ID, may be repeated to sum tagged assets and cash flows only"
+// @Success      200	{object}  model.PortfolioSummary
+// @Failure      400
+// @Failure      500
+// @Router       /api/asset/analytics/portfolio [GET]
+func (controller *analyticsController) getPortfolioSummary(ctx *gin.Context) {
+	from, to := commonUtil.GetTimeQuery(ctx, "from"), commonUtil.GetTimeQuery(ctx, "to")
+	baseDate := commonUtil.GetTimeQuery(ctx, "baseDate")
+	filter := commonUtil.MustGetFilterObject[model.TagFilter](ctx)
+	log.WithContext(ctx).Info("AnalyticsController: GetPortfolioSummary(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetPortfolioSummary(ctx, from, to, baseDate, filter.GetTagIds()))
+	log.WithContext(ctx).Info("AnalyticsController: GetPortfolioSummary(): End")
+}
+
+// analyticsController godoc
+// @Security BearerAuth
+// @Summary      getTagSummaries
+// @Description  Get current user share of incomes, expenses and market values of owned and co-owned assets by every tag of the user
+// @Tags         Analytics controller
+// @Accept       json
+// @Produce      json
+// @Param        from	query	string  false  "Period start (2006-01-02 or RFC3339)"
+// @Param        to		query	string  false  "Period end (2006-01-02 or RFC3339)"
+// @Param        baseDate	query	string  false  "Date of the money of inflation-adjusted totals, now by default (2006-01-02 or RFC3339)"
+// @Success      200	{array}  model.TagSummary
+// @Failure      400
+// @Failure      500
+// @Router       /api/asset/analytics/tags [GET]
+func (controller *analyticsController) getTagSummaries(ctx *gin.Context) {
+	from, to := commonUtil.GetTimeQuery(ctx, "from"), commonUtil.GetTimeQuery(ctx, "to")
+	baseDate := commonUtil.GetTimeQuery(ctx, "baseDate")
+	log.WithContext(ctx).Info("AnalyticsController: GetTagSummaries(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetTagSummaries(ctx, from, to, baseDate))
+	log.WithContext(ctx).Info("AnalyticsController: GetTagSummaries(): End")
+}
@@ -40,14 +40,15 @@ import (
 var assetCntr commonController.HttpController
 
 type assetController struct {
-	service service.AssetService
+	service         service.AssetService
+	timelineService service.TimelineService
 }
 
 func GetAssetController() commonController.HttpController {
 	if assetCntr != nil {
 		return assetCntr
 	}
-	assetCntr = &assetController{service: service.GetAssetService()}
+	assetCntr = &assetController{service: service.GetAssetService(), timelineService: service.GetTimelineService()}
 	return assetCntr
 }
 
@@ -60,13 +61,39 @@ func (controller *assetController) RegisterHttpController(router *gin.Engine) {
 		controller.getById,
 	)
 
+	assetRouter.GET(
+		"/shared",
+		commonMiddleware.HasAnyAuthorities("READ_ASSET"),
+		commonMiddleware.PaginationHandler,
+		controller.getShared,
+	)
+
 	assetRouter.GET(
 		"",
 		commonMiddleware.HasAnyAuthorities("READ_ASSET"),
 		commonMiddleware.PaginationHandler,
+		commonMiddleware.FilterHandler[model.TagFilter],
 		controller.getAll,
 	)
 
+	assetRouter.GET(
+		"/geo/bbox",
+		commonMiddleware.HasAnyAuthorities("READ_ASSET"),
+		controller.findInBoundingBox,
+	)
+
+	assetRouter.GET(
+		"/geo/radius",
+		commonMiddleware.HasAnyAuthorities("READ_ASSET"),
+		controller.findInRadius,
+	)
+
+	assetRouter.GET(
+		"/geo/geojson",
+		commonMiddleware.HasAnyAuthorities("READ_ASSET"),
+		controller.getGeoJson,
+	)
+
 	assetRouter.POST(
 		"",
 		commonMiddleware.HasAnyAuthorities("CREATE_ASSET"),
@@ -77,15 +104,79 @@ func (controller *assetController) RegisterHttpController(router *gin.Engine) {
 	assetRouter.PUT(
 		"/:id",
 		commonMiddleware.HasAnyAuthorities("UPDATE_ASSET"),
-		commonResolver.Resolver[model.Asset],
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.UpdateResolver[model.Asset],
+		controller.update,
+	)
+
+	assetRouter.PATCH(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("UPDATE_ASSET"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.PatchResolver(controller.service.GetById),
 		controller.update,
 	)
 
 	assetRouter.DELETE(
 		"/:id",
 		commonMiddleware.HasAnyAuthorities("DELETE_ASSET"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
 		controller.deleteById,
 	)
+
+	assetRouter.GET(
+		"/trash",
+		commonMiddleware.HasAnyAuthorities("READ_ASSET"),
+		commonMiddleware.PaginationHandler,
+		controller.getTrash,
+	)
+
+	assetRouter.POST(
+		"/:id/restore",
+		commonMiddleware.HasAnyAuthorities("DELETE_ASSET"),
+		controller.restore,
+	)
+
+	assetRouter.GET(
+		"/:id/timeline",
+		commonMiddleware.HasAnyAuthorities("READ_ASSET"),
+		commonMiddleware.PaginationHandler,
+		commonMiddleware.FilterHandler[model.TimelineFilter],
+		controller.getTimeline,
+	)
+
+	assetRouter.GET(
+		"/:id/shares",
+		commonMiddleware.HasAnyAuthorities("READ_ASSET"),
+		controller.getShares,
+	)
+
+	assetRouter.PUT(
+		"/:id/shares",
+		commonMiddleware.HasAnyAuthorities("EDIT_ASSET_SHARE"),
+		commonResolver.Resolver[[]model.AssetShare],
+		controller.updateShares,
+	)
+
+	assetRouter.GET(
+		"/:id/grants",
+		commonMiddleware.HasAnyAuthorities("READ_ASSET"),
+		controller.getGrants,
+	)
+
+	assetRouter.PUT(
+		"/:id/grants",
+		commonMiddleware.HasAnyAuthorities("EDIT_ASSET_GRANT"),
+		commonResolver.Resolver[[]model.AssetGrant],
+		controller.updateGrants,
+	)
+
+	assetRouter.PUT(
+		"/:id/tags",
+		commonMiddleware.HasAnyAuthorities("EDIT_TAG"),
+		commonResolver.Resolver[[]uuid.UUID],
+		controller.updateTags,
+	)
 }
 
 // assetController godoc
@@ -103,7 +194,7 @@ func (controller *assetController) RegisterHttpController(router *gin.Engine) {
 func (controller *assetController) getById(ctx *gin.Context) {
 	id := uuid.MustParse(ctx.Param("id"))
 	log.WithContext(ctx).Infof("AssetController: GetById(id: %s): Start", id)
-	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetById(ctx, id))
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.GetById(ctx, id))
 	log.WithContext(ctx).Info("AssetController: GetById(): End")
 }
 
@@ -114,14 +205,16 @@ func (controller *assetController) getById(ctx *gin.Context) {
 // @Tags         Asset controller
 // @Accept       json
 // @Produce      json
+// @Param        tagId	query	string  false  "Tag.ID, may be repeated to get assets having any of the tags"
 // @Success      200	{array}  model.Asset
 // @Failure      400
 // @Failure      500
 // @Router       /api/asset/assets/ [GET]
 func (controller *assetController) getAll(ctx *gin.Context) {
 	page := commonUtil.MustGetPageable(ctx)
+	filter := commonUtil.MustGetFilterObject[model.TagFilter](ctx)
 	log.WithContext(ctx).Info("AssetController: GetAll(): Start")
-	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetAll(ctx, page))
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetAll(ctx, filter, page))
 	log.WithContext(ctx).Info("AssetController: GetAll(): End")
 }
 
@@ -140,7 +233,7 @@ func (controller *assetController) getAll(ctx *gin.Context) {
 func (controller *assetController) create(ctx *gin.Context) {
 	log.WithContext(ctx).Info("AssetController: Create(): Start")
 	asset := ctx.MustGet("RequestBody").(model.Asset)
-	ctx.AbortWithStatusJSON(http.StatusCreated, controller.service.Create(ctx, asset))
+	commonUtil.AbortWithEntity(ctx, http.StatusCreated, controller.service.Create(ctx, asset))
 	log.WithContext(ctx).Info("AssetController: Create(): End")
 }
 
@@ -156,17 +249,18 @@ func (controller *assetController) create(ctx *gin.Context) {
 // @Failure      400
 // @Failure      500
 // @Router       /api/asset/assets/{id} [PUT]
+// @Router       /api/asset/assets/{id} [PATCH]
 func (controller *assetController) update(ctx *gin.Context) {
 	log.WithContext(ctx).Info("AssetController: Update(): Start")
 	asset := ctx.MustGet("RequestBody").(model.Asset)
-	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.Update(ctx, asset))
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Update(ctx, asset))
 	log.WithContext(ctx).Info("AssetController: Update(): End")
 }
 
 // assetController godoc
 // @Security BearerAuth
 // @Summary      deleteById
-// @Description  Delete asset by id
+// @Description  Move asset to the trash
 // @Tags         Asset controller
 // @Accept       json
 // @Produce      json
@@ -182,3 +276,264 @@ func (controller *assetController) deleteById(ctx *gin.Context) {
 	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
 	log.WithContext(ctx).Info("AssetController: DeleteById(): End")
 }
+
+// assetController godoc
+// @Security BearerAuth
+// @Summary      getTrash
+// @Description  Get assets in the trash available to current user
+// @Tags         Asset controller
+// @Accept       json
+// @Produce      json
+// @Success      200	{array}  model.Asset
+// @Failure      400
+// @Failure      500
+// @Router       /api/asset/assets/trash [GET]
+func (controller *assetController) getTrash(ctx *gin.Context) {
+	page := commonUtil.MustGetPageable(ctx)
+	log.WithContext(ctx).Info("AssetController: GetTrash(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetTrash(ctx, page))
+	log.WithContext(ctx).Info("AssetController: GetTrash(): End")
+}
+
+// assetController godoc
+// @Security BearerAuth
+// @Summary      restore
+// @Description  Restore asset from the trash
+// @Tags         Asset controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Asset.ID"
+// @Success      200	{object}  model.Asset
+// @Failure      400
+// @Failure      500
+// @Router       /api/asset/assets/{id}/restore [POST]
+func (controller *assetController) restore(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("AssetController: Restore(id: %s): Start", id)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Restore(ctx, id))
+	log.WithContext(ctx).Info("AssetController: Restore(): End")
+}
+
+// assetController godoc
+// @Security BearerAuth
+// @Summary      getShared
+// @Description  Get assets shared with current user
+// @Tags         Asset controller
+// @Accept       json
+// @Produce      json
+// @Success      200	{array}  model.Asset
+// @Failure      400
+// @Failure      500
+// @Router       /api/asset/assets/shared [GET]
+func (controller *assetController) getShared(ctx *gin.Context) {
+	page := commonUtil.MustGetPageable(ctx)
+	log.WithContext(ctx).Info("AssetController: GetShared(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetShared(ctx, page))
+	log.WithContext(ctx).Info("AssetController: GetShared(): End")
+}
+
+// assetController godoc
+// @Security BearerAuth
+// @Summary      findInBoundingBox
+// @Description  Find assets within bounding box, minLon greater than maxLon means the box crosses the antimeridian
+// @Tags         Asset controller
+// @Accept       json
+// @Produce      json
+// @Param        minLat	query	number  true  "Min latitude"
+// @Param        minLon	query	number  true  "Min longitude"
+// @Param        maxLat	query	number  true  "Max latitude"
+// @Param        maxLon	query	number  true  "Max longitude"
+// @Param        format	query	string  false  "enum(json, geojson)"
+// @Success      200	{array}  model.Asset
+// @Failure      400
+// @Failure      500
+// @Router       /api/asset/assets/geo/bbox [GET]
+func (controller *assetController) findInBoundingBox(ctx *gin.Context) {
+	box := model.BoundingBox{
+		MinLatitude:  commonUtil.GetFloatQuery(ctx, "minLat"),
+		MinLongitude: commonUtil.GetFloatQuery(ctx, "minLon"),
+		MaxLatitude:  commonUtil.GetFloatQuery(ctx, "maxLat"),
+		MaxLongitude: commonUtil.GetFloatQuery(ctx, "maxLon"),
+	}
+	log.WithContext(ctx).Infof("AssetController: FindInBoundingBox(box: %+v): Start", box)
+	assets := controller.service.FindInBoundingBox(ctx, box)
+	if ctx.Query("format") == "geojson" {
+		ctx.AbortWithStatusJSON(http.StatusOK, model.NewFeatureCollection(assets))
+	} else {
+		ctx.AbortWithStatusJSON(http.StatusOK, assets)
+	}
+	log.WithContext(ctx).Info("AssetController: FindInBoundingBox(): End")
+}
+
+// assetController godoc
+// @Security BearerAuth
+// @Summary      findInRadius
+// @Description  Find assets within radius around the point ordered by distance
+// @Tags         Asset controller
+// @Accept       json
+// @Produce      json
+// @Param        lat	query	number  true  "Latitude"
+// @Param        lon	query	number  true  "Longitude"
+// @Param        radius	query	number  true  "Radius in meters"
+// @Param        format	query	string  false  "enum(json, geojson)"
+// @Success      200	{array}  model.Asset
+// @Failure      400
+// @Failure      500
+// @Router       /api/asset/assets/geo/radius [GET]
+func (controller *assetController) findInRadius(ctx *gin.Context) {
+	latitude, longitude := commonUtil.GetFloatQuery(ctx, "lat"), commonUtil.GetFloatQuery(ctx, "lon")
+	radius := commonUtil.GetFloatQuery(ctx, "radius")
+	log.WithContext(ctx).Infof("AssetController: FindInRadius(lat: %f, lon: %f, radius: %f): Start", latitude, longitude, radius)
+	assets := controller.service.FindInRadius(ctx, latitude, longitude, radius)
+	if ctx.Query("format") == "geojson" {
+		collection := model.NewFeatureCollection(assets)
+		for i, feature := range collection.Features {
+			distance := assets[i].DistanceTo(latitude, longitude)
+			feature.Properties.Distance = &distance
+		}
+		ctx.AbortWithStatusJSON(http.StatusOK, collection)
+	} else {
+		ctx.AbortWithStatusJSON(http.StatusOK, assets)
+	}
+	log.WithContext(ctx).Info("AssetController: FindInRadius(): End")
+}
+
+// assetController godoc
+// @Security BearerAuth
+// @Summary      getGeoJson
+// @Description  Get all located assets as GeoJSON feature collection for map rendering
+// @Tags         Asset controller
+// @Accept       json
+// @Produce      json
+// @Success      200	{object}  model.FeatureCollection
+// @Failure      400
+// @Failure      500
+// @Router       /api/asset/assets/geo/geojson [GET]
+func (controller *assetController) getGeoJson(ctx *gin.Context) {
+	log.WithContext(ctx).Info("AssetController: GetGeoJson(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, model.NewFeatureCollection(controller.service.GetLocated(ctx)))
+	log.WithContext(ctx).Info("AssetController: GetGeoJson(): End")
+}
+
+// assetController godoc
+// @Security BearerAuth
+// @Summary      getTimeline
+// @Description  Get activity timeline of asset by id, the latest events go first unless ascending sort is requested
+// @Tags         Asset controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path	string  true  "Asset.ID"
+// @Param        type	query	string  false  "Event type (CASH_FLOW, VALUATION, DOCUMENT, MAINTENANCE, FIELD_EDIT), may be repeated"
+// @Success      200	{array}  model.TimelineEvent
+// @Failure      400
+// @Failure      500
+// @Router       /api/asset/assets/{id}/timeline [GET]
+func (controller *assetController) getTimeline(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	page := commonUtil.MustGetPageable(ctx)
+	filter := commonUtil.MustGetFilterObject[model.TimelineFilter](ctx)
+	log.WithContext(ctx).Infof("AssetController: GetTimeline(id: %s): Start", id)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.timelineService.GetTimeline(ctx, id, filter, page))
+	log.WithContext(ctx).Info("AssetController: GetTimeline(): End")
+}
+
+// assetController godoc
+// @Security BearerAuth
+// @Summary      getShares
+// @Description  Get ownership shares of asset by id
+// @Tags         Asset controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Asset.ID"
+// @Success      200	{array}  model.AssetShare
+// @Failure      400
+// @Failure      500
+// @Router       /api/asset/assets/{id}/shares [GET]
+func (controller *assetController) getShares(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("AssetController: GetShares(id: %s): Start", id)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetShares(ctx, id))
+	log.WithContext(ctx).Info("AssetController: GetShares(): End")
+}
+
+// assetController godoc
+// @Security BearerAuth
+// @Summary      updateShares
+// @Description  Replace ownership shares of asset by id
+// @Tags         Asset controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Asset.ID"
+// @Param        shares	body	  []model.AssetShare  true  "Asset shares"
+// @Success      200	{array}  model.AssetShare
+// @Failure      400
+// @Failure      500
+// @Router       /api/asset/assets/{id}/shares [PUT]
+func (controller *assetController) updateShares(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("AssetController: UpdateShares(id: %s): Start", id)
+	shares := ctx.MustGet("RequestBody").([]model.AssetShare)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.UpdateShares(ctx, id, shares))
+	log.WithContext(ctx).Info("AssetController: UpdateShares(): End")
+}
+
+// assetController godoc
+// @Security BearerAuth
+// @Summary      getGrants
+// @Description  Get users access grants of asset by id
+// @Tags         Asset controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Asset.ID"
+// @Success      200	{array}  model.AssetGrant
+// @Failure      400
+// @Failure      500
+// @Router       /api/asset/assets/{id}/grants [GET]
+func (controller *assetController) getGrants(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("AssetController: GetGrants(id: %s): Start", id)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetGrants(ctx, id))
+	log.WithContext(ctx).Info("AssetController: GetGrants(): End")
+}
+
+// assetController godoc
+// @Security BearerAuth
+// @Summary      updateGrants
+// @Description  Replace users access grants (READ, WRITE) of asset by id
+// @Tags         Asset controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Asset.ID"
+// @Param        grants	body	  []model.AssetGrant  true  "Asset grants"
+// @Success      200	{array}  model.AssetGrant
+// @Failure      400
+// @Failure      500
+// @Router       /api/asset/assets/{id}/grants [PUT]
+func (controller *assetController) updateGrants(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("AssetController: UpdateGrants(id: %s): Start", id)
+	grants := ctx.MustGet("RequestBody").([]model.AssetGrant)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.UpdateGrants(ctx, id, grants))
+	log.WithContext(ctx).Info("AssetController: UpdateGrants(): End")
+}
+
+// assetController godoc
+// @Security BearerAuth
+// @Summary      updateTags
+// @Description  Replace current user tags of asset by id, tags of other users are kept
+// @Tags         Asset controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Asset.ID"
+// @Param        tagIds	body	  []string  true  "Tag ids"
+// @Success      200	{object}  model.Asset
+// @Failure      400
+// @Failure      500
+// @Router       /api/asset/assets/{id}/tags [PUT]
+func (controller *assetController) updateTags(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("AssetController: UpdateTags(id: %s): Start", id)
+	tagIds := ctx.MustGet("RequestBody").([]uuid.UUID)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.UpdateTags(ctx, id, tagIds))
+	log.WithContext(ctx).Info("AssetController: UpdateTags(): End")
+}
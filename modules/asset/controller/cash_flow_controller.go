@@ -26,7 +26,9 @@ package controller
 
 import (
 	commonController "assets/common/controller"
+	commonError "assets/common/custom_error"
 	commonMiddleware "assets/common/middleware"
+	commonModel "assets/common/model"
 	commonResolver "assets/common/resolver"
 	commonUtil "assets/common/util"
 	"assets/modules/asset/model"
@@ -35,6 +37,7 @@ import (
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	"net/http"
+	"strings"
 )
 
 var cashFlowCntr commonController.HttpController
@@ -64,6 +67,7 @@ func (controller *cashFlowController) RegisterHttpController(router *gin.Engine)
 		"",
 		commonMiddleware.HasAnyAuthorities("READ_CASH_FLOW"),
 		commonMiddleware.PaginationHandler,
+		commonMiddleware.FilterHandler[model.TagFilter],
 		controller.getAll,
 	)
 
@@ -74,18 +78,69 @@ func (controller *cashFlowController) RegisterHttpController(router *gin.Engine)
 		controller.create,
 	)
 
+	cashFlowRouter.POST(
+		"/bulk",
+		commonMiddleware.HasAnyAuthorities("CREATE_CASH_FLOW"),
+		commonResolver.Resolver[[]model.CashFlow],
+		controller.createAll,
+	)
+
+	cashFlowRouter.PUT(
+		"/bulk",
+		commonMiddleware.HasAnyAuthorities("UPDATE_CASH_FLOW"),
+		commonResolver.Resolver[[]model.CashFlow],
+		controller.updateAll,
+	)
+
+	cashFlowRouter.DELETE(
+		"/bulk",
+		commonMiddleware.HasAnyAuthorities("DELETE_CASH_FLOW"),
+		commonResolver.Resolver[[]uuid.UUID],
+		controller.deleteAll,
+	)
+
 	cashFlowRouter.PUT(
 		"/:id",
 		commonMiddleware.HasAnyAuthorities("UPDATE_CASH_FLOW"),
-		commonResolver.Resolver[model.CashFlow],
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.UpdateResolver[model.CashFlow],
+		controller.update,
+	)
+
+	cashFlowRouter.PATCH(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("UPDATE_CASH_FLOW"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.PatchResolver(controller.service.GetById),
 		controller.update,
 	)
 
 	cashFlowRouter.DELETE(
 		"/:id",
 		commonMiddleware.HasAnyAuthorities("DELETE_CASH_FLOW"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
 		controller.deleteById,
 	)
+
+	cashFlowRouter.GET(
+		"/trash",
+		commonMiddleware.HasAnyAuthorities("READ_CASH_FLOW"),
+		commonMiddleware.PaginationHandler,
+		controller.getTrash,
+	)
+
+	cashFlowRouter.POST(
+		"/:id/restore",
+		commonMiddleware.HasAnyAuthorities("DELETE_CASH_FLOW"),
+		controller.restore,
+	)
+
+	cashFlowRouter.PUT(
+		"/:id/tags",
+		commonMiddleware.HasAnyAuthorities("EDIT_TAG"),
+		commonResolver.Resolver[[]uuid.UUID],
+		controller.updateTags,
+	)
 }
 
 // cashFlowController godoc
@@ -103,7 +158,7 @@ func (controller *cashFlowController) RegisterHttpController(router *gin.Engine)
 func (controller *cashFlowController) getById(ctx *gin.Context) {
 	id := uuid.MustParse(ctx.Param("id"))
 	log.WithContext(ctx).Infof("CashFlowController: GetById(id: %s): Start", id)
-	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetById(ctx, id))
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.GetById(ctx, id))
 	log.WithContext(ctx).Info("CashFlowController: GetById(): End")
 }
 
@@ -114,14 +169,16 @@ func (controller *cashFlowController) getById(ctx *gin.Context) {
 // @Tags         CashFlow controller
 // @Accept       json
 // @Produce      json
+// @Param        tagId	query	string  false  "Tag.ID, may be repeated to get cash flows having any of the tags"
 // @Success      200	{array}  model.CashFlow
 // @Failure      400
 // @Failure      500
 // @Router       /api/cashFlow/cashFlows/ [GET]
 func (controller *cashFlowController) getAll(ctx *gin.Context) {
 	page := commonUtil.MustGetPageable(ctx)
+	filter := commonUtil.MustGetFilterObject[model.TagFilter](ctx)
 	log.WithContext(ctx).Info("CashFlowController: GetAll(): Start")
-	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetAll(ctx, page))
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetAll(ctx, filter, page))
 	log.WithContext(ctx).Info("CashFlowController: GetAll(): End")
 }
 
@@ -140,7 +197,7 @@ func (controller *cashFlowController) getAll(ctx *gin.Context) {
 func (controller *cashFlowController) create(ctx *gin.Context) {
 	log.WithContext(ctx).Info("CashFlowController: Create(): Start")
 	cashFlow := ctx.MustGet("RequestBody").(model.CashFlow)
-	ctx.AbortWithStatusJSON(http.StatusCreated, controller.service.Create(ctx, cashFlow))
+	commonUtil.AbortWithEntity(ctx, http.StatusCreated, controller.service.Create(ctx, cashFlow))
 	log.WithContext(ctx).Info("CashFlowController: Create(): End")
 }
 
@@ -156,17 +213,18 @@ func (controller *cashFlowController) create(ctx *gin.Context) {
 // @Failure      400
 // @Failure      500
 // @Router       /api/cashFlow/cashFlows/{id} [PUT]
+// @Router       /api/cashFlow/cashFlows/{id} [PATCH]
 func (controller *cashFlowController) update(ctx *gin.Context) {
 	log.WithContext(ctx).Info("CashFlowController: Update(): Start")
 	cashFlow := ctx.MustGet("RequestBody").(model.CashFlow)
-	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.Update(ctx, cashFlow))
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Update(ctx, cashFlow))
 	log.WithContext(ctx).Info("CashFlowController: Update(): End")
 }
 
 // cashFlowController godoc
 // @Security BearerAuth
 // @Summary      deleteById
-// @Description  Delete cashFlow by id
+// @Description  Move cash flow to the trash
 // @Tags         CashFlow controller
 // @Accept       json
 // @Produce      json
@@ -182,3 +240,136 @@ func (controller *cashFlowController) deleteById(ctx *gin.Context) {
 	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
 	log.WithContext(ctx).Info("CashFlowController: DeleteById(): End")
 }
+
+// cashFlowController godoc
+// @Security BearerAuth
+// @Summary      getTrash
+// @Description  Get cash flows in the trash available to current user
+// @Tags         CashFlow controller
+// @Accept       json
+// @Produce      json
+// @Success      200	{array}  model.CashFlow
+// @Failure      400
+// @Failure      500
+// @Router       /api/cashFlow/cashFlows/trash [GET]
+func (controller *cashFlowController) getTrash(ctx *gin.Context) {
+	page := commonUtil.MustGetPageable(ctx)
+	log.WithContext(ctx).Info("CashFlowController: GetTrash(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetTrash(ctx, page))
+	log.WithContext(ctx).Info("CashFlowController: GetTrash(): End")
+}
+
+// cashFlowController godoc
+// @Security BearerAuth
+// @Summary      restore
+// @Description  Restore cash flow from the trash
+// @Tags         CashFlow controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "CashFlow.ID"
+// @Success      200	{object}  model.CashFlow
+// @Failure      400
+// @Failure      500
+// @Router       /api/cashFlow/cashFlows/{id}/restore [POST]
+func (controller *cashFlowController) restore(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("CashFlowController: Restore(id: %s): Start", id)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Restore(ctx, id))
+	log.WithContext(ctx).Info("CashFlowController: Restore(): End")
+}
+
+// cashFlowController godoc
+// @Security BearerAuth
+// @Summary      updateTags
+// @Description  Replace current user tags of cashFlow by id, tags of other users are kept
+// @Tags         CashFlow controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "CashFlow.ID"
+// @Param        tagIds	body	  []string  true  "Tag ids"
+// @Success      200	{object}  model.CashFlow
+// @Failure      400
+// @Failure      500
+// @Router       /api/cashFlow/cashFlows/{id}/tags [PUT]
+func (controller *cashFlowController) updateTags(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("CashFlowController: UpdateTags(id: %s): Start", id)
+	tagIds := ctx.MustGet("RequestBody").([]uuid.UUID)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.UpdateTags(ctx, id, tagIds))
+	log.WithContext(ctx).Info("CashFlowController: UpdateTags(): End")
+}
+
+// cashFlowController godoc
+// @Security BearerAuth
+// @Summary      createAll
+// @Description  Create cashFlows in batches within one transaction, the result holds the outcome of every cashFlow
+// @Tags         CashFlow controller
+// @Accept       json
+// @Produce      json
+// @Param        mode	query	string  false  "ALL_OR_NOTHING (default) to store nothing if any cashFlow fails or PARTIAL to store the rest"
+// @Param        user	body	  []model.CashFlow  true  "Create CashFlows"
+// @Success      200	{object}  commonModel.BulkResult
+// @Failure      400
+// @Failure      500
+// @Router       /api/cashFlow/cashFlows/bulk [POST]
+func (controller *cashFlowController) createAll(ctx *gin.Context) {
+	partial := isPartial(ctx)
+	log.WithContext(ctx).Infof("CashFlowController: CreateAll(partial: %t): Start", partial)
+	cashFlows := ctx.MustGet("RequestBody").([]model.CashFlow)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.CreateAll(ctx, cashFlows, partial))
+	log.WithContext(ctx).Info("CashFlowController: CreateAll(): End")
+}
+
+// cashFlowController godoc
+// @Security BearerAuth
+// @Summary      updateAll
+// @Description  Update cashFlows in batches within one transaction, the result holds the outcome of every cashFlow
+// @Tags         CashFlow controller
+// @Accept       json
+// @Produce      json
+// @Param        mode	query	string  false  "ALL_OR_NOTHING (default) to store nothing if any cashFlow fails or PARTIAL to store the rest"
+// @Param        user	body	  []model.CashFlow  true  "Update CashFlows"
+// @Success      200	{object}  commonModel.BulkResult
+// @Failure      400
+// @Failure      500
+// @Router       /api/cashFlow/cashFlows/bulk [PUT]
+func (controller *cashFlowController) updateAll(ctx *gin.Context) {
+	partial := isPartial(ctx)
+	log.WithContext(ctx).Infof("CashFlowController: UpdateAll(partial: %t): Start", partial)
+	cashFlows := ctx.MustGet("RequestBody").([]model.CashFlow)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.UpdateAll(ctx, cashFlows, partial))
+	log.WithContext(ctx).Info("CashFlowController: UpdateAll(): End")
+}
+
+// cashFlowController godoc
+// @Security BearerAuth
+// @Summary      deleteAll
+// @Description  Delete cashFlows by ids in batches within one transaction, the result holds the outcome of every id
+// @Tags         CashFlow controller
+// @Accept       json
+// @Produce      json
+// @Param        mode	query	string  false  "ALL_OR_NOTHING (default) to delete nothing if any cashFlow fails or PARTIAL to delete the rest"
+// @Param        ids	body	  []string  true  "CashFlow.ID list"
+// @Success      200	{object}  commonModel.BulkResult
+// @Failure      400
+// @Failure      500
+// @Router       /api/cashFlow/cashFlows/bulk [DELETE]
+func (controller *cashFlowController) deleteAll(ctx *gin.Context) {
+	partial := isPartial(ctx)
+	log.WithContext(ctx).Infof("CashFlowController: DeleteAll(partial: %t): Start", partial)
+	ids := ctx.MustGet("RequestBody").([]uuid.UUID)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.DeleteAll(ctx, ids, partial))
+	log.WithContext(ctx).Info("CashFlowController: DeleteAll(): End")
+}
+
+// isPartial returns whether the bulk request mode allows partial success
+func isPartial(ctx *gin.Context) bool {
+	switch strings.ToUpper(ctx.DefaultQuery("mode", commonModel.AllOrNothingBulkMode)) {
+	case commonModel.AllOrNothingBulkMode:
+		return false
+	case commonModel.PartialBulkMode:
+		return true
+	default:
+		panic(commonError.IllegalArgumentError)
+	}
+}
@@ -0,0 +1,195 @@
+package controller
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonController "assets/common/controller"
+	commonMiddleware "assets/common/middleware"
+	commonResolver "assets/common/resolver"
+	commonUtil "assets/common/util"
+	"assets/modules/asset/model"
+	"assets/modules/asset/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+var tagCntr commonController.HttpController
+
+type tagController struct {
+	service service.TagService
+}
+
+func GetTagController() commonController.HttpController {
+	if tagCntr != nil {
+		return tagCntr
+	}
+	tagCntr = &tagController{service: service.GetTagService()}
+	return tagCntr
+}
+
+func (controller *tagController) RegisterHttpController(router *gin.Engine) {
+	tagRouter := router.Group("/api/asset/tags", commonMiddleware.SecurityHandler)
+
+	tagRouter.GET(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("READ_TAG"),
+		controller.getById,
+	)
+
+	tagRouter.GET(
+		"",
+		commonMiddleware.HasAnyAuthorities("READ_TAG"),
+		controller.getAll,
+	)
+
+	tagRouter.POST(
+		"",
+		commonMiddleware.HasAnyAuthorities("EDIT_TAG"),
+		commonResolver.Resolver[model.Tag],
+		controller.create,
+	)
+
+	tagRouter.PUT(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_TAG"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.UpdateResolver[model.Tag],
+		controller.update,
+	)
+
+	tagRouter.PATCH(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_TAG"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.PatchResolver(controller.service.GetById),
+		controller.update,
+	)
+
+	tagRouter.DELETE(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_TAG"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		controller.deleteById,
+	)
+}
+
+// tagController godoc
+// @Security BearerAuth
+// @Summary      getById
+// @Description  Get tag of current user by id
+// @Tags         Tag controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Tag.ID"
+// @Success      200	{object}  model.Tag
+// @Failure      400
+// @Failure      500
+// @Router       /api/asset/tags/{id} [GET]
+func (controller *tagController) getById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("TagController: GetById(id: %s): Start", id)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.GetById(ctx, id))
+	log.WithContext(ctx).Info("TagController: GetById(): End")
+}
+
+// tagController godoc
+// @Security BearerAuth
+// @Summary      getAll
+// @Description  Get all tags of current user ordered by name
+// @Tags         Tag controller
+// @Accept       json
+// @Produce      json
+// @Success      200	{array}  model.Tag
+// @Failure      400
+// @Failure      500
+// @Router       /api/asset/tags/ [GET]
+func (controller *tagController) getAll(ctx *gin.Context) {
+	log.WithContext(ctx).Info("TagController: GetAll(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetAll(ctx))
+	log.WithContext(ctx).Info("TagController: GetAll(): End")
+}
+
+// tagController godoc
+// @Security BearerAuth
+// @Summary      create
+// @Description  Create tag of current user, the name must be unique among the user tags
+// @Tags         Tag controller
+// @Accept       json
+// @Produce      json
+// @Param        tag	body	  model.Tag  true  "Create Tag"
+// @Success      201	{object}  model.Tag
+// @Failure      400
+// @Failure      409
+// @Failure      500
+// @Router       /api/asset/tags/ [POST]
+func (controller *tagController) create(ctx *gin.Context) {
+	log.WithContext(ctx).Info("TagController: Create(): Start")
+	tag := ctx.MustGet("RequestBody").(model.Tag)
+	commonUtil.AbortWithEntity(ctx, http.StatusCreated, controller.service.Create(ctx, tag))
+	log.WithContext(ctx).Info("TagController: Create(): End")
+}
+
+// tagController godoc
+// @Security BearerAuth
+// @Summary      update
+// @Description  Update tag of current user
+// @Tags         Tag controller
+// @Accept       json
+// @Produce      json
+// @Param        tag	body	  model.Tag  true  "Update Tag"
+// @Success      200	{object}  model.Tag
+// @Failure      400
+// @Failure      409
+// @Failure      500
+// @Router       /api/asset/tags/{id} [PUT]
+// @Router       /api/asset/tags/{id} [PATCH]
+func (controller *tagController) update(ctx *gin.Context) {
+	log.WithContext(ctx).Info("TagController: Update(): Start")
+	tag := ctx.MustGet("RequestBody").(model.Tag)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Update(ctx, tag))
+	log.WithContext(ctx).Info("TagController: Update(): End")
+}
+
+// tagController godoc
+// @Security BearerAuth
+// @Summary      deleteById
+// @Description  Delete tag of current user and detach it from assets and cash flows
+// @Tags         Tag controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Tag.ID"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/asset/tags/{id} [DELETE]
+func (controller *tagController) deleteById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("TagController: DeleteById(id: %s): Start", id)
+	controller.service.DeleteById(ctx, id)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("TagController: DeleteById(): End")
+}
@@ -0,0 +1,120 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+type CashFlowTotal struct {
+	Currency string             `json:"currency,omitempty"`
+	Income   float64            `json:"income"`
+	Expense  float64            `json:"expense"`
+	Net      float64            `json:"net"`
+	Real     *RealCashFlowTotal `json:"real,omitempty"`
+}
+
+// RealCashFlowTotal holds the amounts adjusted by the consumer price index to the money of the base date
+type RealCashFlowTotal struct {
+	Income  float64 `json:"income"`
+	Expense float64 `json:"expense"`
+	Net     float64 `json:"net"`
+}
+
+// MarketValue is the worth of the asset valued outside of its cash flows, e.g. securities at market prices
+type MarketValue struct {
+	Currency string  `json:"currency,omitempty"`
+	Value    float64 `json:"value"`
+}
+
+type OwnerSummary struct {
+	UserID uuid.UUID        `json:"userId,omitempty"`
+	Totals []*CashFlowTotal `json:"totals,omitempty"`
+}
+
+type AssetSummary struct {
+	AssetID      uuid.UUID        `json:"assetId,omitempty"`
+	Name         string           `json:"name,omitempty"`
+	From         *time.Time       `json:"from,omitempty"`
+	To           *time.Time       `json:"to,omitempty"`
+	BaseDate     *time.Time       `json:"baseDate,omitempty"`
+	Totals       []*CashFlowTotal `json:"totals,omitempty"`
+	MarketValues []*MarketValue   `json:"marketValues,omitempty"`
+	Owners       []*OwnerSummary  `json:"owners,omitempty"`
+}
+
+type PortfolioSummary struct {
+	UserID       uuid.UUID        `json:"userId,omitempty"`
+	From         *time.Time       `json:"from,omitempty"`
+	To           *time.Time       `json:"to,omitempty"`
+	BaseDate     *time.Time       `json:"baseDate,omitempty"`
+	TagIDs       []uuid.UUID      `json:"tagIds,omitempty"`
+	Totals       []*CashFlowTotal `json:"totals,omitempty"`
+	MarketValues []*MarketValue   `json:"marketValues,omitempty"`
+	Assets       []*AssetSummary  `json:"assets,omitempty"`
+}
+
+// TagSummary is the user's portfolio narrowed down to the assets and cash flows having the tag
+type TagSummary struct {
+	TagID        uuid.UUID        `json:"tagId,omitempty"`
+	Name         string           `json:"name,omitempty"`
+	Totals       []*CashFlowTotal `json:"totals,omitempty"`
+	MarketValues []*MarketValue   `json:"marketValues,omitempty"`
+}
+
+func NewMarketValue(currency string, value float64) *MarketValue {
+	return &MarketValue{Currency: currency, Value: value}
+}
+
+func NewCashFlowTotal(currency string) *CashFlowTotal {
+	return &CashFlowTotal{Currency: currency}
+}
+
+func (total *CashFlowTotal) AddIncome(amount float64) {
+	total.Income += amount
+	total.Net += amount
+}
+
+func (total *CashFlowTotal) AddExpense(amount float64) {
+	total.Expense += amount
+	total.Net -= amount
+}
+
+func (total *CashFlowTotal) AddRealIncome(amount float64) {
+	if total.Real == nil {
+		total.Real = &RealCashFlowTotal{}
+	}
+	total.Real.Income += amount
+	total.Real.Net += amount
+}
+
+func (total *CashFlowTotal) AddRealExpense(amount float64) {
+	if total.Real == nil {
+		total.Real = &RealCashFlowTotal{}
+	}
+	total.Real.Expense += amount
+	total.Real.Net -= amount
+}
@@ -0,0 +1,1250 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	uuid "github.com/google/uuid"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonDfaeaa7eDecodeAssetsModulesAssetModel(in *jlexer.Lexer, out *TagSummary) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "tagId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.TagID).UnmarshalText(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "totals":
+			if in.IsNull() {
+				in.Skip()
+				out.Totals = nil
+			} else {
+				in.Delim('[')
+				if out.Totals == nil {
+					if !in.IsDelim(']') {
+						out.Totals = make([]*CashFlowTotal, 0, 8)
+					} else {
+						out.Totals = []*CashFlowTotal{}
+					}
+				} else {
+					out.Totals = (out.Totals)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 *CashFlowTotal
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(CashFlowTotal)
+						}
+						(*v1).UnmarshalEasyJSON(in)
+					}
+					out.Totals = append(out.Totals, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "marketValues":
+			if in.IsNull() {
+				in.Skip()
+				out.MarketValues = nil
+			} else {
+				in.Delim('[')
+				if out.MarketValues == nil {
+					if !in.IsDelim(']') {
+						out.MarketValues = make([]*MarketValue, 0, 8)
+					} else {
+						out.MarketValues = []*MarketValue{}
+					}
+				} else {
+					out.MarketValues = (out.MarketValues)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 *MarketValue
+					if in.IsNull() {
+						in.Skip()
+						v2 = nil
+					} else {
+						if v2 == nil {
+							v2 = new(MarketValue)
+						}
+						(*v2).UnmarshalEasyJSON(in)
+					}
+					out.MarketValues = append(out.MarketValues, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDfaeaa7eEncodeAssetsModulesAssetModel(out *jwriter.Writer, in TagSummary) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"tagId\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.TagID).MarshalText())
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	if len(in.Totals) != 0 {
+		const prefix string = ",\"totals\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v3, v4 := range in.Totals {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				if v4 == nil {
+					out.RawString("null")
+				} else {
+					(*v4).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.MarketValues) != 0 {
+		const prefix string = ",\"marketValues\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.MarketValues {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				if v6 == nil {
+					out.RawString("null")
+				} else {
+					(*v6).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TagSummary) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDfaeaa7eEncodeAssetsModulesAssetModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TagSummary) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDfaeaa7eEncodeAssetsModulesAssetModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TagSummary) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDfaeaa7eDecodeAssetsModulesAssetModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TagSummary) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDfaeaa7eDecodeAssetsModulesAssetModel(l, v)
+}
+func easyjsonDfaeaa7eDecodeAssetsModulesAssetModel1(in *jlexer.Lexer, out *RealCashFlowTotal) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "income":
+			out.Income = float64(in.Float64())
+		case "expense":
+			out.Expense = float64(in.Float64())
+		case "net":
+			out.Net = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDfaeaa7eEncodeAssetsModulesAssetModel1(out *jwriter.Writer, in RealCashFlowTotal) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"income\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Income))
+	}
+	{
+		const prefix string = ",\"expense\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Expense))
+	}
+	{
+		const prefix string = ",\"net\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Net))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RealCashFlowTotal) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDfaeaa7eEncodeAssetsModulesAssetModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RealCashFlowTotal) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDfaeaa7eEncodeAssetsModulesAssetModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RealCashFlowTotal) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDfaeaa7eDecodeAssetsModulesAssetModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RealCashFlowTotal) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDfaeaa7eDecodeAssetsModulesAssetModel1(l, v)
+}
+func easyjsonDfaeaa7eDecodeAssetsModulesAssetModel2(in *jlexer.Lexer, out *PortfolioSummary) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "userId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserID).UnmarshalText(data))
+			}
+		case "from":
+			if in.IsNull() {
+				in.Skip()
+				out.From = nil
+			} else {
+				if out.From == nil {
+					out.From = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.From).UnmarshalJSON(data))
+				}
+			}
+		case "to":
+			if in.IsNull() {
+				in.Skip()
+				out.To = nil
+			} else {
+				if out.To == nil {
+					out.To = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.To).UnmarshalJSON(data))
+				}
+			}
+		case "baseDate":
+			if in.IsNull() {
+				in.Skip()
+				out.BaseDate = nil
+			} else {
+				if out.BaseDate == nil {
+					out.BaseDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.BaseDate).UnmarshalJSON(data))
+				}
+			}
+		case "tagIds":
+			if in.IsNull() {
+				in.Skip()
+				out.TagIDs = nil
+			} else {
+				in.Delim('[')
+				if out.TagIDs == nil {
+					if !in.IsDelim(']') {
+						out.TagIDs = make([]uuid.UUID, 0, 4)
+					} else {
+						out.TagIDs = []uuid.UUID{}
+					}
+				} else {
+					out.TagIDs = (out.TagIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 uuid.UUID
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((v7).UnmarshalText(data))
+					}
+					out.TagIDs = append(out.TagIDs, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "totals":
+			if in.IsNull() {
+				in.Skip()
+				out.Totals = nil
+			} else {
+				in.Delim('[')
+				if out.Totals == nil {
+					if !in.IsDelim(']') {
+						out.Totals = make([]*CashFlowTotal, 0, 8)
+					} else {
+						out.Totals = []*CashFlowTotal{}
+					}
+				} else {
+					out.Totals = (out.Totals)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v8 *CashFlowTotal
+					if in.IsNull() {
+						in.Skip()
+						v8 = nil
+					} else {
+						if v8 == nil {
+							v8 = new(CashFlowTotal)
+						}
+						(*v8).UnmarshalEasyJSON(in)
+					}
+					out.Totals = append(out.Totals, v8)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "marketValues":
+			if in.IsNull() {
+				in.Skip()
+				out.MarketValues = nil
+			} else {
+				in.Delim('[')
+				if out.MarketValues == nil {
+					if !in.IsDelim(']') {
+						out.MarketValues = make([]*MarketValue, 0, 8)
+					} else {
+						out.MarketValues = []*MarketValue{}
+					}
+				} else {
+					out.MarketValues = (out.MarketValues)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v9 *MarketValue
+					if in.IsNull() {
+						in.Skip()
+						v9 = nil
+					} else {
+						if v9 == nil {
+							v9 = new(MarketValue)
+						}
+						(*v9).UnmarshalEasyJSON(in)
+					}
+					out.MarketValues = append(out.MarketValues, v9)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "assets":
+			if in.IsNull() {
+				in.Skip()
+				out.Assets = nil
+			} else {
+				in.Delim('[')
+				if out.Assets == nil {
+					if !in.IsDelim(']') {
+						out.Assets = make([]*AssetSummary, 0, 8)
+					} else {
+						out.Assets = []*AssetSummary{}
+					}
+				} else {
+					out.Assets = (out.Assets)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 *AssetSummary
+					if in.IsNull() {
+						in.Skip()
+						v10 = nil
+					} else {
+						if v10 == nil {
+							v10 = new(AssetSummary)
+						}
+						(*v10).UnmarshalEasyJSON(in)
+					}
+					out.Assets = append(out.Assets, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDfaeaa7eEncodeAssetsModulesAssetModel2(out *jwriter.Writer, in PortfolioSummary) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"userId\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.UserID).MarshalText())
+	}
+	if in.From != nil {
+		const prefix string = ",\"from\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.From).MarshalJSON())
+	}
+	if in.To != nil {
+		const prefix string = ",\"to\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.To).MarshalJSON())
+	}
+	if in.BaseDate != nil {
+		const prefix string = ",\"baseDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.BaseDate).MarshalJSON())
+	}
+	if len(in.TagIDs) != 0 {
+		const prefix string = ",\"tagIds\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v11, v12 := range in.TagIDs {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v12).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Totals) != 0 {
+		const prefix string = ",\"totals\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v13, v14 := range in.Totals {
+				if v13 > 0 {
+					out.RawByte(',')
+				}
+				if v14 == nil {
+					out.RawString("null")
+				} else {
+					(*v14).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.MarketValues) != 0 {
+		const prefix string = ",\"marketValues\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v15, v16 := range in.MarketValues {
+				if v15 > 0 {
+					out.RawByte(',')
+				}
+				if v16 == nil {
+					out.RawString("null")
+				} else {
+					(*v16).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Assets) != 0 {
+		const prefix string = ",\"assets\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v17, v18 := range in.Assets {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				if v18 == nil {
+					out.RawString("null")
+				} else {
+					(*v18).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PortfolioSummary) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDfaeaa7eEncodeAssetsModulesAssetModel2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PortfolioSummary) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDfaeaa7eEncodeAssetsModulesAssetModel2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PortfolioSummary) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDfaeaa7eDecodeAssetsModulesAssetModel2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PortfolioSummary) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDfaeaa7eDecodeAssetsModulesAssetModel2(l, v)
+}
+func easyjsonDfaeaa7eDecodeAssetsModulesAssetModel3(in *jlexer.Lexer, out *OwnerSummary) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "userId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserID).UnmarshalText(data))
+			}
+		case "totals":
+			if in.IsNull() {
+				in.Skip()
+				out.Totals = nil
+			} else {
+				in.Delim('[')
+				if out.Totals == nil {
+					if !in.IsDelim(']') {
+						out.Totals = make([]*CashFlowTotal, 0, 8)
+					} else {
+						out.Totals = []*CashFlowTotal{}
+					}
+				} else {
+					out.Totals = (out.Totals)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 *CashFlowTotal
+					if in.IsNull() {
+						in.Skip()
+						v19 = nil
+					} else {
+						if v19 == nil {
+							v19 = new(CashFlowTotal)
+						}
+						(*v19).UnmarshalEasyJSON(in)
+					}
+					out.Totals = append(out.Totals, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDfaeaa7eEncodeAssetsModulesAssetModel3(out *jwriter.Writer, in OwnerSummary) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"userId\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.UserID).MarshalText())
+	}
+	if len(in.Totals) != 0 {
+		const prefix string = ",\"totals\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v20, v21 := range in.Totals {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				if v21 == nil {
+					out.RawString("null")
+				} else {
+					(*v21).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OwnerSummary) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDfaeaa7eEncodeAssetsModulesAssetModel3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OwnerSummary) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDfaeaa7eEncodeAssetsModulesAssetModel3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OwnerSummary) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDfaeaa7eDecodeAssetsModulesAssetModel3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OwnerSummary) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDfaeaa7eDecodeAssetsModulesAssetModel3(l, v)
+}
+func easyjsonDfaeaa7eDecodeAssetsModulesAssetModel4(in *jlexer.Lexer, out *MarketValue) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "currency":
+			out.Currency = string(in.String())
+		case "value":
+			out.Value = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDfaeaa7eEncodeAssetsModulesAssetModel4(out *jwriter.Writer, in MarketValue) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Currency != "" {
+		const prefix string = ",\"currency\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"value\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.Value))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarketValue) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDfaeaa7eEncodeAssetsModulesAssetModel4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarketValue) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDfaeaa7eEncodeAssetsModulesAssetModel4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarketValue) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDfaeaa7eDecodeAssetsModulesAssetModel4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarketValue) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDfaeaa7eDecodeAssetsModulesAssetModel4(l, v)
+}
+func easyjsonDfaeaa7eDecodeAssetsModulesAssetModel5(in *jlexer.Lexer, out *CashFlowTotal) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "currency":
+			out.Currency = string(in.String())
+		case "income":
+			out.Income = float64(in.Float64())
+		case "expense":
+			out.Expense = float64(in.Float64())
+		case "net":
+			out.Net = float64(in.Float64())
+		case "real":
+			if in.IsNull() {
+				in.Skip()
+				out.Real = nil
+			} else {
+				if out.Real == nil {
+					out.Real = new(RealCashFlowTotal)
+				}
+				(*out.Real).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDfaeaa7eEncodeAssetsModulesAssetModel5(out *jwriter.Writer, in CashFlowTotal) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Currency != "" {
+		const prefix string = ",\"currency\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"income\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.Income))
+	}
+	{
+		const prefix string = ",\"expense\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Expense))
+	}
+	{
+		const prefix string = ",\"net\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Net))
+	}
+	if in.Real != nil {
+		const prefix string = ",\"real\":"
+		out.RawString(prefix)
+		(*in.Real).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CashFlowTotal) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDfaeaa7eEncodeAssetsModulesAssetModel5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CashFlowTotal) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDfaeaa7eEncodeAssetsModulesAssetModel5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CashFlowTotal) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDfaeaa7eDecodeAssetsModulesAssetModel5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CashFlowTotal) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDfaeaa7eDecodeAssetsModulesAssetModel5(l, v)
+}
+func easyjsonDfaeaa7eDecodeAssetsModulesAssetModel6(in *jlexer.Lexer, out *AssetSummary) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "assetId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AssetID).UnmarshalText(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "from":
+			if in.IsNull() {
+				in.Skip()
+				out.From = nil
+			} else {
+				if out.From == nil {
+					out.From = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.From).UnmarshalJSON(data))
+				}
+			}
+		case "to":
+			if in.IsNull() {
+				in.Skip()
+				out.To = nil
+			} else {
+				if out.To == nil {
+					out.To = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.To).UnmarshalJSON(data))
+				}
+			}
+		case "baseDate":
+			if in.IsNull() {
+				in.Skip()
+				out.BaseDate = nil
+			} else {
+				if out.BaseDate == nil {
+					out.BaseDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.BaseDate).UnmarshalJSON(data))
+				}
+			}
+		case "totals":
+			if in.IsNull() {
+				in.Skip()
+				out.Totals = nil
+			} else {
+				in.Delim('[')
+				if out.Totals == nil {
+					if !in.IsDelim(']') {
+						out.Totals = make([]*CashFlowTotal, 0, 8)
+					} else {
+						out.Totals = []*CashFlowTotal{}
+					}
+				} else {
+					out.Totals = (out.Totals)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 *CashFlowTotal
+					if in.IsNull() {
+						in.Skip()
+						v22 = nil
+					} else {
+						if v22 == nil {
+							v22 = new(CashFlowTotal)
+						}
+						(*v22).UnmarshalEasyJSON(in)
+					}
+					out.Totals = append(out.Totals, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "marketValues":
+			if in.IsNull() {
+				in.Skip()
+				out.MarketValues = nil
+			} else {
+				in.Delim('[')
+				if out.MarketValues == nil {
+					if !in.IsDelim(']') {
+						out.MarketValues = make([]*MarketValue, 0, 8)
+					} else {
+						out.MarketValues = []*MarketValue{}
+					}
+				} else {
+					out.MarketValues = (out.MarketValues)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v23 *MarketValue
+					if in.IsNull() {
+						in.Skip()
+						v23 = nil
+					} else {
+						if v23 == nil {
+							v23 = new(MarketValue)
+						}
+						(*v23).UnmarshalEasyJSON(in)
+					}
+					out.MarketValues = append(out.MarketValues, v23)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "owners":
+			if in.IsNull() {
+				in.Skip()
+				out.Owners = nil
+			} else {
+				in.Delim('[')
+				if out.Owners == nil {
+					if !in.IsDelim(']') {
+						out.Owners = make([]*OwnerSummary, 0, 8)
+					} else {
+						out.Owners = []*OwnerSummary{}
+					}
+				} else {
+					out.Owners = (out.Owners)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v24 *OwnerSummary
+					if in.IsNull() {
+						in.Skip()
+						v24 = nil
+					} else {
+						if v24 == nil {
+							v24 = new(OwnerSummary)
+						}
+						(*v24).UnmarshalEasyJSON(in)
+					}
+					out.Owners = append(out.Owners, v24)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDfaeaa7eEncodeAssetsModulesAssetModel6(out *jwriter.Writer, in AssetSummary) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"assetId\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.AssetID).MarshalText())
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	if in.From != nil {
+		const prefix string = ",\"from\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.From).MarshalJSON())
+	}
+	if in.To != nil {
+		const prefix string = ",\"to\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.To).MarshalJSON())
+	}
+	if in.BaseDate != nil {
+		const prefix string = ",\"baseDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.BaseDate).MarshalJSON())
+	}
+	if len(in.Totals) != 0 {
+		const prefix string = ",\"totals\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v25, v26 := range in.Totals {
+				if v25 > 0 {
+					out.RawByte(',')
+				}
+				if v26 == nil {
+					out.RawString("null")
+				} else {
+					(*v26).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.MarketValues) != 0 {
+		const prefix string = ",\"marketValues\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v27, v28 := range in.MarketValues {
+				if v27 > 0 {
+					out.RawByte(',')
+				}
+				if v28 == nil {
+					out.RawString("null")
+				} else {
+					(*v28).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Owners) != 0 {
+		const prefix string = ",\"owners\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v29, v30 := range in.Owners {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				if v30 == nil {
+					out.RawString("null")
+				} else {
+					(*v30).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AssetSummary) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDfaeaa7eEncodeAssetsModulesAssetModel6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AssetSummary) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDfaeaa7eEncodeAssetsModulesAssetModel6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AssetSummary) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDfaeaa7eDecodeAssetsModulesAssetModel6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AssetSummary) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDfaeaa7eDecodeAssetsModulesAssetModel6(l, v)
+}
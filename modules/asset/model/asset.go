@@ -1,9 +1,12 @@
 package model
 
 import (
+	commonModel "assets/common/model"
 	commonUtil "assets/common/util"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"math"
+	"time"
 )
 
 /*
@@ -30,15 +33,24 @@ import (
  * © "DEADLINE TEAM" LLC, All rights reserved.
  */
 
+// EarthRadius is the mean radius of the Earth in meters
+const EarthRadius = 6371008.8
+
 type Asset struct {
-	ID       uuid.UUID   `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
-	OwnerID  uuid.UUID   `json:"ownerId,omitempty"`
-	CityID   uuid.UUID   `json:"cityId,omitempty"`
-	Name     string      `json:"name,omitempty"`
-	Type     string      `json:"type,omitempty"`
-	Address  string      `json:"address,omitempty"`
-	Incomes  []*CashFlow `json:"incomes,omitempty" gorm:"many2many:asset_income_cash_flow;"`
-	Expenses []*CashFlow `json:"expenses,omitempty" gorm:"many2many:asset_expense_cash_flow;"`
+	ID        uuid.UUID     `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	OwnerID   uuid.UUID     `json:"ownerId,omitempty"`
+	CityID    uuid.UUID     `json:"cityId,omitempty"`
+	Name      string        `json:"name,omitempty"`
+	Type      string        `json:"type,omitempty"`
+	Address   string        `json:"address,omitempty"`
+	Latitude  *float64      `json:"latitude,omitempty" gorm:"index:idx_asset_location"`
+	Longitude *float64      `json:"longitude,omitempty" gorm:"index:idx_asset_location"`
+	Incomes   []*CashFlow   `json:"incomes,omitempty" gorm:"many2many:asset_income_cash_flow;"`
+	Expenses  []*CashFlow   `json:"expenses,omitempty" gorm:"many2many:asset_expense_cash_flow;"`
+	Shares    []*AssetShare `json:"shares,omitempty"`
+	Tags      []*Tag        `json:"tags,omitempty" gorm:"many2many:asset_tag;"`
+	commonModel.Versioned
+	commonModel.SoftDeletable
 }
 
 func (asset Asset) GetID() uuid.UUID {
@@ -51,3 +63,52 @@ func (asset *Asset) BeforeCreate(tx *gorm.DB) error {
 	}
 	return nil
 }
+
+// ShareOf returns the percentage of the asset owned by the user at the given date.
+// The part not covered by active shares belongs to the asset owner.
+func (asset Asset) ShareOf(userId uuid.UUID, date time.Time) float64 {
+	var result, allocated float64
+	for _, share := range asset.Shares {
+		if !share.IsActiveAt(date) {
+			continue
+		}
+		allocated += share.Percentage
+		if share.UserID == userId {
+			result += share.Percentage
+		}
+	}
+	if userId == asset.OwnerID && allocated < 100 {
+		result += 100 - allocated
+	}
+	return result
+}
+
+func (asset Asset) GetOwnerIds() []uuid.UUID {
+	result := []uuid.UUID{asset.OwnerID}
+	for _, share := range asset.Shares {
+		result = append(result, share.UserID)
+	}
+	return commonUtil.Unique(commonUtil.ArrayNotZero(result))
+}
+
+func (asset Asset) HasLocation() bool {
+	return asset.Latitude != nil && asset.Longitude != nil
+}
+
+// IsValidLocation checks both coordinates are either set within their ranges or not set at all
+func (asset Asset) IsValidLocation() bool {
+	if asset.Latitude == nil || asset.Longitude == nil {
+		return asset.Latitude == nil && asset.Longitude == nil
+	}
+	return *asset.Latitude >= -90 && *asset.Latitude <= 90 && *asset.Longitude >= -180 && *asset.Longitude <= 180
+}
+
+// DistanceTo returns the great-circle distance in meters from the asset to the point
+func (asset Asset) DistanceTo(latitude float64, longitude float64) float64 {
+	toRadians := func(degrees float64) float64 { return degrees * math.Pi / 180 }
+	latitudeDelta := toRadians(latitude - *asset.Latitude)
+	longitudeDelta := toRadians(longitude - *asset.Longitude)
+	a := math.Pow(math.Sin(latitudeDelta/2), 2) +
+		math.Cos(toRadians(*asset.Latitude))*math.Cos(toRadians(latitude))*math.Pow(math.Sin(longitudeDelta/2), 2)
+	return 2 * EarthRadius * math.Asin(math.Sqrt(a))
+}
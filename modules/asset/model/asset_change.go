@@ -0,0 +1,106 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"fmt"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+// AssetChange records the edit of one asset field, values are kept as text
+type AssetChange struct {
+	ID       uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	AssetID  uuid.UUID  `json:"assetId,omitempty" gorm:"type:uuid;index"`
+	UserID   *uuid.UUID `json:"userId,omitempty" gorm:"type:uuid"`
+	Date     *time.Time `json:"date,omitempty"`
+	Field    string     `json:"field,omitempty"`
+	OldValue string     `json:"oldValue,omitempty"`
+	NewValue string     `json:"newValue,omitempty"`
+	commonModel.Versioned
+}
+
+func (change AssetChange) GetID() uuid.UUID {
+	return change.ID
+}
+
+func (change *AssetChange) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(change.ID) {
+		change.ID = uuid.New()
+	}
+	return nil
+}
+
+// NewAssetChanges compares the own fields of the asset, associations are not tracked
+func NewAssetChanges(stored Asset, asset Asset, userId *uuid.UUID, date time.Time) []AssetChange {
+	fields := []struct {
+		name     string
+		oldValue string
+		newValue string
+	}{
+		{"ownerId", formatValue(stored.OwnerID), formatValue(asset.OwnerID)},
+		{"cityId", formatValue(stored.CityID), formatValue(asset.CityID)},
+		{"name", stored.Name, asset.Name},
+		{"type", stored.Type, asset.Type},
+		{"address", stored.Address, asset.Address},
+		{"latitude", formatValue(stored.Latitude), formatValue(asset.Latitude)},
+		{"longitude", formatValue(stored.Longitude), formatValue(asset.Longitude)},
+	}
+
+	var result []AssetChange
+	for _, field := range fields {
+		if field.oldValue != field.newValue {
+			result = append(result, AssetChange{
+				AssetID:  asset.ID,
+				UserID:   userId,
+				Date:     &date,
+				Field:    field.name,
+				OldValue: field.oldValue,
+				NewValue: field.newValue,
+			})
+		}
+	}
+	return result
+}
+
+func formatValue(value any) string {
+	switch it := value.(type) {
+	case uuid.UUID:
+		if it == uuid.Nil {
+			return ""
+		}
+		return it.String()
+	case *float64:
+		if it == nil {
+			return ""
+		}
+		return fmt.Sprint(*it)
+	default:
+		return fmt.Sprint(it)
+	}
+}
@@ -0,0 +1,196 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	uuid "github.com/google/uuid"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson1c58cf07DecodeAssetsModulesAssetModel(in *jlexer.Lexer, out *AssetChange) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "assetId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AssetID).UnmarshalText(data))
+			}
+		case "userId":
+			if in.IsNull() {
+				in.Skip()
+				out.UserID = nil
+			} else {
+				if out.UserID == nil {
+					out.UserID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.UserID).UnmarshalText(data))
+				}
+			}
+		case "date":
+			if in.IsNull() {
+				in.Skip()
+				out.Date = nil
+			} else {
+				if out.Date == nil {
+					out.Date = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.Date).UnmarshalJSON(data))
+				}
+			}
+		case "field":
+			out.Field = string(in.String())
+		case "oldValue":
+			out.OldValue = string(in.String())
+		case "newValue":
+			out.NewValue = string(in.String())
+		case "version":
+			out.Version = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1c58cf07EncodeAssetsModulesAssetModel(out *jwriter.Writer, in AssetChange) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"assetId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.AssetID).MarshalText())
+	}
+	if in.UserID != nil {
+		const prefix string = ",\"userId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((*in.UserID).MarshalText())
+	}
+	if in.Date != nil {
+		const prefix string = ",\"date\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.Date).MarshalJSON())
+	}
+	if in.Field != "" {
+		const prefix string = ",\"field\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Field))
+	}
+	if in.OldValue != "" {
+		const prefix string = ",\"oldValue\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.OldValue))
+	}
+	if in.NewValue != "" {
+		const prefix string = ",\"newValue\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.NewValue))
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AssetChange) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1c58cf07EncodeAssetsModulesAssetModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AssetChange) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1c58cf07EncodeAssetsModulesAssetModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AssetChange) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1c58cf07DecodeAssetsModulesAssetModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AssetChange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1c58cf07DecodeAssetsModulesAssetModel(l, v)
+}
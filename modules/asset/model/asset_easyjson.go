@@ -4,6 +4,7 @@ package model
 
 import (
 	json "encoding/json"
+	uuid "github.com/google/uuid"
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
@@ -54,6 +55,26 @@ func easyjson3b94576aDecodeAssetsModulesAssetModel(in *jlexer.Lexer, out *Asset)
 			out.Type = string(in.String())
 		case "address":
 			out.Address = string(in.String())
+		case "latitude":
+			if in.IsNull() {
+				in.Skip()
+				out.Latitude = nil
+			} else {
+				if out.Latitude == nil {
+					out.Latitude = new(float64)
+				}
+				*out.Latitude = float64(in.Float64())
+			}
+		case "longitude":
+			if in.IsNull() {
+				in.Skip()
+				out.Longitude = nil
+			} else {
+				if out.Longitude == nil {
+					out.Longitude = new(float64)
+				}
+				*out.Longitude = float64(in.Float64())
+			}
 		case "incomes":
 			if in.IsNull() {
 				in.Skip()
@@ -116,6 +137,86 @@ func easyjson3b94576aDecodeAssetsModulesAssetModel(in *jlexer.Lexer, out *Asset)
 				}
 				in.Delim(']')
 			}
+		case "shares":
+			if in.IsNull() {
+				in.Skip()
+				out.Shares = nil
+			} else {
+				in.Delim('[')
+				if out.Shares == nil {
+					if !in.IsDelim(']') {
+						out.Shares = make([]*AssetShare, 0, 8)
+					} else {
+						out.Shares = []*AssetShare{}
+					}
+				} else {
+					out.Shares = (out.Shares)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 *AssetShare
+					if in.IsNull() {
+						in.Skip()
+						v3 = nil
+					} else {
+						if v3 == nil {
+							v3 = new(AssetShare)
+						}
+						(*v3).UnmarshalEasyJSON(in)
+					}
+					out.Shares = append(out.Shares, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]*Tag, 0, 8)
+					} else {
+						out.Tags = []*Tag{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 *Tag
+					if in.IsNull() {
+						in.Skip()
+						v4 = nil
+					} else {
+						if v4 == nil {
+							v4 = new(Tag)
+						}
+						(*v4).UnmarshalEasyJSON(in)
+					}
+					out.Tags = append(out.Tags, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "deletedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DeletedAt).UnmarshalJSON(data))
+			}
+		case "deletedBy":
+			if in.IsNull() {
+				in.Skip()
+				out.DeletedBy = nil
+			} else {
+				if out.DeletedBy == nil {
+					out.DeletedBy = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.DeletedBy).UnmarshalText(data))
+				}
+			}
+		case "version":
+			out.Version = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -186,6 +287,26 @@ func easyjson3b94576aEncodeAssetsModulesAssetModel(out *jwriter.Writer, in Asset
 		}
 		out.String(string(in.Address))
 	}
+	if in.Latitude != nil {
+		const prefix string = ",\"latitude\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(*in.Latitude))
+	}
+	if in.Longitude != nil {
+		const prefix string = ",\"longitude\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(*in.Longitude))
+	}
 	if len(in.Incomes) != 0 {
 		const prefix string = ",\"incomes\":"
 		if first {
@@ -196,14 +317,14 @@ func easyjson3b94576aEncodeAssetsModulesAssetModel(out *jwriter.Writer, in Asset
 		}
 		{
 			out.RawByte('[')
-			for v3, v4 := range in.Incomes {
-				if v3 > 0 {
+			for v5, v6 := range in.Incomes {
+				if v5 > 0 {
 					out.RawByte(',')
 				}
-				if v4 == nil {
+				if v6 == nil {
 					out.RawString("null")
 				} else {
-					(*v4).MarshalEasyJSON(out)
+					(*v6).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -219,19 +340,95 @@ func easyjson3b94576aEncodeAssetsModulesAssetModel(out *jwriter.Writer, in Asset
 		}
 		{
 			out.RawByte('[')
-			for v5, v6 := range in.Expenses {
-				if v5 > 0 {
+			for v7, v8 := range in.Expenses {
+				if v7 > 0 {
 					out.RawByte(',')
 				}
-				if v6 == nil {
+				if v8 == nil {
 					out.RawString("null")
 				} else {
-					(*v6).MarshalEasyJSON(out)
+					(*v8).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
 		}
 	}
+	if len(in.Shares) != 0 {
+		const prefix string = ",\"shares\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v9, v10 := range in.Shares {
+				if v9 > 0 {
+					out.RawByte(',')
+				}
+				if v10 == nil {
+					out.RawString("null")
+				} else {
+					(*v10).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Tags) != 0 {
+		const prefix string = ",\"tags\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v11, v12 := range in.Tags {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				if v12 == nil {
+					out.RawString("null")
+				} else {
+					(*v12).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if true {
+		const prefix string = ",\"deletedAt\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.DeletedAt).MarshalJSON())
+	}
+	if in.DeletedBy != nil {
+		const prefix string = ",\"deletedBy\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((*in.DeletedBy).MarshalText())
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
 	out.RawByte('}')
 }
 
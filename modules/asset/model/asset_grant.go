@@ -0,0 +1,67 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const (
+	ReadPermission  = "READ"
+	WritePermission = "WRITE"
+)
+
+type AssetGrant struct {
+	ID         uuid.UUID `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	AssetID    uuid.UUID `json:"assetId,omitempty" gorm:"type:uuid;index"`
+	UserID     uuid.UUID `json:"userId,omitempty" gorm:"type:uuid;index"`
+	Permission string    `json:"permission,omitempty"`
+	commonModel.Versioned
+}
+
+func (grant AssetGrant) GetID() uuid.UUID {
+	return grant.ID
+}
+
+func (grant *AssetGrant) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(grant.ID) {
+		grant.ID = uuid.New()
+	}
+	return nil
+}
+
+func (grant AssetGrant) IsValid() bool {
+	return !commonUtil.IsZeroObject(grant.UserID) && (grant.Permission == ReadPermission || grant.Permission == WritePermission)
+}
+
+func NewAssetGrant(userId uuid.UUID, permission string) AssetGrant {
+	return AssetGrant{
+		UserID:     userId,
+		Permission: permission,
+	}
+}
@@ -0,0 +1,126 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"sort"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+type AssetShare struct {
+	ID         uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	AssetID    uuid.UUID  `json:"assetId,omitempty" gorm:"type:uuid;index"`
+	UserID     uuid.UUID  `json:"userId,omitempty" gorm:"type:uuid;index"`
+	Percentage float64    `json:"percentage,omitempty"`
+	ValidFrom  *time.Time `json:"validFrom,omitempty"`
+	ValidTo    *time.Time `json:"validTo,omitempty"`
+	commonModel.Versioned
+}
+
+func (share AssetShare) GetID() uuid.UUID {
+	return share.ID
+}
+
+func (share *AssetShare) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(share.ID) {
+		share.ID = uuid.New()
+	}
+	return nil
+}
+
+func (share AssetShare) IsActiveAt(date time.Time) bool {
+	if share.ValidFrom != nil && date.Before(*share.ValidFrom) {
+		return false
+	}
+	if share.ValidTo != nil && !date.Before(*share.ValidTo) {
+		return false
+	}
+	return true
+}
+
+func NewAssetShare(userId uuid.UUID, percentage float64, opts ...AssetShareOption) AssetShare {
+	share := AssetShare{
+		UserID:     userId,
+		Percentage: percentage,
+	}
+
+	for _, opt := range opts {
+		opt(&share)
+	}
+
+	return share
+}
+
+type AssetShareOption func(*AssetShare)
+
+func AssetShareWithPeriod(validFrom *time.Time, validTo *time.Time) AssetShareOption {
+	return func(share *AssetShare) {
+		share.ValidFrom = validFrom
+		share.ValidTo = validTo
+	}
+}
+
+// ValidateShares checks that every share has a sane percentage and period and that
+// at no moment the shares active at the same time exceed 100 percent in total.
+func ValidateShares(shares []*AssetShare) bool {
+	// the total changes only when some share starts, so it is enough to check these moments
+	var moments []time.Time
+	for _, share := range shares {
+		if share.Percentage <= 0 || share.Percentage > 100 || commonUtil.IsZeroObject(share.UserID) {
+			return false
+		}
+		if share.ValidFrom != nil && share.ValidTo != nil && !share.ValidTo.After(*share.ValidFrom) {
+			return false
+		}
+		if share.ValidFrom != nil {
+			moments = append(moments, *share.ValidFrom)
+		}
+	}
+	sort.Slice(moments, func(i, j int) bool { return moments[i].Before(moments[j]) })
+
+	total := func(isActive func(share *AssetShare) bool) float64 {
+		var result float64
+		for _, share := range shares {
+			if isActive(share) {
+				result += share.Percentage
+			}
+		}
+		return result
+	}
+
+	if total(func(share *AssetShare) bool { return share.ValidFrom == nil }) > 100 {
+		return false
+	}
+	for _, moment := range moments {
+		if total(func(share *AssetShare) bool { return share.IsActiveAt(moment) }) > 100 {
+			return false
+		}
+	}
+	return true
+}
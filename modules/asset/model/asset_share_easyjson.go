@@ -0,0 +1,185 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson4cf022daDecodeAssetsModulesAssetModel(in *jlexer.Lexer, out *AssetShare) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "assetId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AssetID).UnmarshalText(data))
+			}
+		case "userId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserID).UnmarshalText(data))
+			}
+		case "percentage":
+			out.Percentage = float64(in.Float64())
+		case "validFrom":
+			if in.IsNull() {
+				in.Skip()
+				out.ValidFrom = nil
+			} else {
+				if out.ValidFrom == nil {
+					out.ValidFrom = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ValidFrom).UnmarshalJSON(data))
+				}
+			}
+		case "validTo":
+			if in.IsNull() {
+				in.Skip()
+				out.ValidTo = nil
+			} else {
+				if out.ValidTo == nil {
+					out.ValidTo = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ValidTo).UnmarshalJSON(data))
+				}
+			}
+		case "version":
+			out.Version = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4cf022daEncodeAssetsModulesAssetModel(out *jwriter.Writer, in AssetShare) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"assetId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.AssetID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"userId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.UserID).MarshalText())
+	}
+	if in.Percentage != 0 {
+		const prefix string = ",\"percentage\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.Percentage))
+	}
+	if in.ValidFrom != nil {
+		const prefix string = ",\"validFrom\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.ValidFrom).MarshalJSON())
+	}
+	if in.ValidTo != nil {
+		const prefix string = ",\"validTo\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.ValidTo).MarshalJSON())
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AssetShare) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4cf022daEncodeAssetsModulesAssetModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AssetShare) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4cf022daEncodeAssetsModulesAssetModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AssetShare) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4cf022daDecodeAssetsModulesAssetModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AssetShare) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4cf022daDecodeAssetsModulesAssetModel(l, v)
+}
@@ -0,0 +1,95 @@
+package model
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"github.com/google/uuid"
+	"testing"
+	"time"
+)
+
+func TestValidateShares(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+	date := func(month time.Month) *time.Time {
+		result := time.Date(2024, month, 1, 0, 0, 0, 0, time.UTC)
+		return &result
+	}
+	share := func(userId uuid.UUID, percentage float64, validFrom *time.Time, validTo *time.Time) *AssetShare {
+		result := NewAssetShare(userId, percentage, AssetShareWithPeriod(validFrom, validTo))
+		return &result
+	}
+
+	tests := []struct {
+		name   string
+		shares []*AssetShare
+		want   bool
+	}{
+		{"no shares", nil, true},
+		{"sole owner", []*AssetShare{share(first, 100, nil, nil)}, true},
+		{"halves", []*AssetShare{share(first, 50, nil, nil), share(second, 50, nil, nil)}, true},
+		{"over 100 percent", []*AssetShare{share(first, 60, nil, nil), share(second, 50, nil, nil)}, false},
+		{"zero percentage", []*AssetShare{share(first, 0, nil, nil)}, false},
+		{"negative percentage", []*AssetShare{share(first, -10, nil, nil)}, false},
+		{"percentage over 100", []*AssetShare{share(first, 101, nil, nil)}, false},
+		{"no user", []*AssetShare{share(uuid.Nil, 10, nil, nil)}, false},
+		{"empty period", []*AssetShare{share(first, 10, date(3), date(3))}, false},
+		{"reversed period", []*AssetShare{share(first, 10, date(3), date(2))}, false},
+		{"sold share passed on", []*AssetShare{share(first, 100, nil, date(6)), share(second, 100, date(6), nil)}, true},
+		{"overlapping periods", []*AssetShare{share(first, 100, nil, date(7)), share(second, 100, date(6), nil)}, false},
+		{"later share over 100 percent", []*AssetShare{share(first, 70, nil, nil), share(second, 40, date(6), date(8))}, false},
+		{"shares of different periods", []*AssetShare{share(first, 70, date(1), date(3)), share(second, 70, date(3), date(5)), share(first, 30, nil, nil)}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := ValidateShares(test.shares); got != test.want {
+				t.Errorf("ValidateShares() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAssetShareIsActiveAt(t *testing.T) {
+	validFrom := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	validTo := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	share := NewAssetShare(uuid.New(), 50, AssetShareWithPeriod(&validFrom, &validTo))
+
+	tests := []struct {
+		date time.Time
+		want bool
+	}{
+		{validFrom.Add(-time.Second), false},
+		{validFrom, true},
+		{validTo.Add(-time.Second), true},
+		{validTo, false},
+	}
+	for _, test := range tests {
+		if got := share.IsActiveAt(test.date); got != test.want {
+			t.Errorf("IsActiveAt(%s) = %v, want %v", test.date, got, test.want)
+		}
+	}
+	if !NewAssetShare(uuid.New(), 50).IsActiveAt(time.Time{}) {
+		t.Error("share without period isn't active")
+	}
+}
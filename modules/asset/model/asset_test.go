@@ -0,0 +1,62 @@
+package model
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"github.com/google/uuid"
+	"testing"
+	"time"
+)
+
+func TestAssetShareOf(t *testing.T) {
+	owner, partner, stranger := uuid.New(), uuid.New(), uuid.New()
+	saleDate := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	before, after := saleDate.AddDate(0, -1, 0), saleDate.AddDate(0, 1, 0)
+	partnerShare := NewAssetShare(partner, 40, AssetShareWithPeriod(&saleDate, nil))
+	ownerShare := NewAssetShare(owner, 10)
+
+	tests := []struct {
+		name   string
+		asset  Asset
+		userId uuid.UUID
+		date   time.Time
+		want   float64
+	}{
+		{"owner without shares", Asset{OwnerID: owner}, owner, after, 100},
+		{"stranger", Asset{OwnerID: owner, Shares: []*AssetShare{&partnerShare}}, stranger, after, 0},
+		{"partner after the sale", Asset{OwnerID: owner, Shares: []*AssetShare{&partnerShare}}, partner, after, 40},
+		{"owner keeps the rest", Asset{OwnerID: owner, Shares: []*AssetShare{&partnerShare}}, owner, after, 60},
+		{"partner before the sale", Asset{OwnerID: owner, Shares: []*AssetShare{&partnerShare}}, partner, before, 0},
+		{"owner before the sale", Asset{OwnerID: owner, Shares: []*AssetShare{&partnerShare}}, owner, before, 100},
+		{"owner with own share", Asset{OwnerID: owner, Shares: []*AssetShare{&partnerShare, &ownerShare}}, owner, after, 60},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.asset.ShareOf(test.userId, test.date); got != test.want {
+				t.Errorf("ShareOf() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
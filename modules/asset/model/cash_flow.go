@@ -1,6 +1,7 @@
 package model
 
 import (
+	commonModel "assets/common/model"
 	commonUtil "assets/common/util"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -32,18 +33,27 @@ import (
  */
 
 type CashFlow struct {
-	ID          uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
-	Type        string     `json:"type,omitempty"`
-	Date        *time.Time `json:"date,omitempty"`
-	Amount      float64    `json:"amount,omitempty"`
-	Currency    string     `json:"currency,omitempty"`
-	Description string     `json:"description,omitempty"`
+	ID           uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	Type         string     `json:"type,omitempty"`
+	Date         *time.Time `json:"date,omitempty"`
+	Amount       float64    `json:"amount,omitempty"`
+	Currency     string     `json:"currency,omitempty"`
+	Description  string     `json:"description,omitempty"`
+	Counterparty string     `json:"counterparty,omitempty"`
+	CreatedBy    uuid.UUID  `json:"createdBy,omitempty" gorm:"type:uuid;index"`
+	Tags         []*Tag     `json:"tags,omitempty" gorm:"many2many:cash_flow_tag;"`
+	commonModel.Versioned
+	commonModel.SoftDeletable
 }
 
 func (cashFlow CashFlow) GetID() uuid.UUID {
 	return cashFlow.ID
 }
 
+func (cashFlow CashFlow) IsValid() bool {
+	return cashFlow.Type != "" && cashFlow.Currency != ""
+}
+
 func (cashFlow *CashFlow) BeforeCreate(tx *gorm.DB) error {
 	if commonUtil.IsZeroObject(cashFlow.ID) {
 		cashFlow.ID = uuid.New()
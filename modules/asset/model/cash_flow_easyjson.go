@@ -4,6 +4,7 @@ package model
 
 import (
 	json "encoding/json"
+	uuid "github.com/google/uuid"
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
@@ -61,6 +62,61 @@ func easyjson50eaff42DecodeAssetsModulesAssetModel(in *jlexer.Lexer, out *CashFl
 			out.Currency = string(in.String())
 		case "description":
 			out.Description = string(in.String())
+		case "counterparty":
+			out.Counterparty = string(in.String())
+		case "createdBy":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.CreatedBy).UnmarshalText(data))
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]*Tag, 0, 8)
+					} else {
+						out.Tags = []*Tag{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 *Tag
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(Tag)
+						}
+						(*v1).UnmarshalEasyJSON(in)
+					}
+					out.Tags = append(out.Tags, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "deletedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DeletedAt).UnmarshalJSON(data))
+			}
+		case "deletedBy":
+			if in.IsNull() {
+				in.Skip()
+				out.DeletedBy = nil
+			} else {
+				if out.DeletedBy == nil {
+					out.DeletedBy = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.DeletedBy).UnmarshalText(data))
+				}
+			}
+		case "version":
+			out.Version = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -131,6 +187,79 @@ func easyjson50eaff42EncodeAssetsModulesAssetModel(out *jwriter.Writer, in CashF
 		}
 		out.String(string(in.Description))
 	}
+	if in.Counterparty != "" {
+		const prefix string = ",\"counterparty\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Counterparty))
+	}
+	if true {
+		const prefix string = ",\"createdBy\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.CreatedBy).MarshalText())
+	}
+	if len(in.Tags) != 0 {
+		const prefix string = ",\"tags\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Tags {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				if v3 == nil {
+					out.RawString("null")
+				} else {
+					(*v3).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if true {
+		const prefix string = ",\"deletedAt\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.DeletedAt).MarshalJSON())
+	}
+	if in.DeletedBy != nil {
+		const prefix string = ",\"deletedBy\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((*in.DeletedBy).MarshalText())
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
 	out.RawByte('}')
 }
 
@@ -0,0 +1,120 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"math"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+// FeatureCollection is a GeoJSON (RFC 7946) collection of asset points for map rendering
+type FeatureCollection struct {
+	Type     string     `json:"type"`
+	Features []*Feature `json:"features"`
+}
+
+type Feature struct {
+	Type       string            `json:"type"`
+	ID         uuid.UUID         `json:"id"`
+	Geometry   Geometry          `json:"geometry"`
+	Properties FeatureProperties `json:"properties"`
+}
+
+// Geometry is a point, coordinates go in longitude, latitude order
+type Geometry struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+type FeatureProperties struct {
+	Name     string    `json:"name,omitempty"`
+	Type     string    `json:"type,omitempty"`
+	Address  string    `json:"address,omitempty"`
+	CityID   uuid.UUID `json:"cityId,omitempty"`
+	OwnerID  uuid.UUID `json:"ownerId,omitempty"`
+	Distance *float64  `json:"distance,omitempty"`
+}
+
+func NewFeatureCollection(assets []Asset) FeatureCollection {
+	collection := FeatureCollection{Type: "FeatureCollection", Features: make([]*Feature, 0, len(assets))}
+	for _, asset := range assets {
+		if asset.HasLocation() {
+			collection.Features = append(collection.Features, NewFeature(asset))
+		}
+	}
+	return collection
+}
+
+func NewFeature(asset Asset) *Feature {
+	return &Feature{
+		Type: "Feature",
+		ID:   asset.ID,
+		Geometry: Geometry{
+			Type:        "Point",
+			Coordinates: [2]float64{*asset.Longitude, *asset.Latitude},
+		},
+		Properties: FeatureProperties{
+			Name:    asset.Name,
+			Type:    asset.Type,
+			Address: asset.Address,
+			CityID:  asset.CityID,
+			OwnerID: asset.OwnerID,
+		},
+	}
+}
+
+type BoundingBox struct {
+	MinLatitude  float64
+	MinLongitude float64
+	MaxLatitude  float64
+	MaxLongitude float64
+}
+
+// IsValid checks the coordinates ranges, MinLongitude greater than MaxLongitude means the box crosses the antimeridian
+func (box BoundingBox) IsValid() bool {
+	return box.MinLatitude >= -90 && box.MaxLatitude <= 90 && box.MinLatitude <= box.MaxLatitude &&
+		box.MinLongitude >= -180 && box.MinLongitude <= 180 && box.MaxLongitude >= -180 && box.MaxLongitude <= 180
+}
+
+// NewBoundingBoxAround returns the box containing the circle, it is used to narrow the radius search by index
+func NewBoundingBoxAround(latitude float64, longitude float64, radius float64) BoundingBox {
+	latitudeDelta := radius / EarthRadius * 180 / math.Pi
+	box := BoundingBox{
+		MinLatitude:  math.Max(latitude-latitudeDelta, -90),
+		MaxLatitude:  math.Min(latitude+latitudeDelta, 90),
+		MinLongitude: -180,
+		MaxLongitude: 180,
+	}
+	if box.MinLatitude == -90 || box.MaxLatitude == 90 {
+		return box
+	}
+
+	longitudeDelta := latitudeDelta / math.Cos(latitude*math.Pi/180)
+	if longitudeDelta < 180 {
+		box.MinLongitude = math.Mod(longitude-longitudeDelta+540, 360) - 180
+		box.MaxLongitude = math.Mod(longitude+longitudeDelta+540, 360) - 180
+	}
+	return box
+}
@@ -0,0 +1,546 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonB4a3795cDecodeAssetsModulesAssetModel(in *jlexer.Lexer, out *Geometry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "coordinates":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('[')
+				v1 := 0
+				for !in.IsDelim(']') {
+					if v1 < 2 {
+						(out.Coordinates)[v1] = float64(in.Float64())
+						v1++
+					} else {
+						in.SkipRecursive()
+					}
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonB4a3795cEncodeAssetsModulesAssetModel(out *jwriter.Writer, in Geometry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"coordinates\":"
+		out.RawString(prefix)
+		out.RawByte('[')
+		for v2 := range in.Coordinates {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			out.Float64(float64((in.Coordinates)[v2]))
+		}
+		out.RawByte(']')
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Geometry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonB4a3795cEncodeAssetsModulesAssetModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Geometry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonB4a3795cEncodeAssetsModulesAssetModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Geometry) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonB4a3795cDecodeAssetsModulesAssetModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Geometry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonB4a3795cDecodeAssetsModulesAssetModel(l, v)
+}
+func easyjsonB4a3795cDecodeAssetsModulesAssetModel1(in *jlexer.Lexer, out *FeatureProperties) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "address":
+			out.Address = string(in.String())
+		case "cityId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.CityID).UnmarshalText(data))
+			}
+		case "ownerId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.OwnerID).UnmarshalText(data))
+			}
+		case "distance":
+			if in.IsNull() {
+				in.Skip()
+				out.Distance = nil
+			} else {
+				if out.Distance == nil {
+					out.Distance = new(float64)
+				}
+				*out.Distance = float64(in.Float64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonB4a3795cEncodeAssetsModulesAssetModel1(out *jwriter.Writer, in FeatureProperties) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	if in.Type != "" {
+		const prefix string = ",\"type\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Type))
+	}
+	if in.Address != "" {
+		const prefix string = ",\"address\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Address))
+	}
+	if true {
+		const prefix string = ",\"cityId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.CityID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"ownerId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.OwnerID).MarshalText())
+	}
+	if in.Distance != nil {
+		const prefix string = ",\"distance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(*in.Distance))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FeatureProperties) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonB4a3795cEncodeAssetsModulesAssetModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FeatureProperties) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonB4a3795cEncodeAssetsModulesAssetModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FeatureProperties) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonB4a3795cDecodeAssetsModulesAssetModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FeatureProperties) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonB4a3795cDecodeAssetsModulesAssetModel1(l, v)
+}
+func easyjsonB4a3795cDecodeAssetsModulesAssetModel2(in *jlexer.Lexer, out *FeatureCollection) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "features":
+			if in.IsNull() {
+				in.Skip()
+				out.Features = nil
+			} else {
+				in.Delim('[')
+				if out.Features == nil {
+					if !in.IsDelim(']') {
+						out.Features = make([]*Feature, 0, 8)
+					} else {
+						out.Features = []*Feature{}
+					}
+				} else {
+					out.Features = (out.Features)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 *Feature
+					if in.IsNull() {
+						in.Skip()
+						v3 = nil
+					} else {
+						if v3 == nil {
+							v3 = new(Feature)
+						}
+						(*v3).UnmarshalEasyJSON(in)
+					}
+					out.Features = append(out.Features, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonB4a3795cEncodeAssetsModulesAssetModel2(out *jwriter.Writer, in FeatureCollection) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"features\":"
+		out.RawString(prefix)
+		if in.Features == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v4, v5 := range in.Features {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				if v5 == nil {
+					out.RawString("null")
+				} else {
+					(*v5).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FeatureCollection) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonB4a3795cEncodeAssetsModulesAssetModel2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FeatureCollection) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonB4a3795cEncodeAssetsModulesAssetModel2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FeatureCollection) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonB4a3795cDecodeAssetsModulesAssetModel2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FeatureCollection) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonB4a3795cDecodeAssetsModulesAssetModel2(l, v)
+}
+func easyjsonB4a3795cDecodeAssetsModulesAssetModel3(in *jlexer.Lexer, out *Feature) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "geometry":
+			(out.Geometry).UnmarshalEasyJSON(in)
+		case "properties":
+			(out.Properties).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonB4a3795cEncodeAssetsModulesAssetModel3(out *jwriter.Writer, in Feature) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"geometry\":"
+		out.RawString(prefix)
+		(in.Geometry).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"properties\":"
+		out.RawString(prefix)
+		(in.Properties).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Feature) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonB4a3795cEncodeAssetsModulesAssetModel3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Feature) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonB4a3795cEncodeAssetsModulesAssetModel3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Feature) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonB4a3795cDecodeAssetsModulesAssetModel3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Feature) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonB4a3795cDecodeAssetsModulesAssetModel3(l, v)
+}
+func easyjsonB4a3795cDecodeAssetsModulesAssetModel4(in *jlexer.Lexer, out *BoundingBox) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "MinLatitude":
+			out.MinLatitude = float64(in.Float64())
+		case "MinLongitude":
+			out.MinLongitude = float64(in.Float64())
+		case "MaxLatitude":
+			out.MaxLatitude = float64(in.Float64())
+		case "MaxLongitude":
+			out.MaxLongitude = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonB4a3795cEncodeAssetsModulesAssetModel4(out *jwriter.Writer, in BoundingBox) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"MinLatitude\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.MinLatitude))
+	}
+	{
+		const prefix string = ",\"MinLongitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.MinLongitude))
+	}
+	{
+		const prefix string = ",\"MaxLatitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.MaxLatitude))
+	}
+	{
+		const prefix string = ",\"MaxLongitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.MaxLongitude))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BoundingBox) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonB4a3795cEncodeAssetsModulesAssetModel4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BoundingBox) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonB4a3795cEncodeAssetsModulesAssetModel4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BoundingBox) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonB4a3795cDecodeAssetsModulesAssetModel4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BoundingBox) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonB4a3795cDecodeAssetsModulesAssetModel4(l, v)
+}
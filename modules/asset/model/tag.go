@@ -0,0 +1,73 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"strings"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+// Tag groups assets and cash flows of its owner ad hoc, e.g. "Sochi properties" or "2024 renovation"
+type Tag struct {
+	ID      uuid.UUID `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	OwnerID uuid.UUID `json:"ownerId,omitempty" gorm:"type:uuid;uniqueIndex:idx_tag_owner_name"`
+	Name    string    `json:"name,omitempty" gorm:"uniqueIndex:idx_tag_owner_name"`
+	Color   string    `json:"color,omitempty"`
+	commonModel.Versioned
+}
+
+func (tag Tag) GetID() uuid.UUID {
+	return tag.ID
+}
+
+func (tag *Tag) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(tag.ID) {
+		tag.ID = uuid.New()
+	}
+	return nil
+}
+
+func (tag Tag) IsValid() bool {
+	return strings.TrimSpace(tag.Name) != ""
+}
+
+type TagFilter struct {
+	TagIDs []string `form:"tagId"`
+}
+
+// GetTagIds parses the tag ids of the filter, nil means no filtering by tags
+func (filter TagFilter) GetTagIds() []uuid.UUID {
+	if len(filter.TagIDs) == 0 {
+		return nil
+	}
+	return commonUtil.Map(filter.TagIDs, func(it string) uuid.UUID { return uuid.MustParse(it) })
+}
+
+func NewTag(ownerId uuid.UUID, name string) Tag {
+	return Tag{OwnerID: ownerId, Name: name}
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const (
+	CashFlowTimelineEvent    = "CASH_FLOW"
+	ValuationTimelineEvent   = "VALUATION"
+	DocumentTimelineEvent    = "DOCUMENT"
+	MaintenanceTimelineEvent = "MAINTENANCE"
+	FieldEditTimelineEvent   = "FIELD_EDIT"
+)
+
+var TimelineEventTypes = []string{
+	CashFlowTimelineEvent, ValuationTimelineEvent, DocumentTimelineEvent, MaintenanceTimelineEvent, FieldEditTimelineEvent,
+}
+
+// TimelineEvent is an entry of the asset activity feed, Data holds the entity the event comes from
+type TimelineEvent struct {
+	Type     string     `json:"type,omitempty"`
+	Date     time.Time  `json:"date"`
+	EntityID uuid.UUID  `json:"entityId,omitempty"`
+	UserID   *uuid.UUID `json:"userId,omitempty"`
+	Summary  string     `json:"summary,omitempty"`
+	Data     any        `json:"data,omitempty"`
+}
+
+type TimelineFilter struct {
+	Types []string `form:"type"`
+}
+
+func (filter TimelineFilter) Includes(eventType string) bool {
+	return len(filter.Types) == 0 || commonUtil.ArrayContains(filter.Types, eventType)
+}
+
+func NewTimelineEvent(eventType string, date time.Time, entityId uuid.UUID, summary string, data any) TimelineEvent {
+	return TimelineEvent{Type: eventType, Date: date, EntityID: entityId, Summary: summary, Data: data}
+}
+
+// WithUser sets the acting user, zero ids of entities created without authorization are ignored
+func (event TimelineEvent) WithUser(userId uuid.UUID) TimelineEvent {
+	if userId != uuid.Nil {
+		event.UserID = &userId
+	}
+	return event
+}
@@ -0,0 +1,75 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonDB "assets/common/db"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/asset/model"
+	"context"
+	"github.com/google/uuid"
+)
+
+var assetChangeRepo AssetChangeRepository
+
+type AssetChangeRepository interface {
+	commonRepository.Repository[model.AssetChange]
+	FindByAssetId(ctx context.Context, assetId uuid.UUID) []model.AssetChange
+	DeleteByAssetId(ctx context.Context, assetId uuid.UUID)
+}
+
+type assetChangeRepository struct {
+	commonRepository.Repository[model.AssetChange]
+	*commonDB.DataSource
+}
+
+func GetAssetChangeRepository() AssetChangeRepository {
+	if assetChangeRepo != nil {
+		return assetChangeRepo
+	}
+	assetChangeRepo = &assetChangeRepository{
+		commonRepository.NewBaseRepository[model.AssetChange](
+			commonDB.GetDataSource(),
+			commonRepository.WithReadScope[model.AssetChange](AssetIdScope("asset_id", false)),
+			commonRepository.WithWriteScope[model.AssetChange](AssetIdScope("asset_id", true)),
+		),
+		commonDB.GetDataSource(),
+	}
+	return assetChangeRepo
+}
+
+func (repo *assetChangeRepository) FindByAssetId(ctx context.Context, assetId uuid.UUID) []model.AssetChange {
+	var result []model.AssetChange
+	commonUtil.Must(AssetIdScope("asset_id", false)(ctx, repo.DataSource.DB).
+		Where("asset_id = ?", assetId).
+		Order("date").
+		Find(&result).Error)
+	return result
+}
+
+func (repo *assetChangeRepository) DeleteByAssetId(ctx context.Context, assetId uuid.UUID) {
+	commonUtil.Must(repo.DataSource.Where("asset_id = ?", assetId).Delete(&model.AssetChange{}).Error)
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	commonDB "assets/common/db"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/asset/model"
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var assetGrantRepo AssetGrantRepository
+
+type AssetGrantRepository interface {
+	commonRepository.Repository[model.AssetGrant]
+	FindByAssetId(ctx context.Context, assetIds ...uuid.UUID) []model.AssetGrant
+	ReplaceByAssetId(ctx context.Context, assetId uuid.UUID, grants []model.AssetGrant) []model.AssetGrant
+}
+
+type assetGrantRepository struct {
+	commonRepository.Repository[model.AssetGrant]
+	*commonDB.DataSource
+}
+
+func GetAssetGrantRepository() AssetGrantRepository {
+	if assetGrantRepo != nil {
+		return assetGrantRepo
+	}
+	assetGrantRepo = &assetGrantRepository{
+		commonRepository.NewBaseRepository[model.AssetGrant](commonDB.GetDataSource()),
+		commonDB.GetDataSource(),
+	}
+	return assetGrantRepo
+}
+
+func (repo *assetGrantRepository) FindByAssetId(ctx context.Context, assetIds ...uuid.UUID) []model.AssetGrant {
+	var result []model.AssetGrant
+	if len(assetIds) == 0 {
+		return result
+	}
+
+	commonUtil.Must(repo.DataSource.Where("asset_id in ?", assetIds).Find(&result).Error)
+	return result
+}
+
+func (repo *assetGrantRepository) ReplaceByAssetId(ctx context.Context, assetId uuid.UUID, grants []model.AssetGrant) []model.AssetGrant {
+	commonUtil.Must(repo.DataSource.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("asset_id = ?", assetId).Delete(&model.AssetGrant{}).Error; err != nil {
+			return err
+		}
+		if len(grants) == 0 {
+			return nil
+		}
+		for i := range grants {
+			grants[i].ID = uuid.Nil
+			grants[i].AssetID = assetId
+		}
+		return tx.Create(&grants).Error
+	}))
+	return grants
+}
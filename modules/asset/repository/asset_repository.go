@@ -26,18 +26,35 @@ package repository
 
 import (
 	commonDB "assets/common/db"
+	commonModel "assets/common/model"
 	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
 	"assets/modules/asset/model"
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
 )
 
 var assetRepo AssetRepository
 
 type AssetRepository interface {
-	commonRepository.Repository[model.Asset]
+	commonRepository.SoftDeleteRepository[model.Asset]
+	FindAllWithPage(ctx context.Context, filter model.TagFilter, page commonModel.Pageable) commonModel.Page[model.Asset]
+	FindSharedWith(ctx context.Context, userId uuid.UUID, date time.Time, page commonModel.Pageable) commonModel.Page[model.Asset]
+	FindByOwnerOrShareholder(ctx context.Context, userId uuid.UUID) []model.Asset
+	FindByCashFlowId(ctx context.Context, cashFlowId uuid.UUID) []model.Asset
+	AddIncomes(ctx context.Context, id uuid.UUID, cashFlows []model.CashFlow)
+	AddExpenses(ctx context.Context, id uuid.UUID, cashFlows []model.CashFlow)
+	RemoveIncomes(ctx context.Context, id uuid.UUID, cashFlowIds []uuid.UUID)
+	IsWritable(ctx context.Context, id uuid.UUID) bool
+	FindInBoundingBox(ctx context.Context, box model.BoundingBox) []model.Asset
+	FindInRadius(ctx context.Context, latitude float64, longitude float64, radius float64) []model.Asset
 }
 
 type assetRepository struct {
-	commonRepository.Repository[model.Asset]
+	commonRepository.SoftDeleteRepository[model.Asset]
 	*commonDB.DataSource
 }
 
@@ -45,9 +62,126 @@ func GetAssetRepository() AssetRepository {
 	if assetRepo != nil {
 		return assetRepo
 	}
-	assetRepo = &assetRepository{
-		commonRepository.NewBaseRepository[model.Asset](commonDB.GetDataSource()),
-		commonDB.GetDataSource(),
-	}
+	assetRepo = newAssetRepository(commonDB.GetDataSource())
 	return assetRepo
 }
+
+func newAssetRepository(dataSource *commonDB.DataSource) *assetRepository {
+	return &assetRepository{
+		commonRepository.NewSoftDeleteRepository[model.Asset](
+			dataSource,
+			commonRepository.WithReadScope[model.Asset](AssetIdScope("id", false)),
+			commonRepository.WithWriteScope[model.Asset](AssetIdScope("id", true)),
+			// shares, cash flows and tags are changed by the dedicated queries checking the rights to them
+			commonRepository.WithReadOnlyColumns[model.Asset]("Shares", "Incomes", "Expenses", "Tags"),
+		),
+		dataSource,
+	}
+}
+
+func (repo *assetRepository) FindAllWithPage(ctx context.Context, filter model.TagFilter, page commonModel.Pageable) commonModel.Page[model.Asset] {
+	query := repo.available(ctx)
+	if tagIds := filter.GetTagIds(); len(tagIds) != 0 {
+		query = query.Where("id in (select asset_id from asset_tag where tag_id in ?)", tagIds)
+	}
+
+	var total int64
+	commonUtil.Must(query.Count(&total).Error)
+
+	var result []model.Asset
+	query = query.Preload(clause.Associations).Limit(page.Size).Offset(page.Page * page.Size)
+	query = commonRepository.OrderBy[model.Asset](query, page.Sort, "")
+	commonUtil.Must(query.Find(&result).Error)
+	return commonModel.NewPage[model.Asset](result, page).WithTotal(int(total))
+}
+
+func (repo *assetRepository) FindSharedWith(ctx context.Context, userId uuid.UUID, date time.Time, page commonModel.Pageable) commonModel.Page[model.Asset] {
+	query := repo.DataSource.Model(&model.Asset{}).
+		Where("owner_id <> ?", userId.String()).
+		Where(
+			"id in (select asset_id from asset_shares where user_id = ? and (valid_from is null or valid_from <= ?) and (valid_to is null or valid_to > ?))",
+			userId, date, date,
+		)
+
+	var total int64
+	commonUtil.Must(query.Count(&total).Error)
+
+	var result []model.Asset
+	query = query.Preload(clause.Associations).Limit(page.Size).Offset(page.Page * page.Size)
+	query = commonRepository.OrderBy[model.Asset](query, page.Sort, "")
+	commonUtil.Must(query.Find(&result).Error)
+	return commonModel.NewPage[model.Asset](result, page).WithTotal(int(total))
+}
+
+func (repo *assetRepository) FindByOwnerOrShareholder(ctx context.Context, userId uuid.UUID) []model.Asset {
+	var result []model.Asset
+	commonUtil.Must(repo.DataSource.Preload(clause.Associations).
+		Where("owner_id = ? or id in (select asset_id from asset_shares where user_id = ?)", userId.String(), userId).
+		Find(&result).Error)
+	return result
+}
+
+// FindByCashFlowId returns available assets having the cash flow among their incomes or expenses
+func (repo *assetRepository) FindByCashFlowId(ctx context.Context, cashFlowId uuid.UUID) []model.Asset {
+	var result []model.Asset
+	commonUtil.Must(repo.available(ctx).
+		Where(
+			"(id in (select asset_id from asset_income_cash_flow where cash_flow_id = ?) or id in (select asset_id from asset_expense_cash_flow where cash_flow_id = ?))",
+			cashFlowId, cashFlowId,
+		).
+		Find(&result).Error)
+	return result
+}
+
+func (repo *assetRepository) AddIncomes(ctx context.Context, id uuid.UUID, cashFlows []model.CashFlow) {
+	commonUtil.Must(repo.DataSource.For(ctx).Model(&model.Asset{ID: id}).Association("Incomes").Append(&cashFlows))
+}
+
+func (repo *assetRepository) AddExpenses(ctx context.Context, id uuid.UUID, cashFlows []model.CashFlow) {
+	commonUtil.Must(repo.DataSource.For(ctx).Model(&model.Asset{ID: id}).Association("Expenses").Append(&cashFlows))
+}
+
+func (repo *assetRepository) RemoveIncomes(ctx context.Context, id uuid.UUID, cashFlowIds []uuid.UUID) {
+	cashFlows := commonUtil.Map(cashFlowIds, func(it uuid.UUID) model.CashFlow { return model.CashFlow{ID: it} })
+	commonUtil.Must(repo.DataSource.For(ctx).Model(&model.Asset{ID: id}).Association("Incomes").Delete(&cashFlows))
+}
+
+func (repo *assetRepository) IsWritable(ctx context.Context, id uuid.UUID) bool {
+	var count int64
+	commonUtil.Must(AssetIdScope("id", true)(ctx, repo.DataSource.Model(&model.Asset{})).Where("id = ?", id).Count(&count).Error)
+	return count != 0
+}
+
+func (repo *assetRepository) FindInBoundingBox(ctx context.Context, box model.BoundingBox) []model.Asset {
+	var result []model.Asset
+	commonUtil.Must(boundingBoxScope(box)(ctx, repo.available(ctx)).Find(&result).Error)
+	return result
+}
+
+// FindInRadius returns assets within the radius in meters ordered by the distance to the point
+func (repo *assetRepository) FindInRadius(ctx context.Context, latitude float64, longitude float64, radius float64) []model.Asset {
+	distance := "2 * ? * asin(sqrt(power(sin(radians(latitude - ?) / 2), 2) + " +
+		"cos(radians(?)) * cos(radians(latitude)) * power(sin(radians(longitude - ?) / 2), 2)))"
+
+	var result []model.Asset
+	commonUtil.Must(boundingBoxScope(model.NewBoundingBoxAround(latitude, longitude, radius))(ctx, repo.available(ctx)).
+		Where(distance+" <= ?", model.EarthRadius, latitude, latitude, longitude, radius).
+		Clauses(clause.OrderBy{Expression: clause.Expr{SQL: distance, Vars: []any{model.EarthRadius, latitude, latitude, longitude}}}).
+		Find(&result).Error)
+	return result
+}
+
+// available returns the query of the assets available to the current user, the assets in the trash are left out
+func (repo *assetRepository) available(ctx context.Context) *gorm.DB {
+	return AssetIdScope("id", false)(ctx, repo.DataSource.For(ctx).Model(&model.Asset{}))
+}
+
+func boundingBoxScope(box model.BoundingBox) commonRepository.Scope {
+	return func(ctx context.Context, db *gorm.DB) *gorm.DB {
+		db = db.Where("latitude between ? and ?", box.MinLatitude, box.MaxLatitude)
+		if box.MinLongitude <= box.MaxLongitude {
+			return db.Where("longitude between ? and ?", box.MinLongitude, box.MaxLongitude)
+		}
+		return db.Where("(longitude >= ? or longitude <= ?)", box.MinLongitude, box.MaxLongitude)
+	}
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/asset/model"
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var unrestrictedAuthorities = []string{"OWNER", "MANAGE_ALL_ASSETS"}
+
+// GetRestrictedUserId returns the current user id if the user may access only own assets
+// and assets shared with the user. Requests without authorization (background jobs) are not restricted.
+func GetRestrictedUserId(ctx context.Context) (uuid.UUID, bool) {
+	tokenInfo, ok := commonUtil.GetCurrentTokenInfo(ctx)
+	if !ok {
+		return uuid.Nil, false
+	}
+	for _, authority := range unrestrictedAuthorities {
+		if commonUtil.ArrayContains(tokenInfo.Authorities, authority) {
+			return uuid.Nil, false
+		}
+	}
+	return tokenInfo.UserId, true
+}
+
+// readableAssetIds includes the assets in the trash, so they may be restored and their records keep the access
+func readableAssetIds(db *gorm.DB, userId uuid.UUID) *gorm.DB {
+	now := time.Now()
+	return db.Session(&gorm.Session{NewDB: true}).Unscoped().Model(&model.Asset{}).Select("id").Where(
+		"owner_id = ? or id in (?) or id in (?)",
+		userId.String(),
+		db.Session(&gorm.Session{NewDB: true}).Model(&model.AssetShare{}).Select("asset_id").
+			Where("user_id = ? and (valid_from is null or valid_from <= ?) and (valid_to is null or valid_to > ?)", userId, now, now),
+		db.Session(&gorm.Session{NewDB: true}).Model(&model.AssetGrant{}).Select("asset_id").
+			Where("user_id = ?", userId),
+	)
+}
+
+func writableAssetIds(db *gorm.DB, userId uuid.UUID) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true}).Unscoped().Model(&model.Asset{}).Select("id").Where(
+		"owner_id = ? or id in (?)",
+		userId.String(),
+		db.Session(&gorm.Session{NewDB: true}).Model(&model.AssetGrant{}).Select("asset_id").
+			Where("user_id = ? and permission = ?", userId, model.WritePermission),
+	)
+}
+
+// AssetIdScope restricts rows by the column referencing an asset available to the current user
+func AssetIdScope(column string, write bool) commonRepository.Scope {
+	return func(ctx context.Context, db *gorm.DB) *gorm.DB {
+		userId, restricted := GetRestrictedUserId(ctx)
+		if !restricted {
+			return db
+		}
+		if write {
+			return db.Where(column+" in (?)", writableAssetIds(db, userId))
+		}
+		return db.Where(column+" in (?)", readableAssetIds(db, userId))
+	}
+}
+
+func cashFlowScope(write bool) commonRepository.Scope {
+	return func(ctx context.Context, db *gorm.DB) *gorm.DB {
+		userId, restricted := GetRestrictedUserId(ctx)
+		if !restricted {
+			return db
+		}
+
+		assetIds := readableAssetIds(db, userId)
+		if write {
+			assetIds = writableAssetIds(db, userId)
+		}
+		return db.Where(
+			"created_by = ? or id in (select cash_flow_id from asset_income_cash_flow where asset_id in (?)) or id in (select cash_flow_id from asset_expense_cash_flow where asset_id in (?))",
+			userId, assetIds, assetIds,
+		)
+	}
+}
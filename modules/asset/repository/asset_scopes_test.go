@@ -0,0 +1,191 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonError "assets/common/custom_error"
+	"assets/common/db/dbtest"
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"assets/modules/asset/model"
+	"context"
+	"database/sql/driver"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// assetTable answers the queries of the asset repository like the assets and their grants would be stored,
+// the rows of the restricted queries are limited to the assets the user of the query owns or is granted
+type assetTable struct {
+	owners map[uuid.UUID]uuid.UUID
+	grants map[uuid.UUID]map[uuid.UUID]string
+}
+
+func (table assetTable) respond(query string, args []any) dbtest.Result {
+	restricted := strings.Contains(query, `"asset_grants"`)
+	write := strings.Contains(query, "permission = ")
+	var userId uuid.UUID
+	for _, arg := range args {
+		if id, ok := arg.(uuid.UUID); ok {
+			userId = id
+		}
+	}
+
+	var ids []uuid.UUID
+	for id, owner := range table.owners {
+		permission := table.grants[id][userId]
+		if !restricted || owner == userId || permission == model.WritePermission || !write && permission != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(query, `SELECT count(*) FROM "assets"`):
+		var count int64
+		for _, id := range ids {
+			if containsArg(args, id.String()) {
+				count++
+			}
+		}
+		return dbtest.Result{Columns: []string{"count"}, Rows: [][]driver.Value{{count}}}
+	case strings.HasPrefix(query, `SELECT * FROM "assets" `):
+		result := dbtest.Result{Columns: []string{"id", "owner_id"}}
+		for _, id := range ids {
+			result.Rows = append(result.Rows, []driver.Value{id.String(), table.owners[id].String()})
+		}
+		return result
+	case strings.HasPrefix(query, "SELECT"):
+		return dbtest.Result{}
+	}
+	return dbtest.Result{RowsAffected: 1}
+}
+
+func containsArg(args []any, value string) bool {
+	for _, arg := range args {
+		if arg == value {
+			return true
+		}
+	}
+	return false
+}
+
+func TestAssetWriteAccess(t *testing.T) {
+	owner, reader, writer, manager := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	assetId := uuid.New()
+	table := assetTable{
+		owners: map[uuid.UUID]uuid.UUID{assetId: owner},
+		grants: map[uuid.UUID]map[uuid.UUID]string{assetId: {reader: model.ReadPermission, writer: model.WritePermission}},
+	}
+
+	tests := []struct {
+		name        string
+		tokenInfo   commonModel.TokenInfo
+		wantAllowed bool
+	}{
+		{"owner", commonModel.TokenInfo{UserId: owner}, true},
+		{"write grant", commonModel.TokenInfo{UserId: writer}, true},
+		{"read grant", commonModel.TokenInfo{UserId: reader}, false},
+		{"stranger", commonModel.TokenInfo{UserId: uuid.New()}, false},
+		{"manager", commonModel.TokenInfo{UserId: manager, Authorities: []string{"MANAGE_ALL_ASSETS"}}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ds, database := dbtest.NewDataSource(t, table.respond)
+			repo := newAssetRepository(ds)
+			ctx := newUserContext(test.tokenInfo)
+
+			operations := map[string]func(){
+				"update": func() { repo.Update(ctx, []model.Asset{{ID: assetId, OwnerID: owner}}) },
+				"delete": func() { repo.DeleteById(ctx, assetId) },
+			}
+			for operation, run := range operations {
+				database.Reset()
+				err := catchPanic(run)
+				changes := database.Find(`UPDATE "assets"`)
+				if test.wantAllowed && (err != nil || len(changes) == 0) {
+					t.Errorf("%s failed: %v, statements %v", operation, err, database.Statements())
+				}
+				if !test.wantAllowed && (err != commonError.NotEnoughRightsError || len(changes) != 0) {
+					t.Errorf("%s = %v, want NotEnoughRightsError without changes, statements %v", operation, err, changes)
+				}
+			}
+		})
+	}
+}
+
+func TestAssetReadScope(t *testing.T) {
+	owner, grantee := uuid.New(), uuid.New()
+	ownAsset, grantedAsset, otherAsset := uuid.New(), uuid.New(), uuid.New()
+	table := assetTable{
+		owners: map[uuid.UUID]uuid.UUID{ownAsset: grantee, grantedAsset: owner, otherAsset: owner},
+		grants: map[uuid.UUID]map[uuid.UUID]string{grantedAsset: {grantee: model.ReadPermission}},
+	}
+	ds, _ := dbtest.NewDataSource(t, table.respond)
+	repo := newAssetRepository(ds)
+
+	tests := []struct {
+		name      string
+		tokenInfo commonModel.TokenInfo
+		want      []uuid.UUID
+	}{
+		{"grantee", commonModel.TokenInfo{UserId: grantee}, []uuid.UUID{ownAsset, grantedAsset}},
+		{"owner", commonModel.TokenInfo{UserId: owner}, []uuid.UUID{grantedAsset, otherAsset}},
+		{"stranger", commonModel.TokenInfo{UserId: uuid.New()}, nil},
+		{"owner of the organization", commonModel.TokenInfo{UserId: uuid.New(), Authorities: []string{"OWNER"}}, []uuid.UUID{ownAsset, grantedAsset, otherAsset}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := make(map[uuid.UUID]bool)
+			for _, asset := range repo.GetAll(newUserContext(test.tokenInfo)) {
+				got[asset.ID] = true
+			}
+			if len(got) != len(test.want) {
+				t.Fatalf("GetAll() = %v, want %v", got, test.want)
+			}
+			for _, id := range test.want {
+				if !got[id] {
+					t.Errorf("GetAll() = %v, want %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func newUserContext(tokenInfo commonModel.TokenInfo) context.Context {
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	commonUtil.SetCurrentTokenInfo(ctx, tokenInfo)
+	return ctx
+}
+
+func catchPanic(run func()) (err any) {
+	defer func() {
+		err = recover()
+	}()
+	run()
+	return nil
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	commonDB "assets/common/db"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/asset/model"
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var assetShareRepo AssetShareRepository
+
+type AssetShareRepository interface {
+	commonRepository.Repository[model.AssetShare]
+	FindByAssetId(ctx context.Context, assetIds ...uuid.UUID) []model.AssetShare
+	FindByUserId(ctx context.Context, userId uuid.UUID) []model.AssetShare
+	ReplaceByAssetId(ctx context.Context, assetId uuid.UUID, shares []model.AssetShare) []model.AssetShare
+}
+
+type assetShareRepository struct {
+	commonRepository.Repository[model.AssetShare]
+	*commonDB.DataSource
+}
+
+func GetAssetShareRepository() AssetShareRepository {
+	if assetShareRepo != nil {
+		return assetShareRepo
+	}
+	assetShareRepo = &assetShareRepository{
+		commonRepository.NewBaseRepository[model.AssetShare](commonDB.GetDataSource()),
+		commonDB.GetDataSource(),
+	}
+	return assetShareRepo
+}
+
+func (repo *assetShareRepository) FindByAssetId(ctx context.Context, assetIds ...uuid.UUID) []model.AssetShare {
+	var result []model.AssetShare
+	if len(assetIds) == 0 {
+		return result
+	}
+
+	commonUtil.Must(repo.DataSource.Where("asset_id in ?", assetIds).Order("valid_from").Find(&result).Error)
+	return result
+}
+
+func (repo *assetShareRepository) FindByUserId(ctx context.Context, userId uuid.UUID) []model.AssetShare {
+	var result []model.AssetShare
+	commonUtil.Must(repo.DataSource.Where("user_id = ?", userId).Find(&result).Error)
+	return result
+}
+
+func (repo *assetShareRepository) ReplaceByAssetId(ctx context.Context, assetId uuid.UUID, shares []model.AssetShare) []model.AssetShare {
+	commonUtil.Must(repo.DataSource.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("asset_id = ?", assetId).Delete(&model.AssetShare{}).Error; err != nil {
+			return err
+		}
+		if len(shares) == 0 {
+			return nil
+		}
+		for i := range shares {
+			shares[i].ID = uuid.Nil
+			shares[i].AssetID = assetId
+		}
+		return tx.Create(&shares).Error
+	}))
+	return shares
+}
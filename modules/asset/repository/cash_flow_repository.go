@@ -26,18 +26,30 @@ package repository
 
 import (
 	commonDB "assets/common/db"
+	commonModel "assets/common/model"
 	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
 	"assets/modules/asset/model"
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var cashFlowRepo CashFlowRepository
 
 type CashFlowRepository interface {
-	commonRepository.Repository[model.CashFlow]
+	commonRepository.SoftDeleteRepository[model.CashFlow]
+	FindAllWithPage(ctx context.Context, filter model.TagFilter, page commonModel.Pageable) commonModel.Page[model.CashFlow]
+	IsWritable(ctx context.Context, id uuid.UUID) bool
+	FindWritableIds(ctx context.Context, ids []uuid.UUID) []uuid.UUID
+	CreateInBatches(ctx context.Context, cashFlows []model.CashFlow, batchSize int, partial bool) map[int]error
+	UpdateInBatches(ctx context.Context, cashFlows []model.CashFlow, batchSize int, partial bool) map[int]error
+	DeleteInBatches(ctx context.Context, ids []uuid.UUID, batchSize int, partial bool) map[int]error
 }
 
 type cashFlowRepository struct {
-	commonRepository.Repository[model.CashFlow]
+	commonRepository.SoftDeleteRepository[model.CashFlow]
 	*commonDB.DataSource
 }
 
@@ -46,8 +58,67 @@ func GetCashFlowRepository() CashFlowRepository {
 		return cashFlowRepo
 	}
 	cashFlowRepo = &cashFlowRepository{
-		commonRepository.NewBaseRepository[model.CashFlow](commonDB.GetDataSource()),
+		commonRepository.NewSoftDeleteRepository[model.CashFlow](
+			commonDB.GetDataSource(),
+			commonRepository.WithReadScope[model.CashFlow](cashFlowScope(false)),
+			commonRepository.WithWriteScope[model.CashFlow](cashFlowScope(true)),
+		),
 		commonDB.GetDataSource(),
 	}
 	return cashFlowRepo
 }
+
+func (repo *cashFlowRepository) FindAllWithPage(ctx context.Context, filter model.TagFilter, page commonModel.Pageable) commonModel.Page[model.CashFlow] {
+	query := cashFlowScope(false)(ctx, repo.DataSource.Model(&model.CashFlow{}))
+	if tagIds := filter.GetTagIds(); len(tagIds) != 0 {
+		query = query.Where("id in (select cash_flow_id from cash_flow_tag where tag_id in ?)", tagIds)
+	}
+
+	var total int64
+	commonUtil.Must(query.Count(&total).Error)
+
+	var result []model.CashFlow
+	query = query.Preload(clause.Associations).Limit(page.Size).Offset(page.Page * page.Size)
+	query = commonRepository.OrderBy[model.CashFlow](query, page.Sort, "")
+	commonUtil.Must(query.Find(&result).Error)
+	return commonModel.NewPage[model.CashFlow](result, page).WithTotal(int(total))
+}
+
+func (repo *cashFlowRepository) IsWritable(ctx context.Context, id uuid.UUID) bool {
+	var count int64
+	commonUtil.Must(cashFlowScope(true)(ctx, repo.DataSource.Model(&model.CashFlow{})).Where("id = ?", id).Count(&count).Error)
+	return count != 0
+}
+
+func (repo *cashFlowRepository) FindWritableIds(ctx context.Context, ids []uuid.UUID) []uuid.UUID {
+	var result []uuid.UUID
+	if len(ids) == 0 {
+		return result
+	}
+	commonUtil.Must(cashFlowScope(true)(ctx, repo.DataSource.Model(&model.CashFlow{})).Where("id in ?", ids).Pluck("id", &result).Error)
+	return result
+}
+
+// CreateInBatches creates the cash flows within one transaction and returns the failures by the cash flow index,
+// see commonRepository.InBatches
+func (repo *cashFlowRepository) CreateInBatches(ctx context.Context, cashFlows []model.CashFlow, batchSize int, partial bool) map[int]error {
+	return commonRepository.InBatches(repo.DataSource, cashFlows, batchSize, partial, func(tx *gorm.DB, batch []model.CashFlow) error {
+		return tx.Omit(clause.Associations).Create(&batch).Error
+	})
+}
+
+func (repo *cashFlowRepository) UpdateInBatches(ctx context.Context, cashFlows []model.CashFlow, batchSize int, partial bool) map[int]error {
+	return commonRepository.InBatches(repo.DataSource, cashFlows, batchSize, partial, func(tx *gorm.DB, batch []model.CashFlow) error {
+		if err := commonRepository.LockVersions(tx, batch); err != nil {
+			return err
+		}
+		return tx.Omit(clause.Associations).Save(&batch).Error
+	})
+}
+
+// DeleteInBatches moves the cash flows to the trash, the write access must be checked beforehand
+func (repo *cashFlowRepository) DeleteInBatches(ctx context.Context, ids []uuid.UUID, batchSize int, partial bool) map[int]error {
+	return commonRepository.InBatches(repo.DataSource, ids, batchSize, partial, func(tx *gorm.DB, batch []uuid.UUID) error {
+		return commonRepository.SoftDelete(ctx, tx.Model(&model.CashFlow{}).Where("id in ?", batch))
+	})
+}
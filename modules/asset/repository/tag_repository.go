@@ -0,0 +1,155 @@
+package repository
+
+import (
+	commonDB "assets/common/db"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/asset/model"
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var tagRepo TagRepository
+
+type TagRepository interface {
+	commonRepository.Repository[model.Tag]
+	FindByOwnerId(ctx context.Context, ownerId uuid.UUID) []model.Tag
+	FindByName(ctx context.Context, ownerId uuid.UUID, name string) []model.Tag
+	FindAssetIds(ctx context.Context, tagIds []uuid.UUID) []uuid.UUID
+	FindCashFlowIds(ctx context.Context, tagIds []uuid.UUID) []uuid.UUID
+	ReplaceAssetTags(ctx context.Context, assetId uuid.UUID, ownerId uuid.UUID, tagIds []uuid.UUID)
+	ReplaceCashFlowTags(ctx context.Context, cashFlowId uuid.UUID, ownerId uuid.UUID, tagIds []uuid.UUID)
+	DeleteAssetLinks(ctx context.Context, assetId uuid.UUID)
+	DeleteCashFlowLinks(ctx context.Context, cashFlowId uuid.UUID)
+	DeleteWithLinks(ctx context.Context, id uuid.UUID)
+}
+
+type tagRepository struct {
+	commonRepository.Repository[model.Tag]
+	*commonDB.DataSource
+}
+
+func GetTagRepository() TagRepository {
+	if tagRepo != nil {
+		return tagRepo
+	}
+	tagRepo = &tagRepository{
+		commonRepository.NewBaseRepository[model.Tag](
+			commonDB.GetDataSource(),
+			commonRepository.WithReadScope[model.Tag](tagScope),
+			commonRepository.WithWriteScope[model.Tag](tagScope),
+		),
+		commonDB.GetDataSource(),
+	}
+	return tagRepo
+}
+
+func (repo *tagRepository) FindByOwnerId(ctx context.Context, ownerId uuid.UUID) []model.Tag {
+	var result []model.Tag
+	commonUtil.Must(repo.DataSource.Where("owner_id = ?", ownerId).Order("name").Find(&result).Error)
+	return result
+}
+
+func (repo *tagRepository) FindByName(ctx context.Context, ownerId uuid.UUID, name string) []model.Tag {
+	var result []model.Tag
+	commonUtil.Must(repo.DataSource.Where("owner_id = ? and lower(name) = lower(?)", ownerId, name).Find(&result).Error)
+	return result
+}
+
+// FindAssetIds returns ids of the assets having any of the tags
+func (repo *tagRepository) FindAssetIds(ctx context.Context, tagIds []uuid.UUID) []uuid.UUID {
+	var result []uuid.UUID
+	if len(tagIds) == 0 {
+		return result
+	}
+	commonUtil.Must(repo.DataSource.Table("asset_tag").Distinct("asset_id").Where("tag_id in ?", tagIds).Pluck("asset_id", &result).Error)
+	return result
+}
+
+// FindCashFlowIds returns ids of the cash flows having any of the tags
+func (repo *tagRepository) FindCashFlowIds(ctx context.Context, tagIds []uuid.UUID) []uuid.UUID {
+	var result []uuid.UUID
+	if len(tagIds) == 0 {
+		return result
+	}
+	commonUtil.Must(repo.DataSource.Table("cash_flow_tag").Distinct("cash_flow_id").Where("tag_id in ?", tagIds).Pluck("cash_flow_id", &result).Error)
+	return result
+}
+
+// ReplaceAssetTags replaces the owner's tags of the asset, tags of other users are kept
+func (repo *tagRepository) ReplaceAssetTags(ctx context.Context, assetId uuid.UUID, ownerId uuid.UUID, tagIds []uuid.UUID) {
+	commonUtil.Must(repo.DataSource.Transaction(func(tx *gorm.DB) error {
+		return replaceLinks(tx, "asset_tag", "asset_id", assetId, ownerId, tagIds)
+	}))
+}
+
+// ReplaceCashFlowTags replaces the owner's tags of the cash flow, tags of other users are kept
+func (repo *tagRepository) ReplaceCashFlowTags(ctx context.Context, cashFlowId uuid.UUID, ownerId uuid.UUID, tagIds []uuid.UUID) {
+	commonUtil.Must(repo.DataSource.Transaction(func(tx *gorm.DB) error {
+		return replaceLinks(tx, "cash_flow_tag", "cash_flow_id", cashFlowId, ownerId, tagIds)
+	}))
+}
+
+func (repo *tagRepository) DeleteAssetLinks(ctx context.Context, assetId uuid.UUID) {
+	commonUtil.Must(repo.DataSource.Exec("delete from asset_tag where asset_id = ?", assetId).Error)
+}
+
+func (repo *tagRepository) DeleteCashFlowLinks(ctx context.Context, cashFlowId uuid.UUID) {
+	commonUtil.Must(repo.DataSource.Exec("delete from cash_flow_tag where cash_flow_id = ?", cashFlowId).Error)
+}
+
+// DeleteWithLinks deletes the tag and detaches it from all the assets and cash flows
+func (repo *tagRepository) DeleteWithLinks(ctx context.Context, id uuid.UUID) {
+	commonUtil.Must(repo.DataSource.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("delete from asset_tag where tag_id = ?", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("delete from cash_flow_tag where tag_id = ?", id).Error; err != nil {
+			return err
+		}
+		query, locked := commonRepository.LockDeleteVersion(ctx, tx.Where("id = ?", id), []uuid.UUID{id})
+		return commonRepository.CheckDeleted(query.Delete(&model.Tag{}), locked)
+	}))
+}
+
+func replaceLinks(tx *gorm.DB, table string, column string, id uuid.UUID, ownerId uuid.UUID, tagIds []uuid.UUID) error {
+	err := tx.Exec("delete from "+table+" where "+column+" = ? and tag_id in (select id from tags where owner_id = ?)", id, ownerId).Error
+	if err != nil || len(tagIds) == 0 {
+		return err
+	}
+	return tx.Exec("insert into "+table+" ("+column+", tag_id) select ?, id from tags where owner_id = ? and id in ?", id, ownerId, tagIds).Error
+}
+
+// tagScope restricts tags to the ones of the current user, tags are private even for unrestricted users
+func tagScope(ctx context.Context, db *gorm.DB) *gorm.DB {
+	tokenInfo, ok := commonUtil.GetCurrentTokenInfo(ctx)
+	if !ok {
+		return db
+	}
+	return db.Where("owner_id = ?", tokenInfo.UserId)
+}
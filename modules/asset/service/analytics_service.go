@@ -0,0 +1,335 @@
+package service
+
+import (
+	commonUtil "assets/common/util"
+	"assets/modules/asset/model"
+	"assets/modules/asset/repository"
+	countryModel "assets/modules/country/model"
+	countryRepository "assets/modules/country/repository"
+	"context"
+	"github.com/google/uuid"
+	"sort"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var analyticsSrv AnalyticsService
+
+type AnalyticsService interface {
+	GetAssetSummary(ctx context.Context, id uuid.UUID, from *time.Time, to *time.Time, baseDate *time.Time) model.AssetSummary
+	GetPortfolioSummary(ctx context.Context, from *time.Time, to *time.Time, baseDate *time.Time, tagIds []uuid.UUID) model.PortfolioSummary
+	GetTagSummaries(ctx context.Context, from *time.Time, to *time.Time, baseDate *time.Time) []*model.TagSummary
+	RegisterValuationSource(source ValuationSource)
+}
+
+// ValuationSource values assets whose worth is kept outside the asset module, e.g. securities holdings
+type ValuationSource interface {
+	GetMarketValues(ctx context.Context, assetIds []uuid.UUID, date time.Time) map[uuid.UUID][]*model.MarketValue
+}
+
+type analyticsService struct {
+	assetService       AssetService
+	assetRepository    repository.AssetRepository
+	tagRepository      repository.TagRepository
+	cityRepository     countryRepository.CityRepository
+	cpiIndexRepository countryRepository.CpiIndexRepository
+	valuationSources   []ValuationSource
+}
+
+func GetAnalyticsService() AnalyticsService {
+	if analyticsSrv != nil {
+		return analyticsSrv
+	}
+
+	analyticsSrv = &analyticsService{
+		assetService:       GetAssetService(),
+		assetRepository:    repository.GetAssetRepository(),
+		tagRepository:      repository.GetTagRepository(),
+		cityRepository:     countryRepository.GetCityRepository(),
+		cpiIndexRepository: countryRepository.GetCpiIndexRepository(),
+	}
+
+	return analyticsSrv
+}
+
+func (service *analyticsService) GetAssetSummary(ctx context.Context, id uuid.UUID, from *time.Time, to *time.Time, baseDate *time.Time) model.AssetSummary {
+	baseDate = getBaseDate(baseDate)
+	asset := service.assetService.GetById(ctx, id)
+	summary := summarizeAsset(asset, from, to, *baseDate, service.getCpiSeries(ctx, asset.CityID, nil))
+	summary.MarketValues = service.getMarketValues(ctx, []uuid.UUID{asset.ID}, getValuationDate(to))[asset.ID]
+	return summary
+}
+
+// GetPortfolioSummary sums the user's shares of the assets. Real totals of a currency are returned
+// only when every asset with cash flows in the currency has the cpi series of its country.
+// With tags only the tagged assets and the tagged cash flows of the other assets are summed
+func (service *analyticsService) GetPortfolioSummary(ctx context.Context, from *time.Time, to *time.Time, baseDate *time.Time, tagIds []uuid.UUID) model.PortfolioSummary {
+	baseDate = getBaseDate(baseDate)
+	userId := commonUtil.MustGetCurrentTokenInfo(ctx).UserId
+	assets, valued := service.filterByTags(ctx, service.assetRepository.FindByOwnerOrShareholder(ctx, userId), tagIds)
+
+	portfolio := service.summarizePortfolio(ctx, userId, assets, valued, from, to, baseDate, make(map[uuid.UUID]countryModel.CpiSeries))
+	portfolio.TagIDs = tagIds
+	return portfolio
+}
+
+// GetTagSummaries sums the user's portfolio by every tag of the user, the same asset or cash flow
+// is counted in each of its tags
+func (service *analyticsService) GetTagSummaries(ctx context.Context, from *time.Time, to *time.Time, baseDate *time.Time) []*model.TagSummary {
+	baseDate = getBaseDate(baseDate)
+	userId := commonUtil.MustGetCurrentTokenInfo(ctx).UserId
+	assets := service.assetRepository.FindByOwnerOrShareholder(ctx, userId)
+	series := make(map[uuid.UUID]countryModel.CpiSeries)
+
+	var result []*model.TagSummary
+	for _, tag := range service.tagRepository.FindByOwnerId(ctx, userId) {
+		tagged, valued := service.filterByTags(ctx, assets, []uuid.UUID{tag.ID})
+		portfolio := service.summarizePortfolio(ctx, userId, tagged, valued, from, to, baseDate, series)
+		result = append(result, &model.TagSummary{TagID: tag.ID, Name: tag.Name, Totals: portfolio.Totals, MarketValues: portfolio.MarketValues})
+	}
+	return result
+}
+
+// summarizePortfolio sums the user's shares of the assets, market values are counted
+// for the valued assets only, nil valued means all of them
+func (service *analyticsService) summarizePortfolio(ctx context.Context, userId uuid.UUID, assets []model.Asset, valued map[uuid.UUID]bool,
+	from *time.Time, to *time.Time, baseDate *time.Time, series map[uuid.UUID]countryModel.CpiSeries) model.PortfolioSummary {
+	portfolio := model.PortfolioSummary{UserID: userId, From: from, To: to, BaseDate: baseDate}
+
+	totals := make(map[string]*model.CashFlowTotal)
+	withoutReal := make(map[string]bool)
+	valuationDate := getValuationDate(to)
+	marketValues := make(map[string]*model.MarketValue)
+
+	valuedAssets := commonUtil.ArrayFilter(assets, func(it model.Asset) bool { return valued == nil || valued[it.ID] })
+	assetValues := service.getMarketValues(ctx, commonUtil.Map(valuedAssets, func(it model.Asset) uuid.UUID { return it.ID }), valuationDate)
+	for _, asset := range assets {
+		summary := summarizeAsset(asset, from, to, *baseDate, service.getCpiSeries(ctx, asset.CityID, series))
+		if share := asset.ShareOf(userId, valuationDate); share > 0 {
+			for _, value := range assetValues[asset.ID] {
+				summary.MarketValues = append(summary.MarketValues, model.NewMarketValue(value.Currency, value.Value*share/100))
+				getOrCreateMarketValue(marketValues, value.Currency).Value += value.Value * share / 100
+			}
+		}
+		if exists, owner := commonUtil.ArrayFindFirst(summary.Owners, func(it *model.OwnerSummary) bool { return it.UserID == userId }); exists {
+			summary.Totals = owner.Totals
+			for _, total := range owner.Totals {
+				portfolioTotal := getOrCreateTotal(totals, total.Currency)
+				portfolioTotal.AddIncome(total.Income)
+				portfolioTotal.AddExpense(total.Expense)
+				if total.Real == nil {
+					withoutReal[total.Currency] = true
+					continue
+				}
+				portfolioTotal.AddRealIncome(total.Real.Income)
+				portfolioTotal.AddRealExpense(total.Real.Expense)
+			}
+		}
+		summary.Owners = nil
+		portfolio.Assets = append(portfolio.Assets, &summary)
+	}
+	for currency := range withoutReal {
+		totals[currency].Real = nil
+	}
+	portfolio.Totals = sortedTotals(totals)
+	portfolio.MarketValues = sortedMarketValues(marketValues)
+
+	return portfolio
+}
+
+// filterByTags keeps the tagged assets whole and the tagged cash flows of the other assets,
+// only the tagged assets are valued. Without tags all the assets are kept and valued
+func (service *analyticsService) filterByTags(ctx context.Context, assets []model.Asset, tagIds []uuid.UUID) ([]model.Asset, map[uuid.UUID]bool) {
+	if len(tagIds) == 0 {
+		return assets, nil
+	}
+
+	valued := make(map[uuid.UUID]bool)
+	for _, assetId := range service.tagRepository.FindAssetIds(ctx, tagIds) {
+		valued[assetId] = true
+	}
+	taggedCashFlows := make(map[uuid.UUID]bool)
+	for _, cashFlowId := range service.tagRepository.FindCashFlowIds(ctx, tagIds) {
+		taggedCashFlows[cashFlowId] = true
+	}
+	isTagged := func(it *model.CashFlow) bool { return taggedCashFlows[it.ID] }
+
+	var result []model.Asset
+	for _, asset := range assets {
+		if !valued[asset.ID] {
+			asset.Incomes = commonUtil.ArrayFilter(asset.Incomes, isTagged)
+			asset.Expenses = commonUtil.ArrayFilter(asset.Expenses, isTagged)
+			if len(asset.Incomes) == 0 && len(asset.Expenses) == 0 {
+				continue
+			}
+		}
+		result = append(result, asset)
+	}
+	return result, valued
+}
+
+func (service *analyticsService) RegisterValuationSource(source ValuationSource) {
+	service.valuationSources = append(service.valuationSources, source)
+}
+
+// getMarketValues merges the values of the assets given by all the registered sources
+func (service *analyticsService) getMarketValues(ctx context.Context, assetIds []uuid.UUID, date time.Time) map[uuid.UUID][]*model.MarketValue {
+	result := make(map[uuid.UUID][]*model.MarketValue)
+	if len(assetIds) == 0 {
+		return result
+	}
+	for _, source := range service.valuationSources {
+		for assetId, values := range source.GetMarketValues(ctx, assetIds, date) {
+			byCurrency := make(map[string]*model.MarketValue)
+			for _, value := range append(result[assetId], values...) {
+				getOrCreateMarketValue(byCurrency, value.Currency).Value += value.Value
+			}
+			result[assetId] = sortedMarketValues(byCurrency)
+		}
+	}
+	return result
+}
+
+// getCpiSeries returns the cpi series of the city's country, the loaded series are kept in the cache by city
+func (service *analyticsService) getCpiSeries(ctx context.Context, cityId uuid.UUID, cache map[uuid.UUID]countryModel.CpiSeries) countryModel.CpiSeries {
+	if series, ok := cache[cityId]; ok {
+		return series
+	}
+
+	var series countryModel.CpiSeries
+	if cities := service.cityRepository.GetById(ctx, []uuid.UUID{cityId}); len(cities) != 0 {
+		series = service.cpiIndexRepository.FindByCountryId(ctx, cities[0].CountryId)
+	}
+	if cache != nil {
+		cache[cityId] = series
+	}
+	return series
+}
+
+// summarizeAsset sums the asset cash flows within the period and splits every cash flow
+// between the owners according to the shares active at the cash flow date. With a non-empty
+// cpi series the real totals are summed as well in the money of the base date.
+func summarizeAsset(asset model.Asset, from *time.Time, to *time.Time, baseDate time.Time, series countryModel.CpiSeries) model.AssetSummary {
+	totals := make(map[string]*model.CashFlowTotal)
+	ownerTotals := make(map[uuid.UUID]map[string]*model.CashFlowTotal)
+	ownerIds := asset.GetOwnerIds()
+	for _, ownerId := range ownerIds {
+		ownerTotals[ownerId] = make(map[string]*model.CashFlowTotal)
+	}
+
+	apply := func(cashFlows []*model.CashFlow, add func(total *model.CashFlowTotal, amount float64), addReal func(total *model.CashFlowTotal, amount float64)) {
+		for _, cashFlow := range cashFlows {
+			if !isInPeriod(cashFlow.Date, from, to) {
+				continue
+			}
+
+			date := time.Now()
+			if cashFlow.Date != nil {
+				date = *cashFlow.Date
+			}
+			addAmount := func(total *model.CashFlowTotal, amount float64) {
+				add(total, amount)
+				if !series.IsEmpty() {
+					addReal(total, series.Deflate(amount, date, baseDate))
+				}
+			}
+
+			addAmount(getOrCreateTotal(totals, cashFlow.Currency), cashFlow.Amount)
+			for _, ownerId := range ownerIds {
+				if share := asset.ShareOf(ownerId, date); share > 0 {
+					addAmount(getOrCreateTotal(ownerTotals[ownerId], cashFlow.Currency), cashFlow.Amount*share/100)
+				}
+			}
+		}
+	}
+	apply(asset.Incomes, (*model.CashFlowTotal).AddIncome, (*model.CashFlowTotal).AddRealIncome)
+	apply(asset.Expenses, (*model.CashFlowTotal).AddExpense, (*model.CashFlowTotal).AddRealExpense)
+
+	summary := model.AssetSummary{AssetID: asset.ID, Name: asset.Name, From: from, To: to, BaseDate: &baseDate, Totals: sortedTotals(totals)}
+	for _, ownerId := range ownerIds {
+		summary.Owners = append(summary.Owners, &model.OwnerSummary{UserID: ownerId, Totals: sortedTotals(ownerTotals[ownerId])})
+	}
+	return summary
+}
+
+func getBaseDate(baseDate *time.Time) *time.Time {
+	if baseDate == nil {
+		now := time.Now()
+		return &now
+	}
+	return baseDate
+}
+
+// getValuationDate returns the end of the period or now for the open one
+func getValuationDate(to *time.Time) time.Time {
+	if to == nil {
+		return time.Now()
+	}
+	return *to
+}
+
+func isInPeriod(date *time.Time, from *time.Time, to *time.Time) bool {
+	if date == nil {
+		return from == nil && to == nil
+	}
+	if from != nil && date.Before(*from) {
+		return false
+	}
+	if to != nil && !date.Before(*to) {
+		return false
+	}
+	return true
+}
+
+func getOrCreateTotal(totals map[string]*model.CashFlowTotal, currency string) *model.CashFlowTotal {
+	total, ok := totals[currency]
+	if !ok {
+		total = model.NewCashFlowTotal(currency)
+		totals[currency] = total
+	}
+	return total
+}
+
+func sortedTotals(totals map[string]*model.CashFlowTotal) []*model.CashFlowTotal {
+	result := commonUtil.GetMapValues(totals)
+	sort.Slice(result, func(i, j int) bool { return result[i].Currency < result[j].Currency })
+	return result
+}
+
+func getOrCreateMarketValue(values map[string]*model.MarketValue, currency string) *model.MarketValue {
+	value, ok := values[currency]
+	if !ok {
+		value = model.NewMarketValue(currency, 0)
+		values[currency] = value
+	}
+	return value
+}
+
+func sortedMarketValues(values map[string]*model.MarketValue) []*model.MarketValue {
+	result := commonUtil.GetMapValues(values)
+	sort.Slice(result, func(i, j int) bool { return result[i].Currency < result[j].Currency })
+	return result
+}
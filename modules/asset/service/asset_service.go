@@ -26,11 +26,19 @@ package service
 
 import (
 	commonCache "assets/common/cache"
+	"assets/common/config"
+	commonError "assets/common/custom_error"
+	"assets/common/geocoder"
 	commonModel "assets/common/model"
+	"assets/common/scheduler"
+	commonUtil "assets/common/util"
 	"assets/modules/asset/model"
 	"assets/modules/asset/repository"
+	countryRepository "assets/modules/country/repository"
 	"context"
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"strings"
 	"time"
 )
 
@@ -38,15 +46,42 @@ var assetSrv AssetService
 
 type AssetService interface {
 	GetById(ctx context.Context, id uuid.UUID) model.Asset
-	GetAll(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.Asset]
+	GetAll(ctx context.Context, filter model.TagFilter, page commonModel.Pageable) commonModel.Page[model.Asset]
 	Create(ctx context.Context, asset model.Asset) model.Asset
 	Update(ctx context.Context, asset model.Asset) model.Asset
 	DeleteById(ctx context.Context, id uuid.UUID)
+	GetTrash(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.Asset]
+	Restore(ctx context.Context, id uuid.UUID) model.Asset
+	PurgeTrash(ctx context.Context)
+
+	GetShared(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.Asset]
+	GetShares(ctx context.Context, id uuid.UUID) []model.AssetShare
+	UpdateShares(ctx context.Context, id uuid.UUID, shares []model.AssetShare) []model.AssetShare
+	GetGrants(ctx context.Context, id uuid.UUID) []model.AssetGrant
+	UpdateGrants(ctx context.Context, id uuid.UUID, grants []model.AssetGrant) []model.AssetGrant
+	UpdateTags(ctx context.Context, id uuid.UUID, tagIds []uuid.UUID) model.Asset
+	AddIncome(ctx context.Context, id uuid.UUID, cashFlow model.CashFlow) model.CashFlow
+	AddExpense(ctx context.Context, id uuid.UUID, cashFlow model.CashFlow) model.CashFlow
+	RemoveIncome(ctx context.Context, id uuid.UUID, cashFlowId uuid.UUID)
+	FindInBoundingBox(ctx context.Context, box model.BoundingBox) []model.Asset
+	FindInRadius(ctx context.Context, latitude float64, longitude float64, radius float64) []model.Asset
+	GetLocated(ctx context.Context) []model.Asset
+	AddDeleteListener(listener func(ctx context.Context, asset model.Asset))
+	CheckWritable(ctx context.Context, id uuid.UUID) model.Asset
 }
 
 type assetService struct {
-	repository repository.AssetRepository
-	cache      *commonCache.Cache[commonModel.Page[model.Asset]]
+	repository        repository.AssetRepository
+	cashFlowService   CashFlowService
+	shareRepository   repository.AssetShareRepository
+	grantRepository   repository.AssetGrantRepository
+	tagRepository     repository.TagRepository
+	changeRepository  repository.AssetChangeRepository
+	cityRepository    countryRepository.CityRepository
+	countryRepository countryRepository.CountryRepository
+	geocoder          geocoder.Geocoder
+	cache             *commonCache.Cache[commonModel.Page[model.Asset]]
+	deleteListeners   []func(ctx context.Context, asset model.Asset)
 }
 
 func GetAssetService() AssetService {
@@ -55,36 +90,281 @@ func GetAssetService() AssetService {
 	}
 
 	assetSrv = &assetService{
-		repository: repository.GetAssetRepository(),
-		cache:      commonCache.NewCache[commonModel.Page[model.Asset]]("assets", 24*time.Hour),
+		repository:        repository.GetAssetRepository(),
+		cashFlowService:   GetCashFlowService(),
+		shareRepository:   repository.GetAssetShareRepository(),
+		grantRepository:   repository.GetAssetGrantRepository(),
+		tagRepository:     repository.GetTagRepository(),
+		changeRepository:  repository.GetAssetChangeRepository(),
+		cityRepository:    countryRepository.GetCityRepository(),
+		countryRepository: countryRepository.GetCountryRepository(),
+		geocoder:          geocoder.GetGeocoder(),
+		cache:             commonCache.NewCache[commonModel.Page[model.Asset]]("assets", 24*time.Hour),
 	}
+	scheduler.Schedule("assetTrash", config.CoreConfig.Database.GetTrashPurgeInterval(), assetSrv.PurgeTrash)
 
 	return assetSrv
 }
 
 func (service *assetService) GetById(ctx context.Context, id uuid.UUID) model.Asset {
-	return service.repository.GetById(ctx, []uuid.UUID{id})[0]
+	result := service.repository.GetById(ctx, []uuid.UUID{id})
+	if len(result) == 0 {
+		panic(commonError.NotFoundError)
+	}
+	return result[0]
 }
 
-func (service *assetService) GetAll(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.Asset] {
+func (service *assetService) GetAll(ctx context.Context, filter model.TagFilter, page commonModel.Pageable) commonModel.Page[model.Asset] {
 	result, err := service.cache.Get(ctx)
 	if err == nil {
 		return result
 	}
-	return service.cache.Set(ctx, service.repository.GetAllWithPage(ctx, page))
+	return service.cache.Set(ctx, service.repository.FindAllWithPage(ctx, filter, page))
 }
 
+// Create stores the asset, its shares are stored as the owner sets them, cash flows and tags
+// are added to the stored asset by the dedicated methods
 func (service *assetService) Create(ctx context.Context, asset model.Asset) model.Asset {
+	if !model.ValidateShares(asset.Shares) {
+		panic(commonError.InvalidAssetSharesError)
+	}
+	if userId, restricted := repository.GetRestrictedUserId(ctx); restricted {
+		if !commonUtil.IsZeroObject(asset.OwnerID) && asset.OwnerID != userId {
+			panic(commonError.NotEnoughRightsError)
+		}
+		asset.OwnerID = userId
+	} else if tokenInfo, ok := commonUtil.GetCurrentTokenInfo(ctx); ok && commonUtil.IsZeroObject(asset.OwnerID) {
+		asset.OwnerID = tokenInfo.UserId
+	}
+	service.locate(ctx, &asset, nil)
+	shares := asset.Shares
+	asset.Shares, asset.Incomes, asset.Expenses, asset.Tags = nil, nil, nil, nil
 	defer service.cache.Evict(ctx)
-	return service.repository.Create(ctx, []model.Asset{asset})[0]
+	asset = service.repository.Create(ctx, []model.Asset{asset})[0]
+	if len(shares) != 0 {
+		service.UpdateShares(ctx, asset.ID, commonUtil.Map(shares, func(it *model.AssetShare) model.AssetShare { return *it }))
+	}
+	return service.GetById(ctx, asset.ID)
 }
 
+// Update changes the fields of the asset only, shares, cash flows and tags are kept as stored,
+// they are changed by the dedicated methods
 func (service *assetService) Update(ctx context.Context, asset model.Asset) model.Asset {
+	var changes []model.AssetChange
+	if stored := service.repository.GetById(ctx, []uuid.UUID{asset.ID}); len(stored) != 0 {
+		if stored[0].OwnerID != asset.OwnerID {
+			service.checkOwner(ctx, stored[0])
+		}
+		service.locate(ctx, &asset, &stored[0])
+		changes = model.NewAssetChanges(stored[0], asset, getUserId(ctx), time.Now())
+	} else {
+		service.locate(ctx, &asset, nil)
+	}
+	asset.Shares, asset.Incomes, asset.Expenses, asset.Tags = nil, nil, nil, nil
 	defer service.cache.Evict(ctx)
-	return service.repository.Update(ctx, []model.Asset{asset})[0]
+	asset = service.repository.Update(ctx, []model.Asset{asset})[0]
+	if len(changes) != 0 {
+		service.changeRepository.Create(ctx, changes)
+	}
+	return service.GetById(ctx, asset.ID)
 }
 
 func (service *assetService) DeleteById(ctx context.Context, id uuid.UUID) {
+	asset := service.CheckWritable(ctx, id)
+	defer service.cache.Evict(ctx)
+	service.repository.DeleteById(ctx, asset.ID)
+}
+
+func (service *assetService) GetTrash(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.Asset] {
+	return service.repository.FindDeleted(ctx, page)
+}
+
+func (service *assetService) Restore(ctx context.Context, id uuid.UUID) model.Asset {
 	defer service.cache.Evict(ctx)
-	service.repository.DeleteById(ctx, id)
+	service.repository.Restore(ctx, id)
+	return service.GetById(ctx, id)
+}
+
+// PurgeTrash deletes for good the assets which are in the trash longer than the retention
+// together with the entities which belong to them
+func (service *assetService) PurgeTrash(ctx context.Context) {
+	assets := service.repository.FindDeletedBefore(ctx, time.Now().Add(-config.CoreConfig.Database.GetTrashRetention()))
+	for _, asset := range assets {
+		service.purge(ctx, asset)
+	}
+}
+
+// purge deletes the asset for good, the asset failed is logged and purged on the next run.
+// The entities of the asset are deleted first, so the asset stays in the trash until they are deleted
+func (service *assetService) purge(ctx context.Context, asset model.Asset) {
+	defer commonUtil.DefaultRecovery(ctx)
+
+	for _, listener := range service.deleteListeners {
+		listener(ctx, asset)
+	}
+	service.tagRepository.DeleteAssetLinks(ctx, asset.ID)
+	service.changeRepository.DeleteByAssetId(ctx, asset.ID)
+	service.repository.Purge(ctx, asset.ID)
+	log.WithContext(ctx).Infof("AssetService: asset %s purged from the trash", asset.ID)
+}
+
+// AddDeleteListener registers the cleanup of entities which belong to the asset purged from the trash
+func (service *assetService) AddDeleteListener(listener func(ctx context.Context, asset model.Asset)) {
+	service.deleteListeners = append(service.deleteListeners, listener)
+}
+
+func (service *assetService) GetShared(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.Asset] {
+	tokenInfo := commonUtil.MustGetCurrentTokenInfo(ctx)
+	return service.repository.FindSharedWith(ctx, tokenInfo.UserId, time.Now(), page)
+}
+
+func (service *assetService) GetShares(ctx context.Context, id uuid.UUID) []model.AssetShare {
+	service.GetById(ctx, id)
+	return service.shareRepository.FindByAssetId(ctx, id)
+}
+
+func (service *assetService) UpdateShares(ctx context.Context, id uuid.UUID, shares []model.AssetShare) []model.AssetShare {
+	if !model.ValidateShares(commonUtil.Map(shares, func(it model.AssetShare) *model.AssetShare { return &it })) {
+		panic(commonError.InvalidAssetSharesError)
+	}
+	service.checkOwner(ctx, service.GetById(ctx, id))
+	defer service.cache.Evict(ctx)
+	return service.shareRepository.ReplaceByAssetId(ctx, id, shares)
+}
+
+func (service *assetService) GetGrants(ctx context.Context, id uuid.UUID) []model.AssetGrant {
+	service.GetById(ctx, id)
+	return service.grantRepository.FindByAssetId(ctx, id)
+}
+
+func (service *assetService) UpdateGrants(ctx context.Context, id uuid.UUID, grants []model.AssetGrant) []model.AssetGrant {
+	for _, grant := range grants {
+		if !grant.IsValid() {
+			panic(commonError.IllegalArgumentError)
+		}
+	}
+	service.checkOwner(ctx, service.GetById(ctx, id))
+	defer service.cache.Evict(ctx)
+	return service.grantRepository.ReplaceByAssetId(ctx, id, grants)
+}
+
+// UpdateTags replaces the current user's tags of the asset, the asset must be writable by the user
+func (service *assetService) UpdateTags(ctx context.Context, id uuid.UUID, tagIds []uuid.UUID) model.Asset {
+	service.CheckWritable(ctx, id)
+	userId := checkOwnTags(ctx, service.tagRepository, tagIds)
+	defer service.cache.Evict(ctx)
+	service.tagRepository.ReplaceAssetTags(ctx, id, userId, tagIds)
+	return service.GetById(ctx, id)
+}
+
+func (service *assetService) AddIncome(ctx context.Context, id uuid.UUID, cashFlow model.CashFlow) model.CashFlow {
+	service.CheckWritable(ctx, id)
+	defer service.cache.Evict(ctx)
+	cashFlow = service.cashFlowService.Create(ctx, cashFlow)
+	service.repository.AddIncomes(ctx, id, []model.CashFlow{cashFlow})
+	return cashFlow
+}
+
+func (service *assetService) AddExpense(ctx context.Context, id uuid.UUID, cashFlow model.CashFlow) model.CashFlow {
+	service.CheckWritable(ctx, id)
+	defer service.cache.Evict(ctx)
+	cashFlow = service.cashFlowService.Create(ctx, cashFlow)
+	service.repository.AddExpenses(ctx, id, []model.CashFlow{cashFlow})
+	return cashFlow
+}
+
+func (service *assetService) RemoveIncome(ctx context.Context, id uuid.UUID, cashFlowId uuid.UUID) {
+	service.CheckWritable(ctx, id)
+	defer service.cache.Evict(ctx)
+	service.repository.RemoveIncomes(ctx, id, []uuid.UUID{cashFlowId})
+	service.cashFlowService.DeleteById(ctx, cashFlowId)
+}
+
+func getUserId(ctx context.Context) *uuid.UUID {
+	if tokenInfo, ok := commonUtil.GetCurrentTokenInfo(ctx); ok {
+		return &tokenInfo.UserId
+	}
+	return nil
+}
+
+// CheckWritable returns the asset if it is available to the current user and the user may change it, it panics
+// with NotFoundError or NotEnoughRightsError otherwise. Other modules check the asset of their entities by it
+func (service *assetService) CheckWritable(ctx context.Context, id uuid.UUID) model.Asset {
+	asset := service.GetById(ctx, id)
+	if !service.repository.IsWritable(ctx, id) {
+		panic(commonError.NotEnoughRightsError)
+	}
+	return asset
+}
+
+// checkOwner allows to manage ownership and access of the asset only to its owner
+func (service *assetService) checkOwner(ctx context.Context, asset model.Asset) {
+	if userId, restricted := repository.GetRestrictedUserId(ctx); restricted && asset.OwnerID != userId {
+		panic(commonError.NotEnoughRightsError)
+	}
+}
+
+func (service *assetService) FindInBoundingBox(ctx context.Context, box model.BoundingBox) []model.Asset {
+	if !box.IsValid() {
+		panic(commonError.IllegalArgumentError)
+	}
+	return service.repository.FindInBoundingBox(ctx, box)
+}
+
+func (service *assetService) FindInRadius(ctx context.Context, latitude float64, longitude float64, radius float64) []model.Asset {
+	if latitude < -90 || latitude > 90 || longitude < -180 || longitude > 180 || radius <= 0 {
+		panic(commonError.IllegalArgumentError)
+	}
+	return service.repository.FindInRadius(ctx, latitude, longitude, radius)
+}
+
+func (service *assetService) GetLocated(ctx context.Context) []model.Asset {
+	return service.repository.FindInBoundingBox(ctx, model.BoundingBox{MinLatitude: -90, MinLongitude: -180, MaxLatitude: 90, MaxLongitude: 180})
+}
+
+// locate validates the coordinates and geocodes new or changed address: the address must belong
+// to the asset's city, missing coordinates are filled. Geocoding failures don't prevent saving the asset
+func (service *assetService) locate(ctx context.Context, asset *model.Asset, stored *model.Asset) {
+	if !asset.IsValidLocation() {
+		panic(commonError.IllegalArgumentError)
+	}
+	if service.geocoder == nil || asset.Address == "" {
+		return
+	}
+	if stored != nil && stored.Address == asset.Address && stored.CityID == asset.CityID && asset.HasLocation() {
+		return
+	}
+
+	location, err := service.geocoder.Geocode(ctx, asset.Address)
+	if !commonUtil.IsZeroObject(asset.CityID) {
+		cities := service.cityRepository.GetById(ctx, []uuid.UUID{asset.CityID})
+		if len(cities) == 0 {
+			panic(commonError.IllegalArgumentError)
+		}
+		// the address may be ambiguous without the city, so it is checked once more within the city
+		if err != nil || !location.IsSameCity(cities[0].Name) {
+			if location, err = service.geocoder.Geocode(ctx, asset.Address+", "+cities[0].Name); err == nil && !location.IsSameCity(cities[0].Name) {
+				panic(commonError.AddressNotInCityError)
+			}
+		}
+		if err == nil && !service.isSameCountry(ctx, cities[0].CountryId, location) {
+			panic(commonError.AddressNotInCityError)
+		}
+	}
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Warnf("AssetService: couldn't geocode address %q", asset.Address)
+		return
+	}
+
+	if !asset.HasLocation() {
+		asset.Latitude, asset.Longitude = &location.Latitude, &location.Longitude
+	}
+}
+
+func (service *assetService) isSameCountry(ctx context.Context, countryId uuid.UUID, location geocoder.Location) bool {
+	countries := service.countryRepository.GetById(ctx, []uuid.UUID{countryId})
+	if len(countries) == 0 || countries[0].CharCode2 == "" || location.CountryCode == "" {
+		return true
+	}
+	return strings.EqualFold(countries[0].CharCode2, location.CountryCode)
 }
@@ -26,11 +26,16 @@ package service
 
 import (
 	commonCache "assets/common/cache"
+	"assets/common/config"
+	commonError "assets/common/custom_error"
 	commonModel "assets/common/model"
+	"assets/common/scheduler"
+	commonUtil "assets/common/util"
 	"assets/modules/asset/model"
 	"assets/modules/asset/repository"
 	"context"
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 	"time"
 )
 
@@ -38,15 +43,26 @@ var cashFlowSrv CashFlowService
 
 type CashFlowService interface {
 	GetById(ctx context.Context, id uuid.UUID) model.CashFlow
-	GetAll(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.CashFlow]
+	GetAll(ctx context.Context, filter model.TagFilter, page commonModel.Pageable) commonModel.Page[model.CashFlow]
 	Create(ctx context.Context, cashFlow model.CashFlow) model.CashFlow
 	Update(ctx context.Context, cashFlow model.CashFlow) model.CashFlow
 	DeleteById(ctx context.Context, id uuid.UUID)
+	GetTrash(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.CashFlow]
+	Restore(ctx context.Context, id uuid.UUID) model.CashFlow
+	PurgeTrash(ctx context.Context)
+	UpdateTags(ctx context.Context, id uuid.UUID, tagIds []uuid.UUID) model.CashFlow
+	AddDeleteListener(listener func(ctx context.Context, cashFlow model.CashFlow))
+
+	CreateAll(ctx context.Context, cashFlows []model.CashFlow, partial bool) commonModel.BulkResult
+	UpdateAll(ctx context.Context, cashFlows []model.CashFlow, partial bool) commonModel.BulkResult
+	DeleteAll(ctx context.Context, ids []uuid.UUID, partial bool) commonModel.BulkResult
 }
 
 type cashFlowService struct {
-	repository repository.CashFlowRepository
-	cache      *commonCache.Cache[commonModel.Page[model.CashFlow]]
+	repository      repository.CashFlowRepository
+	tagRepository   repository.TagRepository
+	cache           *commonCache.Cache[commonModel.Page[model.CashFlow]]
+	deleteListeners []func(ctx context.Context, cashFlow model.CashFlow)
 }
 
 func GetCashFlowService() CashFlowService {
@@ -55,36 +71,241 @@ func GetCashFlowService() CashFlowService {
 	}
 
 	cashFlowSrv = &cashFlowService{
-		repository: repository.GetCashFlowRepository(),
-		cache:      commonCache.NewCache[commonModel.Page[model.CashFlow]]("cashFlows", 24*time.Hour),
+		repository:    repository.GetCashFlowRepository(),
+		tagRepository: repository.GetTagRepository(),
+		cache:         commonCache.NewCache[commonModel.Page[model.CashFlow]]("cashFlows", 24*time.Hour),
 	}
+	scheduler.Schedule("cashFlowTrash", config.CoreConfig.Database.GetTrashPurgeInterval(), cashFlowSrv.PurgeTrash)
 
 	return cashFlowSrv
 }
 
 func (service *cashFlowService) GetById(ctx context.Context, id uuid.UUID) model.CashFlow {
-	return service.repository.GetById(ctx, []uuid.UUID{id})[0]
+	result := service.repository.GetById(ctx, []uuid.UUID{id})
+	if len(result) == 0 {
+		panic(commonError.NotFoundError)
+	}
+	return result[0]
 }
 
-func (service *cashFlowService) GetAll(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.CashFlow] {
+func (service *cashFlowService) GetAll(ctx context.Context, filter model.TagFilter, page commonModel.Pageable) commonModel.Page[model.CashFlow] {
 	result, err := service.cache.Get(ctx)
 	if err == nil {
 		return result
 	}
-	return service.cache.Set(ctx, service.repository.GetAllWithPage(ctx, page))
+	return service.cache.Set(ctx, service.repository.FindAllWithPage(ctx, filter, page))
 }
 
 func (service *cashFlowService) Create(ctx context.Context, cashFlow model.CashFlow) model.CashFlow {
+	if tokenInfo, ok := commonUtil.GetCurrentTokenInfo(ctx); ok {
+		cashFlow.CreatedBy = tokenInfo.UserId
+	}
+	// tags are personal, so they are managed by UpdateTags only
+	cashFlow.Tags = nil
 	defer service.cache.Evict(ctx)
 	return service.repository.Create(ctx, []model.CashFlow{cashFlow})[0]
 }
 
 func (service *cashFlowService) Update(ctx context.Context, cashFlow model.CashFlow) model.CashFlow {
+	if stored := service.repository.GetById(ctx, []uuid.UUID{cashFlow.ID}); len(stored) != 0 {
+		cashFlow.CreatedBy = stored[0].CreatedBy
+	}
+	cashFlow.Tags = nil
 	defer service.cache.Evict(ctx)
 	return service.repository.Update(ctx, []model.CashFlow{cashFlow})[0]
 }
 
 func (service *cashFlowService) DeleteById(ctx context.Context, id uuid.UUID) {
+	service.GetById(ctx, id)
+	if !service.repository.IsWritable(ctx, id) {
+		panic(commonError.NotEnoughRightsError)
+	}
 	defer service.cache.Evict(ctx)
 	service.repository.DeleteById(ctx, id)
 }
+
+func (service *cashFlowService) GetTrash(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.CashFlow] {
+	return service.repository.FindDeleted(ctx, page)
+}
+
+func (service *cashFlowService) Restore(ctx context.Context, id uuid.UUID) model.CashFlow {
+	defer service.cache.Evict(ctx)
+	service.repository.Restore(ctx, id)
+	return service.GetById(ctx, id)
+}
+
+// PurgeTrash deletes for good the cash flows which are in the trash longer than the retention
+// together with the entities which belong to them
+func (service *cashFlowService) PurgeTrash(ctx context.Context) {
+	cashFlows := service.repository.FindDeletedBefore(ctx, time.Now().Add(-config.CoreConfig.Database.GetTrashRetention()))
+	for _, cashFlow := range cashFlows {
+		service.purge(ctx, cashFlow)
+	}
+}
+
+// purge deletes the cash flow for good, the cash flow failed is logged and purged on the next run.
+// The entities of the cash flow are deleted first, so the cash flow stays in the trash until they are deleted
+func (service *cashFlowService) purge(ctx context.Context, cashFlow model.CashFlow) {
+	defer commonUtil.DefaultRecovery(ctx)
+
+	for _, listener := range service.deleteListeners {
+		listener(ctx, cashFlow)
+	}
+	service.tagRepository.DeleteCashFlowLinks(ctx, cashFlow.ID)
+	service.repository.Purge(ctx, cashFlow.ID)
+	log.WithContext(ctx).Infof("CashFlowService: cash flow %s purged from the trash", cashFlow.ID)
+}
+
+// UpdateTags replaces the current user's tags of the cash flow, the cash flow must be writable by the user
+func (service *cashFlowService) UpdateTags(ctx context.Context, id uuid.UUID, tagIds []uuid.UUID) model.CashFlow {
+	service.GetById(ctx, id)
+	if !service.repository.IsWritable(ctx, id) {
+		panic(commonError.NotEnoughRightsError)
+	}
+	userId := checkOwnTags(ctx, service.tagRepository, tagIds)
+	defer service.cache.Evict(ctx)
+	service.tagRepository.ReplaceCashFlowTags(ctx, id, userId, tagIds)
+	return service.GetById(ctx, id)
+}
+
+// AddDeleteListener registers the cleanup of entities which belong to the cash flow purged from the trash
+func (service *cashFlowService) AddDeleteListener(listener func(ctx context.Context, cashFlow model.CashFlow)) {
+	service.deleteListeners = append(service.deleteListeners, listener)
+}
+
+// CreateAll creates the cash flows in batches within one transaction. Invalid cash flows are reported
+// as failed, the rest are stored unless the request is to be applied as a whole
+func (service *cashFlowService) CreateAll(ctx context.Context, cashFlows []model.CashFlow, partial bool) commonModel.BulkResult {
+	result := newBulkResult(len(cashFlows))
+	var userId uuid.UUID
+	if tokenInfo, ok := commonUtil.GetCurrentTokenInfo(ctx); ok {
+		userId = tokenInfo.UserId
+	}
+	for i := range cashFlows {
+		cashFlows[i].CreatedBy, cashFlows[i].Tags, cashFlows[i].SoftDeletable = userId, nil, commonModel.SoftDeletable{}
+		if !cashFlows[i].IsValid() {
+			result.Fail(i, commonError.IllegalArgumentError)
+		}
+	}
+
+	applyInBatches(result, cashFlows, partial, model.CashFlow.GetID, func(valid []model.CashFlow) map[int]error {
+		return service.repository.CreateInBatches(ctx, valid, config.CoreConfig.Database.BatchSize, partial)
+	})
+	if result.Succeeded != 0 {
+		service.cache.Evict(ctx)
+	}
+	return *result
+}
+
+// UpdateAll updates the existing writable cash flows in batches within one transaction, see CreateAll
+func (service *cashFlowService) UpdateAll(ctx context.Context, cashFlows []model.CashFlow, partial bool) commonModel.BulkResult {
+	result := newBulkResult(len(cashFlows))
+	ids := commonUtil.Map(cashFlows, model.CashFlow.GetID)
+	stored, writable := service.getStoredAndWritable(ctx, ids)
+	for i := range cashFlows {
+		if err := checkBulkItem(ids[:i], ids[i], stored, writable); err != nil {
+			result.Fail(i, err)
+		} else if !cashFlows[i].IsValid() {
+			result.Fail(i, commonError.IllegalArgumentError)
+		} else {
+			cashFlows[i].CreatedBy, cashFlows[i].Tags, cashFlows[i].SoftDeletable = stored[ids[i]].CreatedBy, nil, commonModel.SoftDeletable{}
+		}
+	}
+
+	applyInBatches(result, cashFlows, partial, model.CashFlow.GetID, func(valid []model.CashFlow) map[int]error {
+		return service.repository.UpdateInBatches(ctx, valid, config.CoreConfig.Database.BatchSize, partial)
+	})
+	if result.Succeeded != 0 {
+		service.cache.Evict(ctx)
+	}
+	return *result
+}
+
+// DeleteAll moves the existing writable cash flows to the trash in batches within one transaction, see CreateAll
+func (service *cashFlowService) DeleteAll(ctx context.Context, ids []uuid.UUID, partial bool) commonModel.BulkResult {
+	result := newBulkResult(len(ids))
+	stored, writable := service.getStoredAndWritable(ctx, ids)
+	for i := range ids {
+		if err := checkBulkItem(ids[:i], ids[i], stored, writable); err != nil {
+			result.Fail(i, err)
+		}
+	}
+
+	applyInBatches(result, ids, partial, func(id uuid.UUID) uuid.UUID { return id }, func(valid []uuid.UUID) map[int]error {
+		return service.repository.DeleteInBatches(ctx, valid, config.CoreConfig.Database.BatchSize, partial)
+	})
+	if result.Succeeded != 0 {
+		service.cache.Evict(ctx)
+	}
+	return *result
+}
+
+func (service *cashFlowService) getStoredAndWritable(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]model.CashFlow, map[uuid.UUID]bool) {
+	stored := make(map[uuid.UUID]model.CashFlow)
+	for _, cashFlow := range service.repository.GetById(ctx, ids) {
+		stored[cashFlow.ID] = cashFlow
+	}
+	writable := make(map[uuid.UUID]bool)
+	for _, id := range service.repository.FindWritableIds(ctx, ids) {
+		writable[id] = true
+	}
+	return stored, writable
+}
+
+// newBulkResult panics unless the size of the bulk request is within the configured limit
+func newBulkResult(size int) *commonModel.BulkResult {
+	maxSize := config.CoreConfig.Database.MaxBulkSize
+	if maxSize == 0 {
+		maxSize = 1000
+	}
+	if size == 0 || size > maxSize {
+		panic(commonError.IllegalArgumentError)
+	}
+	return commonModel.NewBulkResult(size)
+}
+
+// checkBulkItem returns the error of the item which is repeated in the request, not found or not writable
+func checkBulkItem(previousIds []uuid.UUID, id uuid.UUID, stored map[uuid.UUID]model.CashFlow, writable map[uuid.UUID]bool) error {
+	if _, ok := stored[id]; !ok {
+		return commonError.NotFoundError
+	}
+	if !writable[id] {
+		return commonError.NotEnoughRightsError
+	}
+	if commonUtil.ArrayContains(previousIds, id) {
+		return commonError.IllegalArgumentError
+	}
+	return nil
+}
+
+// applyInBatches stores the items which are not failed yet and completes the result with the outcome.
+// Unless partial, nothing is stored if any item is failed
+func applyInBatches[T any](result *commonModel.BulkResult, items []T, partial bool, getId func(T) uuid.UUID, store func(valid []T) map[int]error) {
+	if !partial && result.Failed != 0 {
+		result.RollBack()
+		return
+	}
+
+	var indexes []int
+	var valid []T
+	for i, item := range items {
+		if !result.IsFailed(i) {
+			indexes, valid = append(indexes, i), append(valid, item)
+		}
+	}
+	if len(valid) == 0 {
+		return
+	}
+
+	failures := store(valid)
+	for i, index := range indexes {
+		if err, failed := failures[i]; failed {
+			result.Fail(index, err)
+		} else {
+			result.Succeed(index, getId(valid[i]))
+		}
+	}
+	if !partial && len(failures) != 0 {
+		result.RollBack()
+	}
+}
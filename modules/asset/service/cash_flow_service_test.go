@@ -0,0 +1,86 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonModel "assets/common/model"
+	"errors"
+	"github.com/google/uuid"
+	"reflect"
+	"testing"
+)
+
+func TestApplyInBatches(t *testing.T) {
+	errStore := errors.New("store failed")
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+	tests := []struct {
+		name           string
+		invalid        int
+		storeFailed    int
+		partial        bool
+		wantStored     []uuid.UUID
+		wantSuccess    []bool
+		wantRolledBack bool
+	}{
+		{"all stored", -1, -1, false, ids, []bool{true, true, true}, false},
+		{"invalid item stores nothing", 0, -1, false, nil, []bool{false, false, false}, true},
+		{"failed batch rolls back all items", -1, 2, false, ids, []bool{false, false, false}, true},
+		{"invalid item is skipped in partial mode", 0, -1, true, ids[1:], []bool{false, true, true}, false},
+		{"failed item is skipped in partial mode", -1, 2, true, ids, []bool{true, true, false}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := commonModel.NewBulkResult(len(ids))
+			if test.invalid >= 0 {
+				result.Fail(test.invalid, errors.New("invalid"))
+			}
+
+			var stored []uuid.UUID
+			applyInBatches(result, ids, test.partial, func(id uuid.UUID) uuid.UUID { return id }, func(valid []uuid.UUID) map[int]error {
+				stored = valid
+				failures := make(map[int]error)
+				for i, id := range valid {
+					if test.storeFailed >= 0 && id == ids[test.storeFailed] {
+						failures[i] = errStore
+					}
+				}
+				return failures
+			})
+
+			if !reflect.DeepEqual(stored, test.wantStored) {
+				t.Errorf("applyInBatches() stored %v, want %v", stored, test.wantStored)
+			}
+			for i, item := range result.Items {
+				if item.Success != test.wantSuccess[i] {
+					t.Errorf("applyInBatches() item %d success = %v, want %v", i, item.Success, test.wantSuccess[i])
+				}
+			}
+			if result.RolledBack != test.wantRolledBack {
+				t.Errorf("applyInBatches() rolledBack = %v, want %v", result.RolledBack, test.wantRolledBack)
+			}
+		})
+	}
+}
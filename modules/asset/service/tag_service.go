@@ -0,0 +1,129 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonCache "assets/common/cache"
+	commonError "assets/common/custom_error"
+	commonUtil "assets/common/util"
+	"assets/modules/asset/model"
+	"assets/modules/asset/repository"
+	"context"
+	"github.com/google/uuid"
+	"strings"
+)
+
+var tagSrv TagService
+
+type TagService interface {
+	GetById(ctx context.Context, id uuid.UUID) model.Tag
+	GetAll(ctx context.Context) []model.Tag
+	Create(ctx context.Context, tag model.Tag) model.Tag
+	Update(ctx context.Context, tag model.Tag) model.Tag
+	DeleteById(ctx context.Context, id uuid.UUID)
+}
+
+type tagService struct {
+	repository repository.TagRepository
+	// assets and cash flows are cached together with their tags
+	evictTagged func(ctx context.Context)
+}
+
+func GetTagService() TagService {
+	if tagSrv != nil {
+		return tagSrv
+	}
+
+	tagSrv = &tagService{
+		repository:  repository.GetTagRepository(),
+		evictTagged: commonCache.EvictCache("assets", "cashFlows"),
+	}
+
+	return tagSrv
+}
+
+func (service *tagService) GetById(ctx context.Context, id uuid.UUID) model.Tag {
+	result := service.repository.GetById(ctx, []uuid.UUID{id})
+	if len(result) == 0 {
+		panic(commonError.NotFoundError)
+	}
+	return result[0]
+}
+
+func (service *tagService) GetAll(ctx context.Context) []model.Tag {
+	return service.repository.FindByOwnerId(ctx, commonUtil.MustGetCurrentTokenInfo(ctx).UserId)
+}
+
+func (service *tagService) Create(ctx context.Context, tag model.Tag) model.Tag {
+	tag.OwnerID = commonUtil.MustGetCurrentTokenInfo(ctx).UserId
+	service.validate(ctx, &tag)
+	return service.repository.Create(ctx, []model.Tag{tag})[0]
+}
+
+func (service *tagService) Update(ctx context.Context, tag model.Tag) model.Tag {
+	tag.OwnerID = service.GetById(ctx, tag.ID).OwnerID
+	service.validate(ctx, &tag)
+	defer service.evictTagged(ctx)
+	return service.repository.Update(ctx, []model.Tag{tag})[0]
+}
+
+// DeleteById deletes the tag, the tagged assets and cash flows are kept
+func (service *tagService) DeleteById(ctx context.Context, id uuid.UUID) {
+	service.GetById(ctx, id)
+	defer service.evictTagged(ctx)
+	service.repository.DeleteWithLinks(ctx, id)
+}
+
+// validate checks the tag name is unique among the tags of the owner ignoring case
+func (service *tagService) validate(ctx context.Context, tag *model.Tag) {
+	if !tag.IsValid() {
+		panic(commonError.IllegalArgumentError)
+	}
+	tag.Name = strings.TrimSpace(tag.Name)
+	for _, existing := range service.repository.FindByName(ctx, tag.OwnerID, tag.Name) {
+		if existing.ID != tag.ID {
+			panic(commonError.AlreadyExists)
+		}
+	}
+}
+
+// checkOwnTags panics unless all the tags belong to the current user, the user id is returned
+func checkOwnTags(ctx context.Context, tagRepository repository.TagRepository, tagIds []uuid.UUID) uuid.UUID {
+	userId := commonUtil.MustGetCurrentTokenInfo(ctx).UserId
+	tagIds = commonUtil.Unique(tagIds)
+	if len(tagIds) == 0 {
+		return userId
+	}
+	tags := tagRepository.GetById(ctx, tagIds)
+	if len(tags) != len(tagIds) {
+		panic(commonError.NotFoundError)
+	}
+	for _, tag := range tags {
+		if tag.OwnerID != userId {
+			panic(commonError.NotEnoughRightsError)
+		}
+	}
+	return userId
+}
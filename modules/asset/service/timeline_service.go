@@ -0,0 +1,147 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonError "assets/common/custom_error"
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"assets/modules/asset/model"
+	"assets/modules/asset/repository"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"sort"
+)
+
+var timelineSrv TimelineService
+
+type TimelineService interface {
+	GetTimeline(ctx context.Context, assetId uuid.UUID, filter model.TimelineFilter, page commonModel.Pageable) commonModel.Page[model.TimelineEvent]
+	RegisterSource(source TimelineSource)
+}
+
+// TimelineSource finds events of one type for the asset, sources of entities kept outside
+// the asset module are registered by their modules
+type TimelineSource interface {
+	Type() string
+	Collect(ctx context.Context, asset model.Asset) []model.TimelineEvent
+}
+
+type timelineService struct {
+	assetService AssetService
+	sources      []TimelineSource
+}
+
+func GetTimelineService() TimelineService {
+	if timelineSrv != nil {
+		return timelineSrv
+	}
+
+	timelineSrv = &timelineService{
+		assetService: GetAssetService(),
+		sources: []TimelineSource{
+			&cashFlowTimelineSource{},
+			&fieldEditTimelineSource{changeRepository: repository.GetAssetChangeRepository()},
+		},
+	}
+
+	return timelineSrv
+}
+
+// GetTimeline merges the events of all the sources, the latest go first unless ascending sort is requested
+func (service *timelineService) GetTimeline(ctx context.Context, assetId uuid.UUID, filter model.TimelineFilter, page commonModel.Pageable) commonModel.Page[model.TimelineEvent] {
+	for _, eventType := range filter.Types {
+		if !commonUtil.ArrayContains(model.TimelineEventTypes, eventType) {
+			panic(commonError.IllegalArgumentError)
+		}
+	}
+	asset := service.assetService.GetById(ctx, assetId)
+
+	events := make([]model.TimelineEvent, 0)
+	for _, source := range service.sources {
+		if filter.Includes(source.Type()) {
+			events = append(events, source.Collect(ctx, asset)...)
+		}
+	}
+
+	ascending := page.Sort != nil && page.Sort.Order == commonModel.Asc
+	sort.SliceStable(events, func(i, j int) bool {
+		if ascending {
+			return events[i].Date.Before(events[j].Date)
+		}
+		return events[i].Date.After(events[j].Date)
+	})
+
+	from := min(page.Page*page.Size, len(events))
+	to := min(from+page.Size, len(events))
+	return commonModel.NewPage[model.TimelineEvent](events[from:to], page).WithTotal(len(events))
+}
+
+func (service *timelineService) RegisterSource(source TimelineSource) {
+	service.sources = append(service.sources, source)
+}
+
+type cashFlowTimelineSource struct{}
+
+func (source *cashFlowTimelineSource) Type() string {
+	return model.CashFlowTimelineEvent
+}
+
+// Collect returns the dated incomes and expenses of the asset, lease payments come as cash flows of their type as well
+func (source *cashFlowTimelineSource) Collect(ctx context.Context, asset model.Asset) []model.TimelineEvent {
+	var result []model.TimelineEvent
+	collect := func(cashFlows []*model.CashFlow, kind string) {
+		for _, cashFlow := range cashFlows {
+			if cashFlow.Date == nil {
+				continue
+			}
+			summary := fmt.Sprintf("%s %s %v %s", kind, cashFlow.Type, cashFlow.Amount, cashFlow.Currency)
+			result = append(result, model.NewTimelineEvent(model.CashFlowTimelineEvent, *cashFlow.Date, cashFlow.ID, summary, cashFlow).
+				WithUser(cashFlow.CreatedBy))
+		}
+	}
+	collect(asset.Incomes, "Income")
+	collect(asset.Expenses, "Expense")
+	return result
+}
+
+type fieldEditTimelineSource struct {
+	changeRepository repository.AssetChangeRepository
+}
+
+func (source *fieldEditTimelineSource) Type() string {
+	return model.FieldEditTimelineEvent
+}
+
+func (source *fieldEditTimelineSource) Collect(ctx context.Context, asset model.Asset) []model.TimelineEvent {
+	changes := source.changeRepository.FindByAssetId(ctx, asset.ID)
+	return commonUtil.Map(changes, func(it model.AssetChange) model.TimelineEvent {
+		summary := fmt.Sprintf("%s changed from %q to %q", it.Field, it.OldValue, it.NewValue)
+		event := model.NewTimelineEvent(model.FieldEditTimelineEvent, *it.Date, it.ID, summary, it)
+		event.UserID = it.UserID
+		return event
+	})
+}
@@ -25,19 +25,23 @@ package model
  */
 
 import (
+	commonModel "assets/common/model"
 	commonUtil "assets/common/util"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"time"
 )
 
+// Attachment is the uploaded file, only the user who uploaded it may file it to the entities
 type Attachment struct {
 	ID         uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
 	CreateDate *time.Time `json:"createDate,omitempty"`
+	CreatedBy  uuid.UUID  `json:"createdBy,omitempty" gorm:"type:uuid"`
 	FileName   string     `json:"fileName,omitempty"`
 	MimeType   string     `json:"mimeType,omitempty"`
 	Size       int64      `json:"size,omitempty"`
 	Path       string     `json:"path,omitempty"`
+	commonModel.Versioned
 }
 
 func (attachment Attachment) GetID() uuid.UUID {
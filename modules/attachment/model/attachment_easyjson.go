@@ -158,6 +158,10 @@ func easyjson76362c5bDecodeAssetsModulesAttachmentModel1(in *jlexer.Lexer, out *
 					in.AddError((*out.CreateDate).UnmarshalJSON(data))
 				}
 			}
+		case "createdBy":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.CreatedBy).UnmarshalText(data))
+			}
 		case "fileName":
 			out.FileName = string(in.String())
 		case "mimeType":
@@ -166,6 +170,8 @@ func easyjson76362c5bDecodeAssetsModulesAttachmentModel1(in *jlexer.Lexer, out *
 			out.Size = int64(in.Int64())
 		case "path":
 			out.Path = string(in.String())
+		case "version":
+			out.Version = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -196,6 +202,16 @@ func easyjson76362c5bEncodeAssetsModulesAttachmentModel1(out *jwriter.Writer, in
 		}
 		out.Raw((*in.CreateDate).MarshalJSON())
 	}
+	if true {
+		const prefix string = ",\"createdBy\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.CreatedBy).MarshalText())
+	}
 	if in.FileName != "" {
 		const prefix string = ",\"fileName\":"
 		if first {
@@ -236,6 +252,16 @@ func easyjson76362c5bEncodeAssetsModulesAttachmentModel1(out *jwriter.Writer, in
 		}
 		out.String(string(in.Path))
 	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
 	out.RawByte('}')
 }
 
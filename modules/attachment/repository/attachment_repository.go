@@ -27,13 +27,16 @@ package repository
 import (
 	commonDB "assets/common/db"
 	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
 	"assets/modules/attachment/model"
+	"context"
 )
 
 var attachmentRepo AttachmentRepository
 
 type AttachmentRepository interface {
 	commonRepository.Repository[model.Attachment]
+	CountByPath(ctx context.Context, path string) int64
 }
 
 type attachmentRepository struct {
@@ -51,3 +54,9 @@ func GetAttachmentRepository() AttachmentRepository {
 	}
 	return attachmentRepo
 }
+
+func (repo *attachmentRepository) CountByPath(ctx context.Context, path string) int64 {
+	var count int64
+	commonUtil.Must(repo.DataSource.Model(&model.Attachment{}).Where("path = ?", path).Count(&count).Error)
+	return count
+}
@@ -26,6 +26,7 @@ package service
 
 import (
 	"assets/common/config"
+	commonError "assets/common/custom_error"
 	commonModel "assets/common/model"
 	commonUtil "assets/common/util"
 	"assets/modules/attachment/model"
@@ -34,6 +35,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 	"io"
 	"mime/multipart"
 	"os"
@@ -47,11 +49,18 @@ type AttachmentService interface {
 	GetAllMeta(ctx context.Context, page commonModel.Pageable, filter model.AttachmentFilter, fields string) commonModel.Page[model.Attachment]
 	Create(ctx context.Context, fileHeader *multipart.FileHeader) model.Attachment
 	DeleteById(ctx context.Context, id uuid.UUID)
+	GetLinkable(ctx context.Context, ids []uuid.UUID) []model.Attachment
+	DeleteUnreferenced(ctx context.Context, ids []uuid.UUID)
+	AddReferenceCounter(counter ReferenceCounter)
 }
 
+// ReferenceCounter counts the entities of a module the attachment is filed to
+type ReferenceCounter func(ctx context.Context, attachmentId uuid.UUID) int64
+
 type attachmentService struct {
 	repository        repository.AttachmentRepository
 	s3UploadListeners []func(context context.Context, attachment model.Attachment)
+	referenceCounters []ReferenceCounter
 }
 
 func GetAttachmentService() AttachmentService {
@@ -84,6 +93,9 @@ func (service *attachmentService) Create(ctx context.Context, fileHeader *multip
 	defer commonUtil.CloseChecker(file)
 	contentType := util.DetectContentType(fileHeader.Filename)
 	attachment := model.NewAttachment(fileHeader.Filename, contentType, fileHeader.Size)
+	if tokenInfo, ok := commonUtil.GetCurrentTokenInfo(ctx); ok {
+		attachment.CreatedBy = tokenInfo.UserId
+	}
 
 	ginCtx := commonUtil.ConvertContext(ctx)
 	dir := fmt.Sprintf("%s/%s", config.CoreConfig.Attachment.UploadPath, attachment.CreateDate.Format("2006-01-02"))
@@ -94,6 +106,65 @@ func (service *attachmentService) Create(ctx context.Context, fileHeader *multip
 	return service.repository.Create(ctx, []model.Attachment{attachment})[0]
 }
 
+// DeleteById deletes the attachment with its uploaded file unless another attachment refers to the same file
 func (service *attachmentService) DeleteById(ctx context.Context, id uuid.UUID) {
+	attachments := service.repository.GetById(ctx, []uuid.UUID{id})
 	service.repository.DeleteById(ctx, id)
+	for _, attachment := range attachments {
+		if service.repository.CountByPath(ctx, attachment.Path) != 0 {
+			continue
+		}
+		if err := os.Remove(attachment.Path); err != nil && !os.IsNotExist(err) {
+			log.WithContext(ctx).WithError(err).Warnf("AttachmentService: couldn't remove file %s", attachment.Path)
+		}
+	}
+}
+
+// GetLinkable returns the attachments the current user may file to the entities, that is the ones the user
+// uploaded. The attachments uploaded before the uploader was recorded can't be filed anew
+func (service *attachmentService) GetLinkable(ctx context.Context, ids []uuid.UUID) []model.Attachment {
+	ids = commonUtil.Unique(ids)
+	attachments := service.repository.GetById(ctx, ids)
+	if len(attachments) != len(ids) {
+		panic(commonError.NotFoundError)
+	}
+	userId := commonUtil.MustGetCurrentTokenInfo(ctx).UserId
+	for _, attachment := range attachments {
+		if attachment.CreatedBy == uuid.Nil || attachment.CreatedBy != userId {
+			panic(commonError.NotEnoughRightsError)
+		}
+	}
+	return attachments
+}
+
+// DeleteUnreferenced deletes the attachments which are not filed to any entity. Failures are only logged,
+// since the entities referring to the attachments are deleted already
+func (service *attachmentService) DeleteUnreferenced(ctx context.Context, ids []uuid.UUID) {
+	for _, id := range commonUtil.Unique(ids) {
+		if service.countReferences(ctx, id) != 0 {
+			continue
+		}
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					log.WithContext(ctx).Warnf("AttachmentService: couldn't delete attachment %s: %v", id, err)
+				}
+			}()
+			service.DeleteById(ctx, id)
+		}()
+	}
+}
+
+// AddReferenceCounter registers the count of the entities of a module referring to the attachments,
+// the attachment is deleted by DeleteUnreferenced only when no module refers to it
+func (service *attachmentService) AddReferenceCounter(counter ReferenceCounter) {
+	service.referenceCounters = append(service.referenceCounters, counter)
+}
+
+func (service *attachmentService) countReferences(ctx context.Context, id uuid.UUID) int64 {
+	var result int64
+	for _, counter := range service.referenceCounters {
+		result += counter(ctx, id)
+	}
+	return result
 }
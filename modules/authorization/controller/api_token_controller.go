@@ -0,0 +1,214 @@
+package controller
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonController "assets/common/controller"
+	commonMiddleware "assets/common/middleware"
+	commonResolver "assets/common/resolver"
+	"assets/modules/authorization/model"
+	"assets/modules/authorization/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+var apiTokenCntr commonController.HttpController
+
+type apiTokenController struct {
+	service service.ApiTokenService
+}
+
+func GetApiTokenController() commonController.HttpController {
+	if apiTokenCntr != nil {
+		return apiTokenCntr
+	}
+	apiTokenCntr = &apiTokenController{
+		service: service.GetApiTokenService(),
+	}
+	return apiTokenCntr
+}
+
+func (controller *apiTokenController) RegisterHttpController(router *gin.Engine) {
+	tokenRouter := router.Group("/api/authorization/users/current/tokens")
+
+	tokenRouter.GET(
+		"",
+		commonMiddleware.SessionSecurityHandler,
+		controller.getPersonalTokens,
+	)
+
+	tokenRouter.POST(
+		"",
+		commonMiddleware.SessionSecurityHandler,
+		commonResolver.Resolver[model.ApiToken],
+		controller.createPersonalToken,
+	)
+
+	tokenRouter.DELETE(
+		"/:id",
+		commonMiddleware.SessionSecurityHandler,
+		controller.deletePersonalToken,
+	)
+
+	apiKeyRouter := router.Group("/api/authorization/api-keys")
+
+	apiKeyRouter.GET(
+		"",
+		commonMiddleware.SessionSecurityHandler,
+		commonMiddleware.HasAnyAuthorities("READ_API_KEY"),
+		controller.getApiKeys,
+	)
+
+	apiKeyRouter.POST(
+		"",
+		commonMiddleware.SessionSecurityHandler,
+		commonMiddleware.HasAnyAuthorities("EDIT_API_KEY"),
+		commonResolver.Resolver[model.ApiToken],
+		controller.createApiKey,
+	)
+
+	apiKeyRouter.DELETE(
+		"/:id",
+		commonMiddleware.SessionSecurityHandler,
+		commonMiddleware.HasAnyAuthorities("EDIT_API_KEY"),
+		controller.deleteApiKey,
+	)
+}
+
+// apiTokenController godoc
+// @Security BearerAuth
+// @Summary      getPersonalTokens
+// @Description  Get personal access tokens of the current user, the tokens themselves are never returned again
+// @Tags         Api token controller
+// @Produce      json
+// @Success      200	{array}  model.ApiToken
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/users/current/tokens [GET]
+func (controller *apiTokenController) getPersonalTokens(ctx *gin.Context) {
+	log.WithContext(ctx).Info("ApiTokenController: GetPersonalTokens(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetPersonalTokens(ctx))
+	log.WithContext(ctx).Info("ApiTokenController: GetPersonalTokens(): End")
+}
+
+// apiTokenController godoc
+// @Security BearerAuth
+// @Summary      createPersonalToken
+// @Description  Issue personal access token of the current user scoped to the authorities of the user.
+// @Description  The token is sent in the Authorization header as "Token <token>" and is returned only in this response
+// @Tags         Api token controller
+// @Accept       json
+// @Produce      json
+// @Param        request	body	  model.ApiToken  true  "Name, authorities and expire date of token"
+// @Success      200	{object}  model.ApiToken
+// @Failure      400
+// @Failure      403
+// @Failure      500
+// @Router       /api/authorization/users/current/tokens [POST]
+func (controller *apiTokenController) createPersonalToken(ctx *gin.Context) {
+	log.WithContext(ctx).Info("ApiTokenController: CreatePersonalToken(): Start")
+	token := ctx.MustGet("RequestBody").(model.ApiToken)
+	ctx.Header("Cache-Control", "no-store")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.CreatePersonalToken(ctx, token))
+	log.WithContext(ctx).Info("ApiTokenController: CreatePersonalToken(): End")
+}
+
+// apiTokenController godoc
+// @Security BearerAuth
+// @Summary      deletePersonalToken
+// @Description  Revoke personal access token of the current user
+// @Tags         Api token controller
+// @Produce      json
+// @Param        id		path     string  true  "ApiToken.ID"
+// @Success      200
+// @Failure      404
+// @Failure      500
+// @Router       /api/authorization/users/current/tokens/{id} [DELETE]
+func (controller *apiTokenController) deletePersonalToken(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("ApiTokenController: DeletePersonalToken(id: %s): Start", id)
+	controller.service.DeletePersonalToken(ctx, id)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("ApiTokenController: DeletePersonalToken(): End")
+}
+
+// apiTokenController godoc
+// @Security BearerAuth
+// @Summary      getApiKeys
+// @Description  Get api keys of all service accounts, the keys themselves are never returned again
+// @Tags         Api token controller
+// @Produce      json
+// @Success      200	{array}  model.ApiToken
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/api-keys [GET]
+func (controller *apiTokenController) getApiKeys(ctx *gin.Context) {
+	log.WithContext(ctx).Info("ApiTokenController: GetApiKeys(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetApiKeys(ctx))
+	log.WithContext(ctx).Info("ApiTokenController: GetApiKeys(): End")
+}
+
+// apiTokenController godoc
+// @Security BearerAuth
+// @Summary      createApiKey
+// @Description  Issue api key of the service account scoped to the authorities of the account, the key without
+// @Description  the expire date never expires. The key is sent in the Authorization header as "Token <key>" and is returned only in this response
+// @Tags         Api token controller
+// @Accept       json
+// @Produce      json
+// @Param        request	body	  model.ApiToken  true  "Name, service account, authorities and expire date of key"
+// @Success      200	{object}  model.ApiToken
+// @Failure      400
+// @Failure      403
+// @Failure      500
+// @Router       /api/authorization/api-keys [POST]
+func (controller *apiTokenController) createApiKey(ctx *gin.Context) {
+	log.WithContext(ctx).Info("ApiTokenController: CreateApiKey(): Start")
+	token := ctx.MustGet("RequestBody").(model.ApiToken)
+	ctx.Header("Cache-Control", "no-store")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.CreateApiKey(ctx, token))
+	log.WithContext(ctx).Info("ApiTokenController: CreateApiKey(): End")
+}
+
+// apiTokenController godoc
+// @Security BearerAuth
+// @Summary      deleteApiKey
+// @Description  Revoke api key of the service account
+// @Tags         Api token controller
+// @Produce      json
+// @Param        id		path     string  true  "ApiToken.ID"
+// @Success      200
+// @Failure      404
+// @Failure      500
+// @Router       /api/authorization/api-keys/{id} [DELETE]
+func (controller *apiTokenController) deleteApiKey(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("ApiTokenController: DeleteApiKey(id: %s): Start", id)
+	controller.service.DeleteApiKey(ctx, id)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("ApiTokenController: DeleteApiKey(): End")
+}
@@ -77,13 +77,23 @@ func (controller *authorityController) RegisterHttpController(router *gin.Engine
 	authorityRouter.PUT(
 		"/:id",
 		commonMiddleware.HasAnyAuthorities("UPDATE_AUTHORITY"),
-		commonResolver.Resolver[model.Authority],
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.UpdateResolver[model.Authority],
+		controller.update,
+	)
+
+	authorityRouter.PATCH(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("UPDATE_AUTHORITY"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.PatchResolver(controller.service.GetById),
 		controller.update,
 	)
 
 	authorityRouter.DELETE(
 		"/:id",
 		commonMiddleware.HasAnyAuthorities("DELETE_AUTHORITY"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
 		controller.deleteById,
 	)
 }
@@ -103,7 +113,7 @@ func (controller *authorityController) RegisterHttpController(router *gin.Engine
 func (controller *authorityController) getById(ctx *gin.Context) {
 	id := uuid.MustParse(ctx.Param("id"))
 	log.WithContext(ctx).Infof("AuthorityController: GetById(id: %s): Start", id)
-	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetById(ctx, id))
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.GetById(ctx, id))
 	log.WithContext(ctx).Info("AuthorityController: GetById(): End")
 }
 
@@ -140,7 +150,7 @@ func (controller *authorityController) getAll(ctx *gin.Context) {
 func (controller *authorityController) create(ctx *gin.Context) {
 	log.WithContext(ctx).Info("AuthorityController: Create(): Start")
 	authority := ctx.MustGet("RequestBody").(model.Authority)
-	ctx.AbortWithStatusJSON(http.StatusCreated, controller.service.Create(ctx, authority))
+	commonUtil.AbortWithEntity(ctx, http.StatusCreated, controller.service.Create(ctx, authority))
 	log.WithContext(ctx).Info("AuthorityController: Create(): End")
 }
 
@@ -156,10 +166,11 @@ func (controller *authorityController) create(ctx *gin.Context) {
 // @Failure      400
 // @Failure      500
 // @Router       /api/authorization/authorities/{id} [PUT]
+// @Router       /api/authorization/authorities/{id} [PATCH]
 func (controller *authorityController) update(ctx *gin.Context) {
 	log.WithContext(ctx).Info("AuthorityController: Update(): Start")
 	authority := ctx.MustGet("RequestBody").(model.Authority)
-	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.Update(ctx, authority))
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Update(ctx, authority))
 	log.WithContext(ctx).Info("AuthorityController: Update(): End")
 }
 
@@ -27,43 +27,74 @@ package controller
 import (
 	commonController "assets/common/controller"
 	commonError "assets/common/custom_error"
+	commonMiddleware "assets/common/middleware"
 	"assets/modules/authorization/model"
 	"assets/modules/authorization/service"
+	"crypto/subtle"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	log "github.com/sirupsen/logrus"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 )
 
+const (
+	federationStateCookie = "federation_state"
+	// externalLoginStateMaxAge is the time in seconds the user has to sign in at the identity provider
+	externalLoginStateMaxAge = 600
+)
+
 var authorizationCntr commonController.HttpController
 
 type authorizationController struct {
-	service service.AuthorizationService
+	service           service.AuthorizationService
+	signingKeyService service.SigningKeyService
+	clientService     service.OAuthClientService
+	openIdService     service.OpenIdService
+	sessionService    service.SessionService
 }
 
 func GetAuthorizationController() commonController.HttpController {
 	if authorizationCntr != nil {
 		return authorizationCntr
 	}
-	authorizationCntr = &authorizationController{service: service.GetAuthorizationService()}
+	authorizationCntr = &authorizationController{
+		service:           service.GetAuthorizationService(),
+		signingKeyService: service.GetSigningKeyService(),
+		clientService:     service.GetOAuthClientService(),
+		openIdService:     service.GetOpenIdService(),
+		sessionService:    service.GetSessionService(),
+	}
 	return authorizationCntr
 }
 
 func (controller *authorizationController) RegisterHttpController(router *gin.Engine) {
 	authorizationRouter := router.Group("/api/authorization")
 	authorizationRouter.POST("/oauth/token", controller.authorize)
+	authorizationRouter.POST("/oauth/revoke", controller.revoke)
+	authorizationRouter.POST("/logout", commonMiddleware.SessionSecurityHandler, controller.logout)
+	authorizationRouter.GET("/federation", controller.getIdentityProviders)
+	authorizationRouter.GET("/federation/:provider/login", controller.externalLogin)
+	authorizationRouter.GET("/federation/:provider/callback", controller.externalLoginCallback)
+
+	router.GET("/.well-known/jwks.json", controller.getJwks)
 }
 
 // authorizationController godoc
 // @Summary      authorize
-// @Description  Authorize
+// @Description  Authorize, the OAuth clients authenticate by HTTP Basic or by client_id and client_secret of the form.
+// @Description  The password grant returns mfa_token when the second factor is needed, it is exchanged by the mfa_otp grant with mfa_token and otp
+// @Description  The password grant returns password_change_required when the password has to be changed, the grant is repeated with new_password.
+// @Description  The failed password and mfa_otp attempts are delayed by 429 and lock the account by 423 for the time of Retry-After header
 // @Tags         Authorization controller
 // @Accept       x-www-form-urlencoded
 // @Produce      json
-// @Param        request	body	string	true	"Authorize request grant_type=enum(password, refresh_token)&username=test&password=test"
+// @Param        request	body	string	true	"Authorize request grant_type=enum(password, mfa_otp, refresh_token, authorization_code, client_credentials)&username=test&password=test"
 // @Success      200		{object}  model.AuthorizationResponse
+// @Failure      423
+// @Failure      429
 // @Failure      500
 // @Router       /api/authorization/oauth/token [POST]
 func (controller *authorizationController) authorize(ctx *gin.Context) {
@@ -73,21 +104,208 @@ func (controller *authorizationController) authorize(ctx *gin.Context) {
 
 	grantType := ctx.PostForm("grant_type")
 	switch grantType {
-	case "password":
+	case model.PasswordGrant:
 		username := strings.ToLower(ctx.PostForm("username"))
 		password := ctx.PostForm("password")
-		response = controller.service.GenerateToken(ctx, username, password)
+		response = controller.service.GenerateToken(ctx, username, password, ctx.PostForm("new_password"), newLoginSource(ctx))
 
-	case "refresh_token":
-		refreshToken := ctx.PostForm("refresh_token")
-		response = controller.service.RefreshToken(ctx, refreshToken)
+	case model.MfaOtpGrant:
+		response = controller.service.VerifyMfa(ctx, ctx.PostForm("mfa_token"), ctx.PostForm("otp"), newLoginSource(ctx))
+
+	case model.RefreshTokenGrant:
+		response = controller.service.RefreshToken(ctx, ctx.PostForm("refresh_token"), controller.authenticateClient(ctx))
+
+	case model.AuthorizationCodeGrant:
+		client := controller.authenticateClient(ctx)
+		if client == nil {
+			panic(commonError.InvalidClientError)
+		}
+		response = controller.openIdService.ExchangeCode(ctx, *client, ctx.PostForm("code"), ctx.PostForm("redirect_uri"), ctx.PostForm("code_verifier"))
+
+	case model.ClientCredentialsGrant:
+		client := controller.authenticateClient(ctx)
+		if client == nil {
+			panic(commonError.InvalidClientError)
+		}
+		response = controller.openIdService.ClientCredentials(ctx, *client, ctx.PostForm("scope"))
 
 	default:
 		panic(commonError.UnknownGrantTypeError)
 	}
 
-	ctx.Header("Set-Cookie", fmt.Sprintf("access_token=%s; Expires=%s; Path=/; HttpOnly", response.AccessToken, time.Unix(response.ExpiresAt, 0).UTC().Format(time.RFC1123)))
+	if response.AccessToken != "" {
+		controller.sessionService.Track(ctx, response.TokenInfo, newLoginSource(ctx))
+	}
+	// the tokens of the OAuth clients are kept by the clients, they never authorize the browser
+	if response.ClientId == "" && response.AccessToken != "" {
+		setAccessTokenCookie(ctx, response)
+	} else {
+		ctx.Header("Cache-Control", "no-store")
+	}
 	ctx.AbortWithStatusJSON(http.StatusOK, response)
 
 	log.WithContext(ctx).Info("AuthorizationController: authorize(): End")
 }
+
+// authorizationController godoc
+// @Summary      revoke
+// @Description  Revoke access or refresh token, revoking refresh token revokes its access token as well (RFC 7009)
+// @Tags         Authorization controller
+// @Accept       x-www-form-urlencoded
+// @Produce      json
+// @Param        request	body	string	true	"Revoke request token=...&token_type_hint=enum(access_token, refresh_token)"
+// @Success      200
+// @Failure      500
+// @Router       /api/authorization/oauth/revoke [POST]
+func (controller *authorizationController) revoke(ctx *gin.Context) {
+	log.WithContext(ctx).Info("AuthorizationController: revoke(): Start")
+	controller.service.RevokeToken(ctx, ctx.PostForm("token"))
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("AuthorizationController: revoke(): End")
+}
+
+// authorizationController godoc
+// @Security BearerAuth
+// @Summary      logout
+// @Description  Revoke all tokens of the current session
+// @Tags         Authorization controller
+// @Produce      json
+// @Success      200
+// @Failure      500
+// @Router       /api/authorization/logout [POST]
+func (controller *authorizationController) logout(ctx *gin.Context) {
+	log.WithContext(ctx).Info("AuthorizationController: logout(): Start")
+	controller.service.Logout(ctx)
+	ctx.Writer.Header().Add("Set-Cookie", fmt.Sprintf("access_token=; Expires=%s; Path=/; HttpOnly", time.Unix(0, 0).UTC().Format(time.RFC1123)))
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("AuthorizationController: logout(): End")
+}
+
+// authorizationController godoc
+// @Summary      getIdentityProviders
+// @Description  Get names of the external identity providers the users may sign in with
+// @Tags         Authorization controller
+// @Produce      json
+// @Success      200		{array}  string
+// @Failure      500
+// @Router       /api/authorization/federation [GET]
+func (controller *authorizationController) getIdentityProviders(ctx *gin.Context) {
+	log.WithContext(ctx).Info("AuthorizationController: getIdentityProviders(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetIdentityProviders(ctx))
+	log.WithContext(ctx).Info("AuthorizationController: getIdentityProviders(): End")
+}
+
+// authorizationController godoc
+// @Summary      externalLogin
+// @Description  Redirect to the external identity provider to sign in
+// @Tags         Authorization controller
+// @Param        provider		path	string	true	"Name of identity provider"
+// @Param        redirect_uri	query	string	false	"Local path the user is redirected to after signing in"
+// @Success      302
+// @Failure      404
+// @Failure      500
+// @Router       /api/authorization/federation/{provider}/login [GET]
+func (controller *authorizationController) externalLogin(ctx *gin.Context) {
+	provider := ctx.Param("provider")
+	log.WithContext(ctx).Infof("AuthorizationController: externalLogin(provider: %s): Start", provider)
+	authorizationUrl, state := controller.service.StartExternalLogin(ctx, provider, ctx.Query("redirect_uri"))
+	// the state is bound to the browser, so the login started by other sites can't be finished in it
+	setFederationStateCookie(ctx, provider, state, externalLoginStateMaxAge)
+	ctx.Redirect(http.StatusFound, authorizationUrl)
+	ctx.Abort()
+	log.WithContext(ctx).Info("AuthorizationController: externalLogin(): End")
+}
+
+// authorizationController godoc
+// @Summary      externalLoginCallback
+// @Description  Callback of the external identity provider. Sets the access token cookie and redirects to the local path
+// @Description  of the login, the tokens are returned in the body when the login has no path. When the second factor is needed
+// @Description  the mfa_token is returned instead, it is passed in the fragment of the local path
+// @Tags         Authorization controller
+// @Produce      json
+// @Param        provider	path	string	true	"Name of identity provider"
+// @Param        code		query	string	true	"Authorization code"
+// @Param        state		query	string	true	"State of the login"
+// @Success      200		{object}  model.AuthorizationResponse
+// @Success      302
+// @Failure      401
+// @Failure      403
+// @Failure      500
+// @Router       /api/authorization/federation/{provider}/callback [GET]
+func (controller *authorizationController) externalLoginCallback(ctx *gin.Context) {
+	provider := ctx.Param("provider")
+	log.WithContext(ctx).Infof("AuthorizationController: externalLoginCallback(provider: %s): Start", provider)
+	if errorCode := ctx.Query("error"); errorCode != "" {
+		log.WithContext(ctx).Warnf("AuthorizationController: identity provider %s rejected login: %s", provider, errorCode)
+		panic(commonError.ExternalLoginFailedError)
+	}
+
+	state, err := ctx.Cookie(federationStateCookie)
+	setFederationStateCookie(ctx, provider, "", -1)
+	if err != nil || state == "" || subtle.ConstantTimeCompare([]byte(state), []byte(ctx.Query("state"))) != 1 {
+		panic(commonError.ExternalLoginFailedError)
+	}
+
+	response, redirectPath := controller.service.FinishExternalLogin(ctx, provider, ctx.Query("code"), state)
+	if response.MfaToken == "" {
+		controller.sessionService.Track(ctx, response.TokenInfo, newLoginSource(ctx))
+		setAccessTokenCookie(ctx, response)
+	} else if redirectPath != "" {
+		// the fragment isn't sent to the servers, so the MFA token doesn't get to their logs
+		params := url.Values{"mfa_token": {response.MfaToken}}
+		if response.MfaEnrolmentRequired {
+			params.Set("mfa_enrolment_required", "true")
+		}
+		redirectPath += "#" + params.Encode()
+	}
+	if redirectPath != "" {
+		ctx.Redirect(http.StatusFound, redirectPath)
+		ctx.Abort()
+	} else {
+		ctx.AbortWithStatusJSON(http.StatusOK, response)
+	}
+	log.WithContext(ctx).Info("AuthorizationController: externalLoginCallback(): End")
+}
+
+// authorizationController godoc
+// @Summary      getJwks
+// @Description  Get public keys verifying the tokens (RFC 7517), the set is empty when tokens are signed by the shared key
+// @Tags         Authorization controller
+// @Produce      json
+// @Success      200		{object}  model.JwkSet
+// @Failure      500
+// @Router       /.well-known/jwks.json [GET]
+func (controller *authorizationController) getJwks(ctx *gin.Context) {
+	log.WithContext(ctx).Info("AuthorizationController: getJwks(): Start")
+	ctx.Header("Cache-Control", "public, max-age=300")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.signingKeyService.GetJwks(ctx))
+	log.WithContext(ctx).Info("AuthorizationController: getJwks(): End")
+}
+
+// setAccessTokenCookie adds the cookie, so it doesn't replace the other cookies of the response
+func setAccessTokenCookie(ctx *gin.Context, response model.AuthorizationResponse) {
+	ctx.Writer.Header().Add("Set-Cookie", fmt.Sprintf("access_token=%s; Expires=%s; Path=/; HttpOnly", response.AccessToken, time.Unix(response.ExpiresAt, 0).UTC().Format(time.RFC1123)))
+}
+
+func setFederationStateCookie(ctx *gin.Context, provider string, state string, maxAge int) {
+	ctx.Writer.Header().Add("Set-Cookie", fmt.Sprintf("%s=%s; Max-Age=%d; Path=/api/authorization/federation/%s/; HttpOnly; SameSite=Lax",
+		federationStateCookie, state, maxAge, url.PathEscape(provider)))
+}
+
+func newLoginSource(ctx *gin.Context) model.LoginSource {
+	return model.LoginSource{Ip: ctx.ClientIP(), UserAgent: ctx.Request.UserAgent()}
+}
+
+// authenticateClient returns the OAuth client authenticated by HTTP Basic or by the form, it is nil when
+// the request has no client credentials
+func (controller *authorizationController) authenticateClient(ctx *gin.Context) *model.OAuthClient {
+	clientId, secret, ok := ctx.Request.BasicAuth()
+	if !ok {
+		clientId, secret = ctx.PostForm("client_id"), ctx.PostForm("client_secret")
+	}
+	if clientId == "" {
+		return nil
+	}
+	client := controller.clientService.Authenticate(ctx, clientId, secret)
+	return &client
+}
@@ -0,0 +1,107 @@
+package controller
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonController "assets/common/controller"
+	commonMiddleware "assets/common/middleware"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+var loginProtectionCntr commonController.HttpController
+
+type loginProtectionController struct {
+	service service.LoginProtectionService
+}
+
+func GetLoginProtectionController() commonController.HttpController {
+	if loginProtectionCntr != nil {
+		return loginProtectionCntr
+	}
+	loginProtectionCntr = &loginProtectionController{
+		service: service.GetLoginProtectionService(),
+	}
+	return loginProtectionCntr
+}
+
+func (controller *loginProtectionController) RegisterHttpController(router *gin.Engine) {
+	router.GET(
+		"/api/authorization/login-events",
+		commonMiddleware.SecurityHandler,
+		commonMiddleware.HasAnyAuthorities("READ_LOGIN_EVENT"),
+		commonMiddleware.PaginationHandler,
+		controller.getEvents,
+	)
+
+	router.DELETE(
+		"/api/authorization/users/:id/lockout",
+		commonMiddleware.SecurityHandler,
+		commonMiddleware.HasAnyAuthorities("UPDATE_USER"),
+		controller.unlock,
+	)
+}
+
+// loginProtectionController godoc
+// @Security BearerAuth
+// @Summary      getEvents
+// @Description  Get audit of the logins and of the account locks, the latest events first
+// @Tags         Login protection controller
+// @Produce      json
+// @Param        username	query	string	false	"Username"
+// @Success      200	{array}  model.LoginEvent
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/login-events [GET]
+func (controller *loginProtectionController) getEvents(ctx *gin.Context) {
+	page := commonUtil.MustGetPageable(ctx)
+	username := ctx.Query("username")
+	log.WithContext(ctx).Infof("LoginProtectionController: GetEvents(username: %s): Start", username)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetEvents(ctx, username, page))
+	log.WithContext(ctx).Info("LoginProtectionController: GetEvents(): End")
+}
+
+// loginProtectionController godoc
+// @Security BearerAuth
+// @Summary      unlock
+// @Description  Unlock the account locked after the failed logins and forget its failures
+// @Tags         Login protection controller
+// @Produce      json
+// @Param        id		path     string  true  "User.ID"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/users/{id}/lockout [DELETE]
+func (controller *loginProtectionController) unlock(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("LoginProtectionController: Unlock(id: %s): Start", id)
+	controller.service.Unlock(ctx, id, newLoginSource(ctx))
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("LoginProtectionController: Unlock(): End")
+}
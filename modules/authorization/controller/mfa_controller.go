@@ -0,0 +1,212 @@
+package controller
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonController "assets/common/controller"
+	commonMiddleware "assets/common/middleware"
+	commonResolver "assets/common/resolver"
+	"assets/modules/authorization/model"
+	"assets/modules/authorization/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+var mfaCntr commonController.HttpController
+
+type mfaController struct {
+	service     service.MfaService
+	userService service.UserService
+}
+
+func GetMfaController() commonController.HttpController {
+	if mfaCntr != nil {
+		return mfaCntr
+	}
+	mfaCntr = &mfaController{
+		service:     service.GetMfaService(),
+		userService: service.GetUserService(),
+	}
+	return mfaCntr
+}
+
+func (controller *mfaController) RegisterHttpController(router *gin.Engine) {
+	mfaRouter := router.Group("/api/authorization/mfa")
+
+	mfaRouter.GET(
+		"",
+		commonMiddleware.SecurityHandler,
+		controller.getStatus,
+	)
+
+	mfaRouter.POST(
+		"/enrolment",
+		commonMiddleware.MfaSecurityHandler,
+		controller.startEnrolment,
+	)
+
+	mfaRouter.POST(
+		"/enrolment/confirm",
+		commonMiddleware.MfaSecurityHandler,
+		commonResolver.Resolver[model.MfaRequest],
+		controller.confirmEnrolment,
+	)
+
+	mfaRouter.POST(
+		"/recovery-codes",
+		commonMiddleware.SessionSecurityHandler,
+		commonResolver.Resolver[model.MfaRequest],
+		controller.regenerateRecoveryCodes,
+	)
+
+	mfaRouter.DELETE(
+		"",
+		commonMiddleware.SessionSecurityHandler,
+		commonResolver.Resolver[model.MfaRequest],
+		controller.disable,
+	)
+
+	router.DELETE(
+		"/api/authorization/users/:id/mfa",
+		commonMiddleware.SecurityHandler,
+		commonMiddleware.HasAnyAuthorities("UPDATE_USER"),
+		controller.reset,
+	)
+}
+
+// mfaController godoc
+// @Security BearerAuth
+// @Summary      getStatus
+// @Description  Get second factor status of the current user
+// @Tags         MFA controller
+// @Produce      json
+// @Success      200	{object}  model.MfaStatus
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/mfa [GET]
+func (controller *mfaController) getStatus(ctx *gin.Context) {
+	log.WithContext(ctx).Info("MfaController: GetStatus(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetStatus(ctx, controller.userService.GetCurrent(ctx)))
+	log.WithContext(ctx).Info("MfaController: GetStatus(): End")
+}
+
+// mfaController godoc
+// @Security BearerAuth
+// @Summary      startEnrolment
+// @Description  Generate TOTP secret of the current user, the provisioning uri is shown as QR code to the authenticator app.
+// @Description  Accepts the MFA token of the login, so the users obliged to use MFA enrol before their first login
+// @Tags         MFA controller
+// @Produce      json
+// @Success      200	{object}  model.MfaEnrolment
+// @Failure      400
+// @Failure      409
+// @Failure      500
+// @Router       /api/authorization/mfa/enrolment [POST]
+func (controller *mfaController) startEnrolment(ctx *gin.Context) {
+	log.WithContext(ctx).Info("MfaController: StartEnrolment(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.StartEnrolment(ctx, controller.userService.GetCurrent(ctx)))
+	log.WithContext(ctx).Info("MfaController: StartEnrolment(): End")
+}
+
+// mfaController godoc
+// @Security BearerAuth
+// @Summary      confirmEnrolment
+// @Description  Enable second factor by the first code of the authenticator app, the recovery codes are returned only once
+// @Tags         MFA controller
+// @Accept       json
+// @Produce      json
+// @Param        request	body	  model.MfaRequest  true  "Code of authenticator"
+// @Success      200	{object}  model.RecoveryCodes
+// @Failure      400
+// @Failure      409
+// @Failure      500
+// @Router       /api/authorization/mfa/enrolment/confirm [POST]
+func (controller *mfaController) confirmEnrolment(ctx *gin.Context) {
+	log.WithContext(ctx).Info("MfaController: ConfirmEnrolment(): Start")
+	request := ctx.MustGet("RequestBody").(model.MfaRequest)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.ConfirmEnrolment(ctx, controller.userService.GetCurrent(ctx).ID, request.Code))
+	log.WithContext(ctx).Info("MfaController: ConfirmEnrolment(): End")
+}
+
+// mfaController godoc
+// @Security BearerAuth
+// @Summary      regenerateRecoveryCodes
+// @Description  Replace recovery codes of the current user, the new codes are returned only once
+// @Tags         MFA controller
+// @Accept       json
+// @Produce      json
+// @Param        request	body	  model.MfaRequest  true  "Code of authenticator or recovery code"
+// @Success      200	{object}  model.RecoveryCodes
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/mfa/recovery-codes [POST]
+func (controller *mfaController) regenerateRecoveryCodes(ctx *gin.Context) {
+	log.WithContext(ctx).Info("MfaController: RegenerateRecoveryCodes(): Start")
+	request := ctx.MustGet("RequestBody").(model.MfaRequest)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.RegenerateRecoveryCodes(ctx, controller.userService.GetCurrent(ctx).ID, request.Code))
+	log.WithContext(ctx).Info("MfaController: RegenerateRecoveryCodes(): End")
+}
+
+// mfaController godoc
+// @Security BearerAuth
+// @Summary      disable
+// @Description  Disable second factor of the current user, it isn't allowed when a role of the user requires MFA
+// @Tags         MFA controller
+// @Accept       json
+// @Produce      json
+// @Param        request	body	  model.MfaRequest  true  "Code of authenticator or recovery code"
+// @Success      200
+// @Failure      400
+// @Failure      403
+// @Failure      500
+// @Router       /api/authorization/mfa [DELETE]
+func (controller *mfaController) disable(ctx *gin.Context) {
+	log.WithContext(ctx).Info("MfaController: Disable(): Start")
+	request := ctx.MustGet("RequestBody").(model.MfaRequest)
+	controller.service.Disable(ctx, controller.userService.GetCurrent(ctx), request.Code)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("MfaController: Disable(): End")
+}
+
+// mfaController godoc
+// @Security BearerAuth
+// @Summary      reset
+// @Description  Remove second factor of the user who lost the authenticator and the recovery codes
+// @Tags         MFA controller
+// @Produce      json
+// @Param        id		path     string  true  "User.ID"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/users/{id}/mfa [DELETE]
+func (controller *mfaController) reset(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("MfaController: Reset(id: %s): Start", id)
+	controller.service.Reset(ctx, id)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("MfaController: Reset(): End")
+}
@@ -0,0 +1,220 @@
+package controller
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonController "assets/common/controller"
+	commonMiddleware "assets/common/middleware"
+	commonResolver "assets/common/resolver"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"assets/modules/authorization/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+var oauthClientCntr commonController.HttpController
+
+type oauthClientController struct {
+	service service.OAuthClientService
+}
+
+func GetOAuthClientController() commonController.HttpController {
+	if oauthClientCntr != nil {
+		return oauthClientCntr
+	}
+	oauthClientCntr = &oauthClientController{service: service.GetOAuthClientService()}
+	return oauthClientCntr
+}
+
+func (controller *oauthClientController) RegisterHttpController(router *gin.Engine) {
+	clientRouter := router.Group("/api/authorization/clients", commonMiddleware.SecurityHandler)
+
+	clientRouter.GET(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("READ_OAUTH_CLIENT"),
+		controller.getById,
+	)
+
+	clientRouter.GET(
+		"",
+		commonMiddleware.HasAnyAuthorities("READ_OAUTH_CLIENT"),
+		commonMiddleware.PaginationHandler,
+		controller.getAll,
+	)
+
+	clientRouter.POST(
+		"",
+		commonMiddleware.HasAnyAuthorities("EDIT_OAUTH_CLIENT"),
+		commonResolver.Resolver[model.OAuthClient],
+		controller.create,
+	)
+
+	clientRouter.PUT(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_OAUTH_CLIENT"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.UpdateResolver[model.OAuthClient],
+		controller.update,
+	)
+
+	clientRouter.PATCH(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_OAUTH_CLIENT"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.PatchResolver(controller.service.GetById),
+		controller.update,
+	)
+
+	clientRouter.DELETE(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_OAUTH_CLIENT"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		controller.deleteById,
+	)
+
+	clientRouter.POST(
+		"/:id/secret",
+		commonMiddleware.HasAnyAuthorities("EDIT_OAUTH_CLIENT"),
+		controller.regenerateSecret,
+	)
+}
+
+// oauthClientController godoc
+// @Security BearerAuth
+// @Summary      getById
+// @Description  Get OAuth client by id
+// @Tags         OAuth client controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "OAuthClient.ID"
+// @Success      200	{object}  model.OAuthClient
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/clients/{id} [GET]
+func (controller *oauthClientController) getById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("OAuthClientController: GetById(id: %s): Start", id)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.GetById(ctx, id))
+	log.WithContext(ctx).Info("OAuthClientController: GetById(): End")
+}
+
+// oauthClientController godoc
+// @Security BearerAuth
+// @Summary      getAll
+// @Description  Get all OAuth clients
+// @Tags         OAuth client controller
+// @Accept       json
+// @Produce      json
+// @Success      200	{array}  model.OAuthClient
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/clients/ [GET]
+func (controller *oauthClientController) getAll(ctx *gin.Context) {
+	page := commonUtil.MustGetPageable(ctx)
+	log.WithContext(ctx).Info("OAuthClientController: GetAll(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetAll(ctx, page))
+	log.WithContext(ctx).Info("OAuthClientController: GetAll(): End")
+}
+
+// oauthClientController godoc
+// @Security BearerAuth
+// @Summary      create
+// @Description  Register OAuth client, the secret of the confidential client is returned only in the response
+// @Tags         OAuth client controller
+// @Accept       json
+// @Produce      json
+// @Param        client	body	  model.OAuthClient  true  "Create OAuthClient"
+// @Success      201	{object}  model.OAuthClient
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/clients/ [POST]
+func (controller *oauthClientController) create(ctx *gin.Context) {
+	log.WithContext(ctx).Info("OAuthClientController: Create(): Start")
+	client := ctx.MustGet("RequestBody").(model.OAuthClient)
+	commonUtil.AbortWithEntity(ctx, http.StatusCreated, controller.service.Create(ctx, client))
+	log.WithContext(ctx).Info("OAuthClientController: Create(): End")
+}
+
+// oauthClientController godoc
+// @Security BearerAuth
+// @Summary      update
+// @Description  Update OAuth client, the secret is kept
+// @Tags         OAuth client controller
+// @Accept       json
+// @Produce      json
+// @Param        client	body	  model.OAuthClient  true  "Update OAuthClient"
+// @Success      200	{object}  model.OAuthClient
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/clients/{id} [PUT]
+// @Router       /api/authorization/clients/{id} [PATCH]
+func (controller *oauthClientController) update(ctx *gin.Context) {
+	log.WithContext(ctx).Info("OAuthClientController: Update(): Start")
+	client := ctx.MustGet("RequestBody").(model.OAuthClient)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Update(ctx, client))
+	log.WithContext(ctx).Info("OAuthClientController: Update(): End")
+}
+
+// oauthClientController godoc
+// @Security BearerAuth
+// @Summary      deleteById
+// @Description  Delete OAuth client by id with the consents of the users
+// @Tags         OAuth client controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "OAuthClient.ID"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/clients/{id} [DELETE]
+func (controller *oauthClientController) deleteById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("OAuthClientController: DeleteById(id: %s): Start", id)
+	controller.service.DeleteById(ctx, id)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("OAuthClientController: DeleteById(): End")
+}
+
+// oauthClientController godoc
+// @Security BearerAuth
+// @Summary      regenerateSecret
+// @Description  Replace the secret of the confidential OAuth client, the new secret is returned only in the response
+// @Tags         OAuth client controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "OAuthClient.ID"
+// @Success      200	{object}  model.OAuthClient
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/clients/{id}/secret [POST]
+func (controller *oauthClientController) regenerateSecret(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("OAuthClientController: RegenerateSecret(id: %s): Start", id)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.RegenerateSecret(ctx, id))
+	log.WithContext(ctx).Info("OAuthClientController: RegenerateSecret(): End")
+}
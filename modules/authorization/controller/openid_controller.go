@@ -0,0 +1,140 @@
+package controller
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonController "assets/common/controller"
+	commonError "assets/common/custom_error"
+	commonMiddleware "assets/common/middleware"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"assets/modules/authorization/service"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+var openIdCntr commonController.HttpController
+
+type openIdController struct {
+	service service.OpenIdService
+}
+
+func GetOpenIdController() commonController.HttpController {
+	if openIdCntr != nil {
+		return openIdCntr
+	}
+	openIdCntr = &openIdController{service: service.GetOpenIdService()}
+	return openIdCntr
+}
+
+func (controller *openIdController) RegisterHttpController(router *gin.Engine) {
+	// the consent is given by the signed in user only, the api tokens of the integrations can't give it
+	authorizeRouter := router.Group("/api/authorization", commonMiddleware.SessionSecurityHandler)
+	authorizeRouter.GET("/authorize", controller.authorize)
+	authorizeRouter.POST("/authorize", controller.authorize)
+
+	openIdRouter := router.Group("/api/authorization", commonMiddleware.SecurityHandler)
+	openIdRouter.GET("/userinfo", controller.getUserInfo)
+	openIdRouter.POST("/userinfo", controller.getUserInfo)
+
+	router.GET("/.well-known/openid-configuration", controller.getConfiguration)
+}
+
+// openIdController godoc
+// @Security BearerAuth
+// @Summary      authorize
+// @Description  Authorization endpoint of the authorization code flow with PKCE. Redirects to the client with the code,
+// @Description  or returns the consent request when the current user hasn't allowed the client the scopes yet.
+// @Description  The consent is given by the same request sent by POST with consent=enum(approve, deny) and consent_token of the consent request
+// @Tags         OpenID controller
+// @Accept       x-www-form-urlencoded
+// @Produce      json
+// @Param        response_type			query	string	true	"code"
+// @Param        client_id				query	string	true	"OAuthClient.ID"
+// @Param        redirect_uri			query	string	false	"Registered redirect uri of the client"
+// @Param        scope					query	string	true	"Scopes separated by spaces, e.g. openid profile email"
+// @Param        state					query	string	false	"State returned to the client"
+// @Param        nonce					query	string	false	"Nonce of the ID token"
+// @Param        code_challenge			query	string	false	"PKCE code challenge, required for the public clients"
+// @Param        code_challenge_method	query	string	false	"enum(S256, plain)"
+// @Param        consent				formData	string	false	"enum(approve, deny), accepted by POST only"
+// @Param        consent_token			formData	string	false	"ConsentRequest.consentToken, required with the consent"
+// @Success      200	{object}  model.ConsentRequest
+// @Success      302
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/authorize [GET]
+// @Router       /api/authorization/authorize [POST]
+func (controller *openIdController) authorize(ctx *gin.Context) {
+	log.WithContext(ctx).Info("OpenIdController: Authorize(): Start")
+	var request model.AuthorizationRequest
+	commonUtil.Must(ctx.ShouldBind(&request))
+	// the consent changes the state, so it isn't accepted by the links other sites may embed
+	if ctx.Request.Method != http.MethodPost && request.Consent != "" {
+		panic(commonError.IllegalArgumentError)
+	}
+
+	redirectUri, consentRequest := controller.service.Authorize(ctx, request)
+	if consentRequest != nil {
+		ctx.AbortWithStatusJSON(http.StatusOK, consentRequest)
+	} else {
+		ctx.Redirect(http.StatusFound, redirectUri)
+		ctx.Abort()
+	}
+	log.WithContext(ctx).Info("OpenIdController: Authorize(): End")
+}
+
+// openIdController godoc
+// @Security BearerAuth
+// @Summary      getUserInfo
+// @Description  Get claims of the current user allowed by the scopes of the token
+// @Tags         OpenID controller
+// @Produce      json
+// @Success      200	{object}  model.UserInfo
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/userinfo [GET]
+// @Router       /api/authorization/userinfo [POST]
+func (controller *openIdController) getUserInfo(ctx *gin.Context) {
+	log.WithContext(ctx).Info("OpenIdController: GetUserInfo(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetUserInfo(ctx))
+	log.WithContext(ctx).Info("OpenIdController: GetUserInfo(): End")
+}
+
+// openIdController godoc
+// @Summary      getConfiguration
+// @Description  Get OpenID Connect discovery document
+// @Tags         OpenID controller
+// @Produce      json
+// @Success      200	{object}  model.OpenIdConfiguration
+// @Failure      500
+// @Router       /.well-known/openid-configuration [GET]
+func (controller *openIdController) getConfiguration(ctx *gin.Context) {
+	log.WithContext(ctx).Info("OpenIdController: GetConfiguration(): Start")
+	ctx.Header("Cache-Control", "public, max-age=300")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetConfiguration(ctx))
+	log.WithContext(ctx).Info("OpenIdController: GetConfiguration(): End")
+}
@@ -0,0 +1,141 @@
+package controller
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonController "assets/common/controller"
+	commonMiddleware "assets/common/middleware"
+	commonResolver "assets/common/resolver"
+	"assets/modules/authorization/model"
+	"assets/modules/authorization/service"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+	"time"
+)
+
+var passwordCntr commonController.HttpController
+
+type passwordController struct {
+	service service.PasswordService
+}
+
+func GetPasswordController() commonController.HttpController {
+	if passwordCntr != nil {
+		return passwordCntr
+	}
+	passwordCntr = &passwordController{
+		service: service.GetPasswordService(),
+	}
+	return passwordCntr
+}
+
+func (controller *passwordController) RegisterHttpController(router *gin.Engine) {
+	passwordRouter := router.Group("/api/authorization/password")
+	passwordRouter.GET("/policy", controller.getPolicy)
+	passwordRouter.POST("/reset-request", commonResolver.Resolver[model.PasswordResetRequest], controller.requestReset)
+	passwordRouter.POST("/reset", commonResolver.Resolver[model.PasswordReset], controller.reset)
+
+	router.PUT(
+		"/api/authorization/users/current/password",
+		commonMiddleware.SessionSecurityHandler,
+		commonResolver.Resolver[model.PasswordChangeRequest],
+		controller.change,
+	)
+}
+
+// passwordController godoc
+// @Summary      getPolicy
+// @Description  Get policy the new passwords have to meet
+// @Tags         Password controller
+// @Produce      json
+// @Success      200	{object}  model.PasswordPolicy
+// @Failure      500
+// @Router       /api/authorization/password/policy [GET]
+func (controller *passwordController) getPolicy(ctx *gin.Context) {
+	log.WithContext(ctx).Info("PasswordController: GetPolicy(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetPolicy(ctx))
+	log.WithContext(ctx).Info("PasswordController: GetPolicy(): End")
+}
+
+// passwordController godoc
+// @Security BearerAuth
+// @Summary      change
+// @Description  Change password of the current user, all tokens of the user are revoked and the user signs in again
+// @Tags         Password controller
+// @Accept       json
+// @Produce      json
+// @Param        request	body	  model.PasswordChangeRequest  true  "Current and new passwords"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/users/current/password [PUT]
+func (controller *passwordController) change(ctx *gin.Context) {
+	log.WithContext(ctx).Info("PasswordController: Change(): Start")
+	request := ctx.MustGet("RequestBody").(model.PasswordChangeRequest)
+	controller.service.Change(ctx, request.CurrentPassword, request.NewPassword)
+	ctx.Writer.Header().Add("Set-Cookie", fmt.Sprintf("access_token=; Expires=%s; Path=/; HttpOnly", time.Unix(0, 0).UTC().Format(time.RFC1123)))
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("PasswordController: Change(): End")
+}
+
+// passwordController godoc
+// @Summary      requestReset
+// @Description  Send link to reset password to the email, the response doesn't tell whether the email belongs to any user
+// @Tags         Password controller
+// @Accept       json
+// @Produce      json
+// @Param        request	body	  model.PasswordResetRequest  true  "Email of user"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/password/reset-request [POST]
+func (controller *passwordController) requestReset(ctx *gin.Context) {
+	log.WithContext(ctx).Info("PasswordController: RequestReset(): Start")
+	request := ctx.MustGet("RequestBody").(model.PasswordResetRequest)
+	controller.service.RequestReset(ctx, request.Email)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("PasswordController: RequestReset(): End")
+}
+
+// passwordController godoc
+// @Summary      reset
+// @Description  Set new password by the token of the reset email, the token is used once
+// @Tags         Password controller
+// @Accept       json
+// @Produce      json
+// @Param        request	body	  model.PasswordReset  true  "Token and new password"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/password/reset [POST]
+func (controller *passwordController) reset(ctx *gin.Context) {
+	log.WithContext(ctx).Info("PasswordController: Reset(): Start")
+	request := ctx.MustGet("RequestBody").(model.PasswordReset)
+	controller.service.Reset(ctx, request.Token, request.NewPassword)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("PasswordController: Reset(): End")
+}
@@ -77,13 +77,23 @@ func (controller *roleController) RegisterHttpController(router *gin.Engine) {
 	roleRouter.PUT(
 		"/:id",
 		commonMiddleware.HasAnyAuthorities("UPDATE_ROLE"),
-		commonResolver.Resolver[model.Role],
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.UpdateResolver[model.Role],
+		controller.update,
+	)
+
+	roleRouter.PATCH(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("UPDATE_ROLE"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.PatchResolver(controller.service.GetById),
 		controller.update,
 	)
 
 	roleRouter.DELETE(
 		"/:id",
 		commonMiddleware.HasAnyAuthorities("DELETE_ROLE"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
 		controller.deleteById,
 	)
 }
@@ -103,7 +113,7 @@ func (controller *roleController) RegisterHttpController(router *gin.Engine) {
 func (controller *roleController) getById(ctx *gin.Context) {
 	id := uuid.MustParse(ctx.Param("id"))
 	log.WithContext(ctx).Infof("RoleController: GetById(id: %s): Start", id)
-	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetById(ctx, id))
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.GetById(ctx, id))
 	log.WithContext(ctx).Info("RoleController: GetById(): End")
 }
 
@@ -140,7 +150,7 @@ func (controller *roleController) getAll(ctx *gin.Context) {
 func (controller *roleController) create(ctx *gin.Context) {
 	log.WithContext(ctx).Info("RoleController: Create(): Start")
 	role := ctx.MustGet("RequestBody").(model.Role)
-	ctx.AbortWithStatusJSON(http.StatusCreated, controller.service.Create(ctx, role))
+	commonUtil.AbortWithEntity(ctx, http.StatusCreated, controller.service.Create(ctx, role))
 	log.WithContext(ctx).Info("RoleController: Create(): End")
 }
 
@@ -156,10 +166,11 @@ func (controller *roleController) create(ctx *gin.Context) {
 // @Failure      400
 // @Failure      500
 // @Router       /api/authorization/roles/{id} [PUT]
+// @Router       /api/authorization/roles/{id} [PATCH]
 func (controller *roleController) update(ctx *gin.Context) {
 	log.WithContext(ctx).Info("RoleController: Update(): Start")
 	role := ctx.MustGet("RequestBody").(model.Role)
-	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.Update(ctx, role))
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Update(ctx, role))
 	log.WithContext(ctx).Info("RoleController: Update(): End")
 }
 
@@ -0,0 +1,214 @@
+package controller
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonController "assets/common/controller"
+	commonMiddleware "assets/common/middleware"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/service"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+	"time"
+)
+
+var sessionCntr commonController.HttpController
+
+type sessionController struct {
+	service service.SessionService
+}
+
+func GetSessionController() commonController.HttpController {
+	if sessionCntr != nil {
+		return sessionCntr
+	}
+	sessionCntr = &sessionController{
+		service: service.GetSessionService(),
+	}
+	return sessionCntr
+}
+
+func (controller *sessionController) RegisterHttpController(router *gin.Engine) {
+	currentRouter := router.Group("/api/authorization/users/current/sessions")
+
+	currentRouter.GET(
+		"",
+		commonMiddleware.SessionSecurityHandler,
+		controller.getCurrentUserSessions,
+	)
+
+	currentRouter.DELETE(
+		"",
+		commonMiddleware.SessionSecurityHandler,
+		controller.revokeOtherSessions,
+	)
+
+	currentRouter.DELETE(
+		"/:id",
+		commonMiddleware.SessionSecurityHandler,
+		controller.revokeCurrentUserSession,
+	)
+
+	userRouter := router.Group("/api/authorization/users/:id/sessions")
+
+	userRouter.GET(
+		"",
+		commonMiddleware.SecurityHandler,
+		commonMiddleware.HasAnyAuthorities("READ_USER"),
+		controller.getSessions,
+	)
+
+	userRouter.DELETE(
+		"",
+		commonMiddleware.SecurityHandler,
+		commonMiddleware.HasAnyAuthorities("UPDATE_USER"),
+		controller.revokeAll,
+	)
+
+	userRouter.DELETE(
+		"/:sessionId",
+		commonMiddleware.SecurityHandler,
+		commonMiddleware.HasAnyAuthorities("UPDATE_USER"),
+		controller.revoke,
+	)
+}
+
+// sessionController godoc
+// @Security BearerAuth
+// @Summary      getCurrentUserSessions
+// @Description  Get active sessions of the current user, the session of the request is marked as current
+// @Tags         Session controller
+// @Produce      json
+// @Success      200	{array}  model.UserSession
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/users/current/sessions [GET]
+func (controller *sessionController) getCurrentUserSessions(ctx *gin.Context) {
+	log.WithContext(ctx).Info("SessionController: GetCurrentUserSessions(): Start")
+	tokenInfo := commonUtil.MustGetCurrentTokenInfo(ctx)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetSessions(ctx, tokenInfo.UserId))
+	log.WithContext(ctx).Info("SessionController: GetCurrentUserSessions(): End")
+}
+
+// sessionController godoc
+// @Security BearerAuth
+// @Summary      revokeOtherSessions
+// @Description  Sign out the current user on all other devices
+// @Tags         Session controller
+// @Produce      json
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/users/current/sessions [DELETE]
+func (controller *sessionController) revokeOtherSessions(ctx *gin.Context) {
+	log.WithContext(ctx).Info("SessionController: RevokeOtherSessions(): Start")
+	tokenInfo := commonUtil.MustGetCurrentTokenInfo(ctx)
+	controller.service.RevokeAll(ctx, tokenInfo.UserId, tokenInfo.SessionId)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("SessionController: RevokeOtherSessions(): End")
+}
+
+// sessionController godoc
+// @Security BearerAuth
+// @Summary      revokeCurrentUserSession
+// @Description  Sign out the current user on the device of the session
+// @Tags         Session controller
+// @Produce      json
+// @Param        id		path     string  true  "UserSession.ID"
+// @Success      200
+// @Failure      404
+// @Failure      500
+// @Router       /api/authorization/users/current/sessions/{id} [DELETE]
+func (controller *sessionController) revokeCurrentUserSession(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("SessionController: RevokeCurrentUserSession(id: %s): Start", id)
+	tokenInfo := commonUtil.MustGetCurrentTokenInfo(ctx)
+	controller.service.Revoke(ctx, tokenInfo.UserId, id)
+	if id.String() == tokenInfo.SessionId {
+		ctx.Writer.Header().Add("Set-Cookie", fmt.Sprintf("access_token=; Expires=%s; Path=/; HttpOnly", time.Unix(0, 0).UTC().Format(time.RFC1123)))
+	}
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("SessionController: RevokeCurrentUserSession(): End")
+}
+
+// sessionController godoc
+// @Security BearerAuth
+// @Summary      getSessions
+// @Description  Get active sessions of the user
+// @Tags         Session controller
+// @Produce      json
+// @Param        id		path     string  true  "User.ID"
+// @Success      200	{array}  model.UserSession
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/users/{id}/sessions [GET]
+func (controller *sessionController) getSessions(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("SessionController: GetSessions(id: %s): Start", id)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetSessions(ctx, id))
+	log.WithContext(ctx).Info("SessionController: GetSessions(): End")
+}
+
+// sessionController godoc
+// @Security BearerAuth
+// @Summary      revokeAll
+// @Description  Sign out the user everywhere, all tokens of the user are revoked
+// @Tags         Session controller
+// @Produce      json
+// @Param        id		path     string  true  "User.ID"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/authorization/users/{id}/sessions [DELETE]
+func (controller *sessionController) revokeAll(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("SessionController: RevokeAll(id: %s): Start", id)
+	controller.service.RevokeAll(ctx, id, "")
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("SessionController: RevokeAll(): End")
+}
+
+// sessionController godoc
+// @Security BearerAuth
+// @Summary      revoke
+// @Description  Sign out the user on the device of the session
+// @Tags         Session controller
+// @Produce      json
+// @Param        id			path     string  true  "User.ID"
+// @Param        sessionId	path     string  true  "UserSession.ID"
+// @Success      200
+// @Failure      404
+// @Failure      500
+// @Router       /api/authorization/users/{id}/sessions/{sessionId} [DELETE]
+func (controller *sessionController) revoke(ctx *gin.Context) {
+	id, sessionId := uuid.MustParse(ctx.Param("id")), uuid.MustParse(ctx.Param("sessionId"))
+	log.WithContext(ctx).Infof("SessionController: Revoke(id: %s, sessionId: %s): Start", id, sessionId)
+	controller.service.Revoke(ctx, id, sessionId)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("SessionController: Revoke(): End")
+}
@@ -81,14 +81,25 @@ func (controller *userController) RegisterHttpController(router *gin.Engine) {
 		"",
 		commonMiddleware.SecurityHandler,
 		commonMiddleware.HasAnyAuthorities("UPDATE_USER"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
 		commonResolver.Resolver[model.User],
 		controller.update,
 	)
 
+	userRouter.PATCH(
+		"/:id",
+		commonMiddleware.SecurityHandler,
+		commonMiddleware.HasAnyAuthorities("UPDATE_USER"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.PatchResolver(controller.service.GetById),
+		controller.update,
+	)
+
 	userRouter.DELETE(
 		"/:id",
 		commonMiddleware.SecurityHandler,
 		commonMiddleware.HasAnyAuthorities("DELETE_USER"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
 		controller.deleteById,
 	)
 
@@ -143,7 +154,7 @@ func (controller *userController) RegisterHttpController(router *gin.Engine) {
 func (controller *userController) getById(ctx *gin.Context) {
 	id := uuid.MustParse(ctx.Param("id"))
 	log.WithContext(ctx).Infof("UserController: GetById(id: %s): Start", id)
-	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetById(ctx, id))
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.GetById(ctx, id))
 	log.WithContext(ctx).Info("UserController: GetById(): End")
 }
 
@@ -180,7 +191,7 @@ func (controller *userController) getAll(ctx *gin.Context) {
 func (controller *userController) create(ctx *gin.Context) {
 	log.WithContext(ctx).Info("UserController: Create(): Start")
 	user := ctx.MustGet("RequestBody").(model.User)
-	ctx.AbortWithStatusJSON(http.StatusCreated, controller.service.Create(ctx, user))
+	commonUtil.AbortWithEntity(ctx, http.StatusCreated, controller.service.Create(ctx, user))
 	log.WithContext(ctx).Info("UserController: Create(): End")
 }
 
@@ -196,10 +207,11 @@ func (controller *userController) create(ctx *gin.Context) {
 // @Failure      400
 // @Failure      500
 // @Router       /api/authorization/users/{id} [PUT]
+// @Router       /api/authorization/users/{id} [PATCH]
 func (controller *userController) update(ctx *gin.Context) {
 	log.WithContext(ctx).Info("UserController: Update(): Start")
 	user := ctx.MustGet("RequestBody").(model.User)
-	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.Update(ctx, user))
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Update(ctx, user))
 	log.WithContext(ctx).Info("UserController: Update(): End")
 }
 
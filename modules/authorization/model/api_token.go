@@ -0,0 +1,77 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const (
+	// PersonalAccessTokenKind is the token the user issues to the own scripts
+	PersonalAccessTokenKind = "PERSONAL_ACCESS_TOKEN"
+	// ApiKeyKind is the token the administrator issues to the service account
+	ApiKeyKind = "API_KEY"
+)
+
+// ApiToken is the long-living token of the Token authorization scheme. It is stored hashed and shown only
+// once on creation, the prefix lets the user recognize it. The token has the authorities of its user
+// it is scoped to, the ones the user lost are dropped
+type ApiToken struct {
+	ID           uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	Name         string     `json:"name,omitempty"`
+	Kind         string     `json:"kind,omitempty"`
+	UserID       uuid.UUID  `json:"userId,omitempty" gorm:"type:uuid;index"`
+	Token        string     `json:"token,omitempty" gorm:"-"`
+	Prefix       string     `json:"prefix,omitempty"`
+	TokenHash    string     `json:"-" gorm:"uniqueIndex"`
+	Authorities  []string   `json:"authorities,omitempty" gorm:"serializer:json"`
+	ExpireDate   *time.Time `json:"expireDate,omitempty"`
+	LastUsedDate *time.Time `json:"lastUsedDate,omitempty"`
+	CreateDate   *time.Time `json:"createDate,omitempty"`
+	commonModel.Versioned
+}
+
+func (token ApiToken) GetID() uuid.UUID {
+	return token.ID
+}
+
+func (token *ApiToken) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(token.ID) {
+		token.ID = uuid.New()
+	}
+	if token.CreateDate == nil {
+		now := time.Now()
+		token.CreateDate = &now
+	}
+	return nil
+}
+
+func (token ApiToken) IsExpired() bool {
+	return token.ExpireDate != nil && token.ExpireDate.Before(time.Now())
+}
@@ -0,0 +1,271 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonAb4b98b4DecodeAssetsModulesAuthorizationModel(in *jlexer.Lexer, out *ApiToken) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "kind":
+			out.Kind = string(in.String())
+		case "userId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserID).UnmarshalText(data))
+			}
+		case "token":
+			out.Token = string(in.String())
+		case "prefix":
+			out.Prefix = string(in.String())
+		case "authorities":
+			if in.IsNull() {
+				in.Skip()
+				out.Authorities = nil
+			} else {
+				in.Delim('[')
+				if out.Authorities == nil {
+					if !in.IsDelim(']') {
+						out.Authorities = make([]string, 0, 4)
+					} else {
+						out.Authorities = []string{}
+					}
+				} else {
+					out.Authorities = (out.Authorities)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Authorities = append(out.Authorities, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "expireDate":
+			if in.IsNull() {
+				in.Skip()
+				out.ExpireDate = nil
+			} else {
+				if out.ExpireDate == nil {
+					out.ExpireDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ExpireDate).UnmarshalJSON(data))
+				}
+			}
+		case "lastUsedDate":
+			if in.IsNull() {
+				in.Skip()
+				out.LastUsedDate = nil
+			} else {
+				if out.LastUsedDate == nil {
+					out.LastUsedDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.LastUsedDate).UnmarshalJSON(data))
+				}
+			}
+		case "createDate":
+			if in.IsNull() {
+				in.Skip()
+				out.CreateDate = nil
+			} else {
+				if out.CreateDate == nil {
+					out.CreateDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreateDate).UnmarshalJSON(data))
+				}
+			}
+		case "version":
+			out.Version = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonAb4b98b4EncodeAssetsModulesAuthorizationModel(out *jwriter.Writer, in ApiToken) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	if in.Kind != "" {
+		const prefix string = ",\"kind\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Kind))
+	}
+	if true {
+		const prefix string = ",\"userId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.UserID).MarshalText())
+	}
+	if in.Token != "" {
+		const prefix string = ",\"token\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Token))
+	}
+	if in.Prefix != "" {
+		const prefix string = ",\"prefix\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Prefix))
+	}
+	if len(in.Authorities) != 0 {
+		const prefix string = ",\"authorities\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Authorities {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.ExpireDate != nil {
+		const prefix string = ",\"expireDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.ExpireDate).MarshalJSON())
+	}
+	if in.LastUsedDate != nil {
+		const prefix string = ",\"lastUsedDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.LastUsedDate).MarshalJSON())
+	}
+	if in.CreateDate != nil {
+		const prefix string = ",\"createDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.CreateDate).MarshalJSON())
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ApiToken) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonAb4b98b4EncodeAssetsModulesAuthorizationModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ApiToken) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonAb4b98b4EncodeAssetsModulesAuthorizationModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ApiToken) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonAb4b98b4DecodeAssetsModulesAuthorizationModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ApiToken) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonAb4b98b4DecodeAssetsModulesAuthorizationModel(l, v)
+}
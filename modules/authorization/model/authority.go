@@ -1,6 +1,7 @@
 package model
 
 import (
+	commonModel "assets/common/model"
 	commonUtil "assets/common/util"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -36,6 +37,7 @@ type Authority struct {
 	ID          uuid.UUID `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
 	Method      string    `json:"method,omitempty"`
 	Description string    `json:"description,omitempty"`
+	commonModel.Versioned
 }
 
 func (authority Authority) GetID() uuid.UUID {
@@ -44,6 +44,8 @@ func easyjson4258a2bdDecodeAssetsModulesAuthorizationModel(in *jlexer.Lexer, out
 			out.Method = string(in.String())
 		case "description":
 			out.Description = string(in.String())
+		case "version":
+			out.Version = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -84,6 +86,16 @@ func easyjson4258a2bdEncodeAssetsModulesAuthorizationModel(out *jwriter.Writer,
 		}
 		out.String(string(in.Description))
 	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
 	out.RawByte('}')
 }
 
@@ -0,0 +1,92 @@
+package model
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"github.com/google/uuid"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const (
+	PlainCodeChallenge = "plain"
+	S256CodeChallenge  = "S256"
+)
+
+const (
+	ApproveConsent = "approve"
+	DenyConsent    = "deny"
+)
+
+// AuthorizationRequest is the request of the authorization endpoint, the consent is sent by the user
+// approving or denying the access of the client
+type AuthorizationRequest struct {
+	ResponseType        string `form:"response_type"`
+	ClientID            string `form:"client_id"`
+	RedirectUri         string `form:"redirect_uri"`
+	Scope               string `form:"scope"`
+	State               string `form:"state"`
+	Nonce               string `form:"nonce"`
+	CodeChallenge       string `form:"code_challenge"`
+	CodeChallengeMethod string `form:"code_challenge_method"`
+	Consent             string `form:"consent"`
+	ConsentToken        string `form:"consent_token"`
+}
+
+// ConsentRequest asks the user to allow the client to access the scopes, the consent is sent
+// with the consent token, so other sites can't consent on behalf of the user
+type ConsentRequest struct {
+	ClientID     uuid.UUID `json:"clientId,omitempty"`
+	ClientName   string    `json:"clientName,omitempty"`
+	Scopes       []string  `json:"scopes,omitempty"`
+	ConsentToken string    `json:"consentToken,omitempty"`
+}
+
+// AuthorizationCode is issued to the client on the user consent and exchanged once for the tokens
+type AuthorizationCode struct {
+	ClientID            uuid.UUID `json:"clientId,omitempty"`
+	UserID              uuid.UUID `json:"userId,omitempty"`
+	RedirectUri         string    `json:"redirectUri,omitempty"`
+	Scope               string    `json:"scope,omitempty"`
+	Nonce               string    `json:"nonce,omitempty"`
+	CodeChallenge       string    `json:"codeChallenge,omitempty"`
+	CodeChallengeMethod string    `json:"codeChallengeMethod,omitempty"`
+	AuthTime            int64     `json:"authTime,omitempty"`
+}
+
+// VerifyCodeVerifier checks the PKCE code verifier against the challenge of the code (RFC 7636),
+// codes issued without challenge need no verifier
+func (code AuthorizationCode) VerifyCodeVerifier(codeVerifier string) bool {
+	if code.CodeChallenge == "" {
+		return codeVerifier == ""
+	}
+	challenge := codeVerifier
+	if code.CodeChallengeMethod == S256CodeChallenge {
+		hash := sha256.Sum256([]byte(codeVerifier))
+		challenge = base64.RawURLEncoding.EncodeToString(hash[:])
+	}
+	return subtle.ConstantTimeCompare([]byte(challenge), []byte(code.CodeChallenge)) == 1
+}
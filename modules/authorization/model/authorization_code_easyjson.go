@@ -0,0 +1,438 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson79c26e35DecodeAssetsModulesAuthorizationModel(in *jlexer.Lexer, out *ConsentRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "clientId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ClientID).UnmarshalText(data))
+			}
+		case "clientName":
+			out.ClientName = string(in.String())
+		case "scopes":
+			if in.IsNull() {
+				in.Skip()
+				out.Scopes = nil
+			} else {
+				in.Delim('[')
+				if out.Scopes == nil {
+					if !in.IsDelim(']') {
+						out.Scopes = make([]string, 0, 4)
+					} else {
+						out.Scopes = []string{}
+					}
+				} else {
+					out.Scopes = (out.Scopes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Scopes = append(out.Scopes, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "consentToken":
+			out.ConsentToken = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson79c26e35EncodeAssetsModulesAuthorizationModel(out *jwriter.Writer, in ConsentRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"clientId\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ClientID).MarshalText())
+	}
+	if in.ClientName != "" {
+		const prefix string = ",\"clientName\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ClientName))
+	}
+	if len(in.Scopes) != 0 {
+		const prefix string = ",\"scopes\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Scopes {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.ConsentToken != "" {
+		const prefix string = ",\"consentToken\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ConsentToken))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ConsentRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson79c26e35EncodeAssetsModulesAuthorizationModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ConsentRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson79c26e35EncodeAssetsModulesAuthorizationModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ConsentRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson79c26e35DecodeAssetsModulesAuthorizationModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ConsentRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson79c26e35DecodeAssetsModulesAuthorizationModel(l, v)
+}
+func easyjson79c26e35DecodeAssetsModulesAuthorizationModel1(in *jlexer.Lexer, out *AuthorizationRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ResponseType":
+			out.ResponseType = string(in.String())
+		case "ClientID":
+			out.ClientID = string(in.String())
+		case "RedirectUri":
+			out.RedirectUri = string(in.String())
+		case "Scope":
+			out.Scope = string(in.String())
+		case "State":
+			out.State = string(in.String())
+		case "Nonce":
+			out.Nonce = string(in.String())
+		case "CodeChallenge":
+			out.CodeChallenge = string(in.String())
+		case "CodeChallengeMethod":
+			out.CodeChallengeMethod = string(in.String())
+		case "Consent":
+			out.Consent = string(in.String())
+		case "ConsentToken":
+			out.ConsentToken = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson79c26e35EncodeAssetsModulesAuthorizationModel1(out *jwriter.Writer, in AuthorizationRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ResponseType\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ResponseType))
+	}
+	{
+		const prefix string = ",\"ClientID\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientID))
+	}
+	{
+		const prefix string = ",\"RedirectUri\":"
+		out.RawString(prefix)
+		out.String(string(in.RedirectUri))
+	}
+	{
+		const prefix string = ",\"Scope\":"
+		out.RawString(prefix)
+		out.String(string(in.Scope))
+	}
+	{
+		const prefix string = ",\"State\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	{
+		const prefix string = ",\"Nonce\":"
+		out.RawString(prefix)
+		out.String(string(in.Nonce))
+	}
+	{
+		const prefix string = ",\"CodeChallenge\":"
+		out.RawString(prefix)
+		out.String(string(in.CodeChallenge))
+	}
+	{
+		const prefix string = ",\"CodeChallengeMethod\":"
+		out.RawString(prefix)
+		out.String(string(in.CodeChallengeMethod))
+	}
+	{
+		const prefix string = ",\"Consent\":"
+		out.RawString(prefix)
+		out.String(string(in.Consent))
+	}
+	{
+		const prefix string = ",\"ConsentToken\":"
+		out.RawString(prefix)
+		out.String(string(in.ConsentToken))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AuthorizationRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson79c26e35EncodeAssetsModulesAuthorizationModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AuthorizationRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson79c26e35EncodeAssetsModulesAuthorizationModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AuthorizationRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson79c26e35DecodeAssetsModulesAuthorizationModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AuthorizationRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson79c26e35DecodeAssetsModulesAuthorizationModel1(l, v)
+}
+func easyjson79c26e35DecodeAssetsModulesAuthorizationModel2(in *jlexer.Lexer, out *AuthorizationCode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "clientId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ClientID).UnmarshalText(data))
+			}
+		case "userId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserID).UnmarshalText(data))
+			}
+		case "redirectUri":
+			out.RedirectUri = string(in.String())
+		case "scope":
+			out.Scope = string(in.String())
+		case "nonce":
+			out.Nonce = string(in.String())
+		case "codeChallenge":
+			out.CodeChallenge = string(in.String())
+		case "codeChallengeMethod":
+			out.CodeChallengeMethod = string(in.String())
+		case "authTime":
+			out.AuthTime = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson79c26e35EncodeAssetsModulesAuthorizationModel2(out *jwriter.Writer, in AuthorizationCode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"clientId\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ClientID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"userId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.UserID).MarshalText())
+	}
+	if in.RedirectUri != "" {
+		const prefix string = ",\"redirectUri\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.RedirectUri))
+	}
+	if in.Scope != "" {
+		const prefix string = ",\"scope\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Scope))
+	}
+	if in.Nonce != "" {
+		const prefix string = ",\"nonce\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Nonce))
+	}
+	if in.CodeChallenge != "" {
+		const prefix string = ",\"codeChallenge\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.CodeChallenge))
+	}
+	if in.CodeChallengeMethod != "" {
+		const prefix string = ",\"codeChallengeMethod\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.CodeChallengeMethod))
+	}
+	if in.AuthTime != 0 {
+		const prefix string = ",\"authTime\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.AuthTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AuthorizationCode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson79c26e35EncodeAssetsModulesAuthorizationModel2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AuthorizationCode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson79c26e35EncodeAssetsModulesAuthorizationModel2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AuthorizationCode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson79c26e35DecodeAssetsModulesAuthorizationModel2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AuthorizationCode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson79c26e35DecodeAssetsModulesAuthorizationModel2(l, v)
+}
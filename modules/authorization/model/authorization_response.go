@@ -35,4 +35,11 @@ type AuthorizationResponse struct {
 	AccessToken  string `json:"access_token,omitempty"`
 	TokenType    string `json:"token_type,omitempty"`
 	RefreshToken string `json:"refresh_token,omitempty"`
+	IdToken      string `json:"id_token,omitempty"`
+	// MfaToken is returned instead of the tokens when the login needs the second factor, the enrolment
+	// is required when the user has to use MFA but hasn't enrolled yet
+	MfaToken             string `json:"mfa_token,omitempty"`
+	MfaEnrolmentRequired bool   `json:"mfa_enrolment_required,omitempty"`
+	// PasswordChangeRequired is returned instead of the tokens until the password grant comes with new_password
+	PasswordChangeRequired bool `json:"password_change_required,omitempty"`
 }
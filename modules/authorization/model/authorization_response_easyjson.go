@@ -42,6 +42,14 @@ func easyjson1158570fDecodeAssetsModulesAuthorizationModel(in *jlexer.Lexer, out
 			out.TokenType = string(in.String())
 		case "refresh_token":
 			out.RefreshToken = string(in.String())
+		case "id_token":
+			out.IdToken = string(in.String())
+		case "mfa_token":
+			out.MfaToken = string(in.String())
+		case "mfa_enrolment_required":
+			out.MfaEnrolmentRequired = bool(in.Bool())
+		case "password_change_required":
+			out.PasswordChangeRequired = bool(in.Bool())
 		case "userId":
 			if data := in.UnsafeBytes(); in.Ok() {
 				in.AddError((out.UserId).UnmarshalText(data))
@@ -104,6 +112,12 @@ func easyjson1158570fDecodeAssetsModulesAuthorizationModel(in *jlexer.Lexer, out
 			}
 		case "ati":
 			out.ParentId = string(in.String())
+		case "sid":
+			out.SessionId = string(in.String())
+		case "client_id":
+			out.ClientId = string(in.String())
+		case "scope":
+			out.Scope = string(in.String())
 		case "aud":
 			out.Audience = string(in.String())
 		case "exp":
@@ -158,6 +172,46 @@ func easyjson1158570fEncodeAssetsModulesAuthorizationModel(out *jwriter.Writer,
 		}
 		out.String(string(in.RefreshToken))
 	}
+	if in.IdToken != "" {
+		const prefix string = ",\"id_token\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.IdToken))
+	}
+	if in.MfaToken != "" {
+		const prefix string = ",\"mfa_token\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.MfaToken))
+	}
+	if in.MfaEnrolmentRequired {
+		const prefix string = ",\"mfa_enrolment_required\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.MfaEnrolmentRequired))
+	}
+	if in.PasswordChangeRequired {
+		const prefix string = ",\"password_change_required\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.PasswordChangeRequired))
+	}
 	if true {
 		const prefix string = ",\"userId\":"
 		if first {
@@ -266,6 +320,36 @@ func easyjson1158570fEncodeAssetsModulesAuthorizationModel(out *jwriter.Writer,
 		}
 		out.String(string(in.ParentId))
 	}
+	if in.SessionId != "" {
+		const prefix string = ",\"sid\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.SessionId))
+	}
+	if in.ClientId != "" {
+		const prefix string = ",\"client_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ClientId))
+	}
+	if in.Scope != "" {
+		const prefix string = ",\"scope\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Scope))
+	}
 	if in.Audience != "" {
 		const prefix string = ",\"aud\":"
 		if first {
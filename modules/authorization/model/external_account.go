@@ -0,0 +1,81 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+// ExternalAccount links the account of the external identity provider to the user
+type ExternalAccount struct {
+	ID            uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	UserID        uuid.UUID  `json:"userId,omitempty" gorm:"type:uuid;index"`
+	Provider      string     `json:"provider,omitempty" gorm:"uniqueIndex:idx_external_account_subject"`
+	Subject       string     `json:"subject,omitempty" gorm:"uniqueIndex:idx_external_account_subject"`
+	Email         string     `json:"email,omitempty"`
+	CreateDate    *time.Time `json:"createDate,omitempty"`
+	LastLoginDate *time.Time `json:"lastLoginDate,omitempty"`
+	commonModel.Versioned
+}
+
+func (account ExternalAccount) GetID() uuid.UUID {
+	return account.ID
+}
+
+func (account *ExternalAccount) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(account.ID) {
+		account.ID = uuid.New()
+	}
+	if account.CreateDate == nil {
+		now := time.Now()
+		account.CreateDate = &now
+	}
+	return nil
+}
+
+// ExternalIdentity is the user signed in by the external identity provider, the roles are the values
+// of the role claim of the provider
+type ExternalIdentity struct {
+	Provider          string   `json:"provider,omitempty"`
+	Subject           string   `json:"subject,omitempty"`
+	Email             string   `json:"email,omitempty"`
+	EmailVerified     bool     `json:"emailVerified,omitempty"`
+	GivenName         string   `json:"givenName,omitempty"`
+	FamilyName        string   `json:"familyName,omitempty"`
+	PreferredUsername string   `json:"preferredUsername,omitempty"`
+	Roles             []string `json:"roles,omitempty"`
+}
+
+// FederationState is kept between the redirect to the external identity provider and its callback
+type FederationState struct {
+	Provider     string `json:"provider,omitempty"`
+	Nonce        string `json:"nonce,omitempty"`
+	CodeVerifier string `json:"codeVerifier,omitempty"`
+	RedirectPath string `json:"redirectPath,omitempty"`
+}
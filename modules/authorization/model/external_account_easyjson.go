@@ -0,0 +1,479 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson6bbe6cc1DecodeAssetsModulesAuthorizationModel(in *jlexer.Lexer, out *FederationState) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "provider":
+			out.Provider = string(in.String())
+		case "nonce":
+			out.Nonce = string(in.String())
+		case "codeVerifier":
+			out.CodeVerifier = string(in.String())
+		case "redirectPath":
+			out.RedirectPath = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6bbe6cc1EncodeAssetsModulesAuthorizationModel(out *jwriter.Writer, in FederationState) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Provider != "" {
+		const prefix string = ",\"provider\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Provider))
+	}
+	if in.Nonce != "" {
+		const prefix string = ",\"nonce\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Nonce))
+	}
+	if in.CodeVerifier != "" {
+		const prefix string = ",\"codeVerifier\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.CodeVerifier))
+	}
+	if in.RedirectPath != "" {
+		const prefix string = ",\"redirectPath\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.RedirectPath))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FederationState) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6bbe6cc1EncodeAssetsModulesAuthorizationModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FederationState) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6bbe6cc1EncodeAssetsModulesAuthorizationModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FederationState) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6bbe6cc1DecodeAssetsModulesAuthorizationModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FederationState) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6bbe6cc1DecodeAssetsModulesAuthorizationModel(l, v)
+}
+func easyjson6bbe6cc1DecodeAssetsModulesAuthorizationModel1(in *jlexer.Lexer, out *ExternalIdentity) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "provider":
+			out.Provider = string(in.String())
+		case "subject":
+			out.Subject = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "emailVerified":
+			out.EmailVerified = bool(in.Bool())
+		case "givenName":
+			out.GivenName = string(in.String())
+		case "familyName":
+			out.FamilyName = string(in.String())
+		case "preferredUsername":
+			out.PreferredUsername = string(in.String())
+		case "roles":
+			if in.IsNull() {
+				in.Skip()
+				out.Roles = nil
+			} else {
+				in.Delim('[')
+				if out.Roles == nil {
+					if !in.IsDelim(']') {
+						out.Roles = make([]string, 0, 4)
+					} else {
+						out.Roles = []string{}
+					}
+				} else {
+					out.Roles = (out.Roles)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Roles = append(out.Roles, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6bbe6cc1EncodeAssetsModulesAuthorizationModel1(out *jwriter.Writer, in ExternalIdentity) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Provider != "" {
+		const prefix string = ",\"provider\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Provider))
+	}
+	if in.Subject != "" {
+		const prefix string = ",\"subject\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Subject))
+	}
+	if in.Email != "" {
+		const prefix string = ",\"email\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Email))
+	}
+	if in.EmailVerified {
+		const prefix string = ",\"emailVerified\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.EmailVerified))
+	}
+	if in.GivenName != "" {
+		const prefix string = ",\"givenName\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.GivenName))
+	}
+	if in.FamilyName != "" {
+		const prefix string = ",\"familyName\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.FamilyName))
+	}
+	if in.PreferredUsername != "" {
+		const prefix string = ",\"preferredUsername\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.PreferredUsername))
+	}
+	if len(in.Roles) != 0 {
+		const prefix string = ",\"roles\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Roles {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExternalIdentity) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6bbe6cc1EncodeAssetsModulesAuthorizationModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExternalIdentity) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6bbe6cc1EncodeAssetsModulesAuthorizationModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExternalIdentity) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6bbe6cc1DecodeAssetsModulesAuthorizationModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExternalIdentity) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6bbe6cc1DecodeAssetsModulesAuthorizationModel1(l, v)
+}
+func easyjson6bbe6cc1DecodeAssetsModulesAuthorizationModel2(in *jlexer.Lexer, out *ExternalAccount) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "userId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserID).UnmarshalText(data))
+			}
+		case "provider":
+			out.Provider = string(in.String())
+		case "subject":
+			out.Subject = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "createDate":
+			if in.IsNull() {
+				in.Skip()
+				out.CreateDate = nil
+			} else {
+				if out.CreateDate == nil {
+					out.CreateDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreateDate).UnmarshalJSON(data))
+				}
+			}
+		case "lastLoginDate":
+			if in.IsNull() {
+				in.Skip()
+				out.LastLoginDate = nil
+			} else {
+				if out.LastLoginDate == nil {
+					out.LastLoginDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.LastLoginDate).UnmarshalJSON(data))
+				}
+			}
+		case "version":
+			out.Version = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6bbe6cc1EncodeAssetsModulesAuthorizationModel2(out *jwriter.Writer, in ExternalAccount) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"userId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.UserID).MarshalText())
+	}
+	if in.Provider != "" {
+		const prefix string = ",\"provider\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Provider))
+	}
+	if in.Subject != "" {
+		const prefix string = ",\"subject\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Subject))
+	}
+	if in.Email != "" {
+		const prefix string = ",\"email\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Email))
+	}
+	if in.CreateDate != nil {
+		const prefix string = ",\"createDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.CreateDate).MarshalJSON())
+	}
+	if in.LastLoginDate != nil {
+		const prefix string = ",\"lastLoginDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.LastLoginDate).MarshalJSON())
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExternalAccount) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6bbe6cc1EncodeAssetsModulesAuthorizationModel2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExternalAccount) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6bbe6cc1EncodeAssetsModulesAuthorizationModel2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExternalAccount) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6bbe6cc1DecodeAssetsModulesAuthorizationModel2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExternalAccount) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6bbe6cc1DecodeAssetsModulesAuthorizationModel2(l, v)
+}
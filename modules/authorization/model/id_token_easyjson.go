@@ -0,0 +1,856 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson99b399dDecodeAssetsModulesAuthorizationModel(in *jlexer.Lexer, out *UserInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "sub":
+			out.Subject = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "given_name":
+			out.GivenName = string(in.String())
+		case "family_name":
+			out.FamilyName = string(in.String())
+		case "preferred_username":
+			out.PreferredUsername = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson99b399dEncodeAssetsModulesAuthorizationModel(out *jwriter.Writer, in UserInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Subject != "" {
+		const prefix string = ",\"sub\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Subject))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	if in.GivenName != "" {
+		const prefix string = ",\"given_name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.GivenName))
+	}
+	if in.FamilyName != "" {
+		const prefix string = ",\"family_name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.FamilyName))
+	}
+	if in.PreferredUsername != "" {
+		const prefix string = ",\"preferred_username\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.PreferredUsername))
+	}
+	if in.Email != "" {
+		const prefix string = ",\"email\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Email))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UserInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson99b399dEncodeAssetsModulesAuthorizationModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UserInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson99b399dEncodeAssetsModulesAuthorizationModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UserInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson99b399dDecodeAssetsModulesAuthorizationModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UserInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson99b399dDecodeAssetsModulesAuthorizationModel(l, v)
+}
+func easyjson99b399dDecodeAssetsModulesAuthorizationModel1(in *jlexer.Lexer, out *OpenIdConfiguration) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "issuer":
+			out.Issuer = string(in.String())
+		case "authorization_endpoint":
+			out.AuthorizationEndpoint = string(in.String())
+		case "token_endpoint":
+			out.TokenEndpoint = string(in.String())
+		case "userinfo_endpoint":
+			out.UserinfoEndpoint = string(in.String())
+		case "revocation_endpoint":
+			out.RevocationEndpoint = string(in.String())
+		case "end_session_endpoint":
+			out.EndSessionEndpoint = string(in.String())
+		case "jwks_uri":
+			out.JwksUri = string(in.String())
+		case "scopes_supported":
+			if in.IsNull() {
+				in.Skip()
+				out.ScopesSupported = nil
+			} else {
+				in.Delim('[')
+				if out.ScopesSupported == nil {
+					if !in.IsDelim(']') {
+						out.ScopesSupported = make([]string, 0, 4)
+					} else {
+						out.ScopesSupported = []string{}
+					}
+				} else {
+					out.ScopesSupported = (out.ScopesSupported)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.ScopesSupported = append(out.ScopesSupported, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "response_types_supported":
+			if in.IsNull() {
+				in.Skip()
+				out.ResponseTypesSupported = nil
+			} else {
+				in.Delim('[')
+				if out.ResponseTypesSupported == nil {
+					if !in.IsDelim(']') {
+						out.ResponseTypesSupported = make([]string, 0, 4)
+					} else {
+						out.ResponseTypesSupported = []string{}
+					}
+				} else {
+					out.ResponseTypesSupported = (out.ResponseTypesSupported)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 string
+					v2 = string(in.String())
+					out.ResponseTypesSupported = append(out.ResponseTypesSupported, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "grant_types_supported":
+			if in.IsNull() {
+				in.Skip()
+				out.GrantTypesSupported = nil
+			} else {
+				in.Delim('[')
+				if out.GrantTypesSupported == nil {
+					if !in.IsDelim(']') {
+						out.GrantTypesSupported = make([]string, 0, 4)
+					} else {
+						out.GrantTypesSupported = []string{}
+					}
+				} else {
+					out.GrantTypesSupported = (out.GrantTypesSupported)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 string
+					v3 = string(in.String())
+					out.GrantTypesSupported = append(out.GrantTypesSupported, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "subject_types_supported":
+			if in.IsNull() {
+				in.Skip()
+				out.SubjectTypesSupported = nil
+			} else {
+				in.Delim('[')
+				if out.SubjectTypesSupported == nil {
+					if !in.IsDelim(']') {
+						out.SubjectTypesSupported = make([]string, 0, 4)
+					} else {
+						out.SubjectTypesSupported = []string{}
+					}
+				} else {
+					out.SubjectTypesSupported = (out.SubjectTypesSupported)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 string
+					v4 = string(in.String())
+					out.SubjectTypesSupported = append(out.SubjectTypesSupported, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "id_token_signing_alg_values_supported":
+			if in.IsNull() {
+				in.Skip()
+				out.IdTokenSigningAlgValuesSupported = nil
+			} else {
+				in.Delim('[')
+				if out.IdTokenSigningAlgValuesSupported == nil {
+					if !in.IsDelim(']') {
+						out.IdTokenSigningAlgValuesSupported = make([]string, 0, 4)
+					} else {
+						out.IdTokenSigningAlgValuesSupported = []string{}
+					}
+				} else {
+					out.IdTokenSigningAlgValuesSupported = (out.IdTokenSigningAlgValuesSupported)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 string
+					v5 = string(in.String())
+					out.IdTokenSigningAlgValuesSupported = append(out.IdTokenSigningAlgValuesSupported, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "token_endpoint_auth_methods_supported":
+			if in.IsNull() {
+				in.Skip()
+				out.TokenEndpointAuthMethodsSupported = nil
+			} else {
+				in.Delim('[')
+				if out.TokenEndpointAuthMethodsSupported == nil {
+					if !in.IsDelim(']') {
+						out.TokenEndpointAuthMethodsSupported = make([]string, 0, 4)
+					} else {
+						out.TokenEndpointAuthMethodsSupported = []string{}
+					}
+				} else {
+					out.TokenEndpointAuthMethodsSupported = (out.TokenEndpointAuthMethodsSupported)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v6 string
+					v6 = string(in.String())
+					out.TokenEndpointAuthMethodsSupported = append(out.TokenEndpointAuthMethodsSupported, v6)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "code_challenge_methods_supported":
+			if in.IsNull() {
+				in.Skip()
+				out.CodeChallengeMethodsSupported = nil
+			} else {
+				in.Delim('[')
+				if out.CodeChallengeMethodsSupported == nil {
+					if !in.IsDelim(']') {
+						out.CodeChallengeMethodsSupported = make([]string, 0, 4)
+					} else {
+						out.CodeChallengeMethodsSupported = []string{}
+					}
+				} else {
+					out.CodeChallengeMethodsSupported = (out.CodeChallengeMethodsSupported)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 string
+					v7 = string(in.String())
+					out.CodeChallengeMethodsSupported = append(out.CodeChallengeMethodsSupported, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "claims_supported":
+			if in.IsNull() {
+				in.Skip()
+				out.ClaimsSupported = nil
+			} else {
+				in.Delim('[')
+				if out.ClaimsSupported == nil {
+					if !in.IsDelim(']') {
+						out.ClaimsSupported = make([]string, 0, 4)
+					} else {
+						out.ClaimsSupported = []string{}
+					}
+				} else {
+					out.ClaimsSupported = (out.ClaimsSupported)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v8 string
+					v8 = string(in.String())
+					out.ClaimsSupported = append(out.ClaimsSupported, v8)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson99b399dEncodeAssetsModulesAuthorizationModel1(out *jwriter.Writer, in OpenIdConfiguration) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Issuer != "" {
+		const prefix string = ",\"issuer\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Issuer))
+	}
+	if in.AuthorizationEndpoint != "" {
+		const prefix string = ",\"authorization_endpoint\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.AuthorizationEndpoint))
+	}
+	if in.TokenEndpoint != "" {
+		const prefix string = ",\"token_endpoint\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.TokenEndpoint))
+	}
+	if in.UserinfoEndpoint != "" {
+		const prefix string = ",\"userinfo_endpoint\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.UserinfoEndpoint))
+	}
+	if in.RevocationEndpoint != "" {
+		const prefix string = ",\"revocation_endpoint\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.RevocationEndpoint))
+	}
+	if in.EndSessionEndpoint != "" {
+		const prefix string = ",\"end_session_endpoint\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.EndSessionEndpoint))
+	}
+	if in.JwksUri != "" {
+		const prefix string = ",\"jwks_uri\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.JwksUri))
+	}
+	if len(in.ScopesSupported) != 0 {
+		const prefix string = ",\"scopes_supported\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v9, v10 := range in.ScopesSupported {
+				if v9 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v10))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.ResponseTypesSupported) != 0 {
+		const prefix string = ",\"response_types_supported\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v11, v12 := range in.ResponseTypesSupported {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v12))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.GrantTypesSupported) != 0 {
+		const prefix string = ",\"grant_types_supported\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v13, v14 := range in.GrantTypesSupported {
+				if v13 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v14))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.SubjectTypesSupported) != 0 {
+		const prefix string = ",\"subject_types_supported\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v15, v16 := range in.SubjectTypesSupported {
+				if v15 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v16))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.IdTokenSigningAlgValuesSupported) != 0 {
+		const prefix string = ",\"id_token_signing_alg_values_supported\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v17, v18 := range in.IdTokenSigningAlgValuesSupported {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v18))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.TokenEndpointAuthMethodsSupported) != 0 {
+		const prefix string = ",\"token_endpoint_auth_methods_supported\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v19, v20 := range in.TokenEndpointAuthMethodsSupported {
+				if v19 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v20))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.CodeChallengeMethodsSupported) != 0 {
+		const prefix string = ",\"code_challenge_methods_supported\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v21, v22 := range in.CodeChallengeMethodsSupported {
+				if v21 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v22))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.ClaimsSupported) != 0 {
+		const prefix string = ",\"claims_supported\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v23, v24 := range in.ClaimsSupported {
+				if v23 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v24))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OpenIdConfiguration) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson99b399dEncodeAssetsModulesAuthorizationModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OpenIdConfiguration) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson99b399dEncodeAssetsModulesAuthorizationModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OpenIdConfiguration) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson99b399dDecodeAssetsModulesAuthorizationModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OpenIdConfiguration) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson99b399dDecodeAssetsModulesAuthorizationModel1(l, v)
+}
+func easyjson99b399dDecodeAssetsModulesAuthorizationModel2(in *jlexer.Lexer, out *IdTokenClaims) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "nonce":
+			out.Nonce = string(in.String())
+		case "auth_time":
+			out.AuthTime = int64(in.Int64())
+		case "sid":
+			out.SessionId = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "given_name":
+			out.GivenName = string(in.String())
+		case "family_name":
+			out.FamilyName = string(in.String())
+		case "preferred_username":
+			out.PreferredUsername = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "aud":
+			out.Audience = string(in.String())
+		case "exp":
+			out.ExpiresAt = int64(in.Int64())
+		case "jti":
+			out.Id = string(in.String())
+		case "iat":
+			out.IssuedAt = int64(in.Int64())
+		case "iss":
+			out.Issuer = string(in.String())
+		case "nbf":
+			out.NotBefore = int64(in.Int64())
+		case "sub":
+			out.Subject = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson99b399dEncodeAssetsModulesAuthorizationModel2(out *jwriter.Writer, in IdTokenClaims) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Nonce != "" {
+		const prefix string = ",\"nonce\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Nonce))
+	}
+	if in.AuthTime != 0 {
+		const prefix string = ",\"auth_time\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.AuthTime))
+	}
+	if in.SessionId != "" {
+		const prefix string = ",\"sid\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.SessionId))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	if in.GivenName != "" {
+		const prefix string = ",\"given_name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.GivenName))
+	}
+	if in.FamilyName != "" {
+		const prefix string = ",\"family_name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.FamilyName))
+	}
+	if in.PreferredUsername != "" {
+		const prefix string = ",\"preferred_username\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.PreferredUsername))
+	}
+	if in.Email != "" {
+		const prefix string = ",\"email\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Email))
+	}
+	if in.Audience != "" {
+		const prefix string = ",\"aud\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Audience))
+	}
+	if in.ExpiresAt != 0 {
+		const prefix string = ",\"exp\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.ExpiresAt))
+	}
+	if in.Id != "" {
+		const prefix string = ",\"jti\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Id))
+	}
+	if in.IssuedAt != 0 {
+		const prefix string = ",\"iat\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.IssuedAt))
+	}
+	if in.Issuer != "" {
+		const prefix string = ",\"iss\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Issuer))
+	}
+	if in.NotBefore != 0 {
+		const prefix string = ",\"nbf\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.NotBefore))
+	}
+	if in.Subject != "" {
+		const prefix string = ",\"sub\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Subject))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IdTokenClaims) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson99b399dEncodeAssetsModulesAuthorizationModel2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IdTokenClaims) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson99b399dEncodeAssetsModulesAuthorizationModel2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IdTokenClaims) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson99b399dDecodeAssetsModulesAuthorizationModel2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IdTokenClaims) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson99b399dDecodeAssetsModulesAuthorizationModel2(l, v)
+}
@@ -30,12 +30,41 @@ var ReadAssetAuthority = NewAuthority("READ_ASSET", "Чтение активов
 var CreateAssetAuthority = NewAuthority("CREATE_ASSET", "Создание активов")
 var UpdateAssetAuthority = NewAuthority("UPDATE_ASSET", "Редактирование активов")
 var DeleteAssetAuthority = NewAuthority("DELETE_ASSET", "Удаление активов")
+var EditAssetShareAuthority = NewAuthority("EDIT_ASSET_SHARE", "Редактирование долей владения активами")
+var EditAssetGrantAuthority = NewAuthority("EDIT_ASSET_GRANT", "Управление доступом к активам")
+var ManageAllAssetsAuthority = NewAuthority("MANAGE_ALL_ASSETS", "Доступ ко всем активам и приходам/расходам")
 
 var ReadCashFlowAuthority = NewAuthority("READ_CASH_FLOW", "Чтение приходов/расходов")
 var CreateCashFlowAuthority = NewAuthority("CREATE_CASH_FLOW", "Создание приходов/расходов")
 var UpdateCashFlowAuthority = NewAuthority("UPDATE_CASH_FLOW", "Редактирование приходов/расходов")
 var DeleteCashFlowAuthority = NewAuthority("DELETE_CASH_FLOW", "Удаление приходов/расходов")
 
+var ReadWorkOrderAuthority = NewAuthority("READ_WORK_ORDER", "Чтение заказ-нарядов на обслуживание")
+var CreateWorkOrderAuthority = NewAuthority("CREATE_WORK_ORDER", "Создание заказ-нарядов на обслуживание")
+var UpdateWorkOrderAuthority = NewAuthority("UPDATE_WORK_ORDER", "Редактирование заказ-нарядов на обслуживание")
+var DeleteWorkOrderAuthority = NewAuthority("DELETE_WORK_ORDER", "Удаление заказ-нарядов на обслуживание")
+
+var ReadPreventiveTaskAuthority = NewAuthority("READ_PREVENTIVE_TASK", "Чтение регламентных работ")
+var CreatePreventiveTaskAuthority = NewAuthority("CREATE_PREVENTIVE_TASK", "Создание регламентных работ")
+var UpdatePreventiveTaskAuthority = NewAuthority("UPDATE_PREVENTIVE_TASK", "Редактирование регламентных работ")
+var DeletePreventiveTaskAuthority = NewAuthority("DELETE_PREVENTIVE_TASK", "Удаление регламентных работ")
+
+var ReadNotificationAuthority = NewAuthority("READ_NOTIFICATION", "Чтение уведомлений")
+var EditNotificationAuthority = NewAuthority("EDIT_NOTIFICATION", "Настройка уведомлений")
+
+var ReadReconciliationAuthority = NewAuthority("READ_RECONCILIATION", "Чтение банковских выписок и сверки")
+var EditReconciliationAuthority = NewAuthority("EDIT_RECONCILIATION", "Загрузка банковских выписок и сверка")
+
+var ReadInvestmentAuthority = NewAuthority("READ_INVESTMENT", "Чтение инструментов и портфелей ценных бумаг")
+var EditInvestmentAuthority = NewAuthority("EDIT_INVESTMENT", "Редактирование инструментов, котировок и сделок с ценными бумагами")
+var ReadDocumentAuthority = NewAuthority("READ_DOCUMENT", "Чтение документов активов")
+var EditDocumentAuthority = NewAuthority("EDIT_DOCUMENT", "Редактирование документов активов")
+
+var ReadTagAuthority = NewAuthority("READ_TAG", "Чтение меток")
+var EditTagAuthority = NewAuthority("EDIT_TAG", "Редактирование меток и их назначение активам и приходам/расходам")
+var ReadViewAuthority = NewAuthority("READ_VIEW", "Чтение сохранённых представлений")
+var EditViewAuthority = NewAuthority("EDIT_VIEW", "Редактирование сохранённых представлений и доступа к ним")
+
 var CreateAttachmentAuthority = NewAuthority("CREATE_ATTACHMENT", "Создание вложений")
 var DeleteAttachmentAuthority = NewAuthority("DELETE_ATTACHMENT", "Удаление вложений")
 
@@ -55,6 +84,12 @@ var UpdateUserAuthority = NewAuthority("UPDATE_USER", "Редактирован
 var DeleteUserAuthority = NewAuthority("DELETE_USER", "Удаление пользователей")
 var EditRoleUserAuthority = NewAuthority("EDIT_ROLE_USER", "Редактирование ролей пользователей")
 var EditAuthorityUserAuthority = NewAuthority("EDIT_AUTHORITY_USER", "Редактирование прав пользователей")
+var ReadLoginEventAuthority = NewAuthority("READ_LOGIN_EVENT", "Чтение журнала входов в систему")
+var ReadApiKeyAuthority = NewAuthority("READ_API_KEY", "Чтение API ключей сервисных учётных записей")
+var EditApiKeyAuthority = NewAuthority("EDIT_API_KEY", "Выпуск и отзыв API ключей сервисных учётных записей")
+
+var ReadOAuthClientAuthority = NewAuthority("READ_OAUTH_CLIENT", "Чтение OAuth клиентов")
+var EditOAuthClientAuthority = NewAuthority("EDIT_OAUTH_CLIENT", "Регистрация и редактирование OAuth клиентов и их секретов")
 
 var ReadCityAuthority = NewAuthority("READ_CITY", "Чтение городов")
 var CreateCityAuthority = NewAuthority("CREATE_CITY", "Создание городов")
@@ -72,12 +107,22 @@ var UpdateCurrencyAuthority = NewAuthority("UPDATE_CURRENCY", "Редактир
 var DeleteCurrencyAuthority = NewAuthority("DELETE_CURRENCY", "Удаление валют")
 
 var AdminRole = NewRole("ADMIN", "Роль админа", RoleWithAuthorities(
-	&ReadAssetAuthority, &CreateAssetAuthority, &UpdateAssetAuthority, &DeleteAssetAuthority,
+	&ReadAssetAuthority, &CreateAssetAuthority, &UpdateAssetAuthority, &DeleteAssetAuthority, &EditAssetShareAuthority,
+	&EditAssetGrantAuthority, &ManageAllAssetsAuthority,
 	&ReadCashFlowAuthority, &CreateCashFlowAuthority, &UpdateCashFlowAuthority, &DeleteCashFlowAuthority,
+	&ReadWorkOrderAuthority, &CreateWorkOrderAuthority, &UpdateWorkOrderAuthority, &DeleteWorkOrderAuthority,
+	&ReadPreventiveTaskAuthority, &CreatePreventiveTaskAuthority, &UpdatePreventiveTaskAuthority, &DeletePreventiveTaskAuthority,
+	&ReadNotificationAuthority, &EditNotificationAuthority,
+	&ReadReconciliationAuthority, &EditReconciliationAuthority,
+	&ReadInvestmentAuthority, &EditInvestmentAuthority,
+	&ReadDocumentAuthority, &EditDocumentAuthority,
+	&ReadTagAuthority, &EditTagAuthority,
+	&ReadViewAuthority, &EditViewAuthority,
 	&CreateAttachmentAuthority, &DeleteAttachmentAuthority,
 	&ReadAuthorityAuthority, &CreateAuthorityAuthority, &UpdateAuthorityAuthority, &DeleteAuthorityAuthority,
 	&ReadRoleAuthority, &CreateRoleAuthority, &UpdateRoleAuthority, &DeleteRoleAuthority,
-	&ReadUserAuthority, &CreateUserAuthority, &UpdateUserAuthority, &DeleteUserAuthority, &EditRoleUserAuthority, &EditAuthorityUserAuthority,
+	&ReadUserAuthority, &CreateUserAuthority, &UpdateUserAuthority, &DeleteUserAuthority, &EditRoleUserAuthority, &EditAuthorityUserAuthority, &ReadLoginEventAuthority, &ReadApiKeyAuthority, &EditApiKeyAuthority,
+	&ReadOAuthClientAuthority, &EditOAuthClientAuthority,
 	&ReadCityAuthority, &CreateCityAuthority, &UpdateCityAuthority, &DeleteCityAuthority,
 	&ReadCountryAuthority, &CreateCountryAuthority, &UpdateCountryAuthority, &DeleteCountryAuthority,
 	&ReadCurrencyAuthority, &CreateCurrencyAuthority, &UpdateCurrencyAuthority, &DeleteCurrencyAuthority,
@@ -85,4 +130,5 @@ var AdminRole = NewRole("ADMIN", "Роль админа", RoleWithAuthorities(
 
 var InternalUserRole = NewRole("USER", "Роль пользователя", RoleWithAuthorities(
 	&ReadUserAuthority,
+	&ReadNotificationAuthority, &EditNotificationAuthority,
 ))
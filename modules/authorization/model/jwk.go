@@ -0,0 +1,128 @@
+package model
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+// Jwk is the public part of the signing key published in the JWK set (RFC 7517)
+type Jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type JwkSet struct {
+	Keys []Jwk `json:"keys"`
+}
+
+func NewJwk(key SigningKey) (Jwk, error) {
+	signer, err := key.GetSigner()
+	if err != nil {
+		return Jwk{}, err
+	}
+
+	jwk := Jwk{Kid: key.ID.String(), Use: "sig", Alg: key.Algorithm}
+	switch publicKey := signer.Public().(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = encodeJwkValue(publicKey.N.Bytes())
+		jwk.E = encodeJwkValue(big.NewInt(int64(publicKey.E)).Bytes())
+	case *ecdsa.PublicKey:
+		size := (publicKey.Curve.Params().BitSize + 7) / 8
+		jwk.Kty, jwk.Crv = "EC", publicKey.Curve.Params().Name
+		jwk.X = encodeJwkValue(publicKey.X.FillBytes(make([]byte, size)))
+		jwk.Y = encodeJwkValue(publicKey.Y.FillBytes(make([]byte, size)))
+	case ed25519.PublicKey:
+		jwk.Kty, jwk.Crv = "OKP", "Ed25519"
+		jwk.X = encodeJwkValue(publicKey)
+	default:
+		return Jwk{}, fmt.Errorf("unsupported public key of signing key %s", key.ID)
+	}
+	return jwk, nil
+}
+
+// GetPublicKey returns the public key of the JWK published by the external identity provider
+func (jwk Jwk) GetPublicKey() (any, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeJwkValue(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeJwkValue(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		curves := map[string]elliptic.Curve{"P-256": elliptic.P256(), "P-384": elliptic.P384(), "P-521": elliptic.P521()}
+		curve, ok := curves[jwk.Crv]
+		if !ok {
+			return nil, fmt.Errorf("unsupported curve %s of key %s", jwk.Crv, jwk.Kid)
+		}
+		x, err := decodeJwkValue(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeJwkValue(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	case "OKP":
+		x, err := decodeJwkValue(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		if jwk.Crv != "Ed25519" || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("unsupported curve %s of key %s", jwk.Crv, jwk.Kid)
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s of key %s", jwk.Kty, jwk.Kid)
+	}
+}
+
+func encodeJwkValue(value []byte) string {
+	return base64.RawURLEncoding.EncodeToString(value)
+}
+
+func decodeJwkValue(value string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(value)
+}
@@ -0,0 +1,239 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson52b8508aDecodeAssetsModulesAuthorizationModel(in *jlexer.Lexer, out *JwkSet) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "keys":
+			if in.IsNull() {
+				in.Skip()
+				out.Keys = nil
+			} else {
+				in.Delim('[')
+				if out.Keys == nil {
+					if !in.IsDelim(']') {
+						out.Keys = make([]Jwk, 0, 0)
+					} else {
+						out.Keys = []Jwk{}
+					}
+				} else {
+					out.Keys = (out.Keys)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 Jwk
+					(v1).UnmarshalEasyJSON(in)
+					out.Keys = append(out.Keys, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson52b8508aEncodeAssetsModulesAuthorizationModel(out *jwriter.Writer, in JwkSet) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"keys\":"
+		out.RawString(prefix[1:])
+		if in.Keys == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Keys {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v JwkSet) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson52b8508aEncodeAssetsModulesAuthorizationModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v JwkSet) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson52b8508aEncodeAssetsModulesAuthorizationModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *JwkSet) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson52b8508aDecodeAssetsModulesAuthorizationModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *JwkSet) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson52b8508aDecodeAssetsModulesAuthorizationModel(l, v)
+}
+func easyjson52b8508aDecodeAssetsModulesAuthorizationModel1(in *jlexer.Lexer, out *Jwk) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "kty":
+			out.Kty = string(in.String())
+		case "kid":
+			out.Kid = string(in.String())
+		case "use":
+			out.Use = string(in.String())
+		case "alg":
+			out.Alg = string(in.String())
+		case "n":
+			out.N = string(in.String())
+		case "e":
+			out.E = string(in.String())
+		case "crv":
+			out.Crv = string(in.String())
+		case "x":
+			out.X = string(in.String())
+		case "y":
+			out.Y = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson52b8508aEncodeAssetsModulesAuthorizationModel1(out *jwriter.Writer, in Jwk) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"kty\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kty))
+	}
+	{
+		const prefix string = ",\"kid\":"
+		out.RawString(prefix)
+		out.String(string(in.Kid))
+	}
+	{
+		const prefix string = ",\"use\":"
+		out.RawString(prefix)
+		out.String(string(in.Use))
+	}
+	{
+		const prefix string = ",\"alg\":"
+		out.RawString(prefix)
+		out.String(string(in.Alg))
+	}
+	if in.N != "" {
+		const prefix string = ",\"n\":"
+		out.RawString(prefix)
+		out.String(string(in.N))
+	}
+	if in.E != "" {
+		const prefix string = ",\"e\":"
+		out.RawString(prefix)
+		out.String(string(in.E))
+	}
+	if in.Crv != "" {
+		const prefix string = ",\"crv\":"
+		out.RawString(prefix)
+		out.String(string(in.Crv))
+	}
+	if in.X != "" {
+		const prefix string = ",\"x\":"
+		out.RawString(prefix)
+		out.String(string(in.X))
+	}
+	if in.Y != "" {
+		const prefix string = ",\"y\":"
+		out.RawString(prefix)
+		out.String(string(in.Y))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Jwk) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson52b8508aEncodeAssetsModulesAuthorizationModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Jwk) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson52b8508aEncodeAssetsModulesAuthorizationModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Jwk) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson52b8508aDecodeAssetsModulesAuthorizationModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Jwk) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson52b8508aDecodeAssetsModulesAuthorizationModel1(l, v)
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const (
+	LoginSucceededEvent  = "LOGIN_SUCCEEDED"
+	LoginFailedEvent     = "LOGIN_FAILED"
+	AccountLockedEvent   = "ACCOUNT_LOCKED"
+	AccountUnlockedEvent = "ACCOUNT_UNLOCKED"
+)
+
+// LoginSource is the client the login attempt comes from
+type LoginSource struct {
+	Ip        string
+	UserAgent string
+}
+
+// LoginEvent is the audit entry of the login attempt or of the change of the account lock. The username
+// is stored as it was entered, the user is known only when the username exists
+type LoginEvent struct {
+	ID         uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	Username   string     `json:"username,omitempty" gorm:"index"`
+	UserID     *uuid.UUID `json:"userId,omitempty" gorm:"type:uuid"`
+	Event      string     `json:"event,omitempty"`
+	Ip         string     `json:"ip,omitempty"`
+	UserAgent  string     `json:"userAgent,omitempty"`
+	Details    string     `json:"details,omitempty"`
+	CreateDate *time.Time `json:"createDate,omitempty" gorm:"index"`
+	commonModel.Versioned
+}
+
+func NewLoginEvent(event string, username string, source LoginSource) LoginEvent {
+	return LoginEvent{Event: event, Username: username, Ip: source.Ip, UserAgent: source.UserAgent}
+}
+
+func (event LoginEvent) GetID() uuid.UUID {
+	return event.ID
+}
+
+func (event *LoginEvent) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(event.ID) {
+		event.ID = uuid.New()
+	}
+	if event.CreateDate == nil {
+		now := time.Now()
+		event.CreateDate = &now
+	}
+	return nil
+}
@@ -0,0 +1,279 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	uuid "github.com/google/uuid"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson4e11654DecodeAssetsModulesAuthorizationModel(in *jlexer.Lexer, out *LoginSource) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Ip":
+			out.Ip = string(in.String())
+		case "UserAgent":
+			out.UserAgent = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4e11654EncodeAssetsModulesAuthorizationModel(out *jwriter.Writer, in LoginSource) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Ip\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Ip))
+	}
+	{
+		const prefix string = ",\"UserAgent\":"
+		out.RawString(prefix)
+		out.String(string(in.UserAgent))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LoginSource) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4e11654EncodeAssetsModulesAuthorizationModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LoginSource) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4e11654EncodeAssetsModulesAuthorizationModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LoginSource) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4e11654DecodeAssetsModulesAuthorizationModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LoginSource) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4e11654DecodeAssetsModulesAuthorizationModel(l, v)
+}
+func easyjson4e11654DecodeAssetsModulesAuthorizationModel1(in *jlexer.Lexer, out *LoginEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "username":
+			out.Username = string(in.String())
+		case "userId":
+			if in.IsNull() {
+				in.Skip()
+				out.UserID = nil
+			} else {
+				if out.UserID == nil {
+					out.UserID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.UserID).UnmarshalText(data))
+				}
+			}
+		case "event":
+			out.Event = string(in.String())
+		case "ip":
+			out.Ip = string(in.String())
+		case "userAgent":
+			out.UserAgent = string(in.String())
+		case "details":
+			out.Details = string(in.String())
+		case "createDate":
+			if in.IsNull() {
+				in.Skip()
+				out.CreateDate = nil
+			} else {
+				if out.CreateDate == nil {
+					out.CreateDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreateDate).UnmarshalJSON(data))
+				}
+			}
+		case "version":
+			out.Version = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4e11654EncodeAssetsModulesAuthorizationModel1(out *jwriter.Writer, in LoginEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	if in.Username != "" {
+		const prefix string = ",\"username\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Username))
+	}
+	if in.UserID != nil {
+		const prefix string = ",\"userId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((*in.UserID).MarshalText())
+	}
+	if in.Event != "" {
+		const prefix string = ",\"event\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Event))
+	}
+	if in.Ip != "" {
+		const prefix string = ",\"ip\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Ip))
+	}
+	if in.UserAgent != "" {
+		const prefix string = ",\"userAgent\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.UserAgent))
+	}
+	if in.Details != "" {
+		const prefix string = ",\"details\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Details))
+	}
+	if in.CreateDate != nil {
+		const prefix string = ",\"createDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.CreateDate).MarshalJSON())
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LoginEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4e11654EncodeAssetsModulesAuthorizationModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LoginEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4e11654EncodeAssetsModulesAuthorizationModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LoginEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4e11654DecodeAssetsModulesAuthorizationModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LoginEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4e11654DecodeAssetsModulesAuthorizationModel1(l, v)
+}
@@ -0,0 +1,122 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"strings"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const (
+	AuthorizationCodeGrant = "authorization_code"
+	RefreshTokenGrant      = "refresh_token"
+	ClientCredentialsGrant = "client_credentials"
+	PasswordGrant          = "password"
+	MfaOtpGrant            = "mfa_otp"
+)
+
+const (
+	OpenIdScope  = "openid"
+	ProfileScope = "profile"
+	EmailScope   = "email"
+)
+
+var Scopes = []string{OpenIdScope, ProfileScope, EmailScope}
+
+// OAuthClient is an application registered to obtain tokens. Public clients (mobile and single page
+// applications) have no secret and must use PKCE. Tokens of the client credentials grant are issued
+// on behalf of the service user of the client
+type OAuthClient struct {
+	ID            uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	Name          string     `json:"name,omitempty"`
+	Secret        string     `json:"secret,omitempty" gorm:"-"`
+	SecretHash    string     `json:"-"`
+	IsPublic      bool       `json:"isPublic,omitempty"`
+	RedirectUris  []string   `json:"redirectUris,omitempty" gorm:"serializer:json"`
+	GrantTypes    []string   `json:"grantTypes,omitempty" gorm:"serializer:json"`
+	Scopes        []string   `json:"scopes,omitempty" gorm:"serializer:json"`
+	ServiceUserID *uuid.UUID `json:"serviceUserId,omitempty" gorm:"type:uuid"`
+	commonModel.Versioned
+}
+
+func (client OAuthClient) GetID() uuid.UUID {
+	return client.ID
+}
+
+func (client *OAuthClient) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(client.ID) {
+		client.ID = uuid.New()
+	}
+	return nil
+}
+
+func (client OAuthClient) IsValid() bool {
+	if client.Name == "" || len(client.GrantTypes) == 0 {
+		return false
+	}
+	for _, grantType := range client.GrantTypes {
+		if !commonUtil.ArrayContains([]string{AuthorizationCodeGrant, RefreshTokenGrant, ClientCredentialsGrant}, grantType) {
+			return false
+		}
+	}
+	for _, scope := range client.Scopes {
+		if fields := strings.Fields(scope); len(fields) != 1 || fields[0] != scope {
+			return false
+		}
+	}
+	if client.HasGrantType(AuthorizationCodeGrant) && len(client.RedirectUris) == 0 {
+		return false
+	}
+	// a public client can't keep the credentials of the service user
+	return !client.HasGrantType(ClientCredentialsGrant) || (!client.IsPublic && client.ServiceUserID != nil)
+}
+
+// GetAuthorityScopes returns the scopes besides the OpenID Connect ones, they name the authorities
+// of the user the tokens of the client may carry
+func (client OAuthClient) GetAuthorityScopes() []string {
+	return commonUtil.ArrayFilter(client.Scopes, func(scope string) bool { return !commonUtil.ArrayContains(Scopes, scope) })
+}
+
+func (client OAuthClient) HasGrantType(grantType string) bool {
+	return commonUtil.ArrayContains(client.GrantTypes, grantType)
+}
+
+func (client OAuthClient) HasRedirectUri(redirectUri string) bool {
+	return commonUtil.ArrayContains(client.RedirectUris, redirectUri)
+}
+
+// GetAllowedScope returns the requested scopes allowed to the client separated by spaces
+func (client OAuthClient) GetAllowedScope(scope string) string {
+	var result []string
+	for _, it := range strings.Fields(scope) {
+		if commonUtil.ArrayContains(client.Scopes, it) && !commonUtil.ArrayContains(result, it) {
+			result = append(result, it)
+		}
+	}
+	return strings.Join(result, " ")
+}
@@ -0,0 +1,285 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	uuid "github.com/google/uuid"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonE5d7ad5DecodeAssetsModulesAuthorizationModel(in *jlexer.Lexer, out *OAuthClient) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "secret":
+			out.Secret = string(in.String())
+		case "isPublic":
+			out.IsPublic = bool(in.Bool())
+		case "redirectUris":
+			if in.IsNull() {
+				in.Skip()
+				out.RedirectUris = nil
+			} else {
+				in.Delim('[')
+				if out.RedirectUris == nil {
+					if !in.IsDelim(']') {
+						out.RedirectUris = make([]string, 0, 4)
+					} else {
+						out.RedirectUris = []string{}
+					}
+				} else {
+					out.RedirectUris = (out.RedirectUris)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.RedirectUris = append(out.RedirectUris, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "grantTypes":
+			if in.IsNull() {
+				in.Skip()
+				out.GrantTypes = nil
+			} else {
+				in.Delim('[')
+				if out.GrantTypes == nil {
+					if !in.IsDelim(']') {
+						out.GrantTypes = make([]string, 0, 4)
+					} else {
+						out.GrantTypes = []string{}
+					}
+				} else {
+					out.GrantTypes = (out.GrantTypes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 string
+					v2 = string(in.String())
+					out.GrantTypes = append(out.GrantTypes, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "scopes":
+			if in.IsNull() {
+				in.Skip()
+				out.Scopes = nil
+			} else {
+				in.Delim('[')
+				if out.Scopes == nil {
+					if !in.IsDelim(']') {
+						out.Scopes = make([]string, 0, 4)
+					} else {
+						out.Scopes = []string{}
+					}
+				} else {
+					out.Scopes = (out.Scopes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 string
+					v3 = string(in.String())
+					out.Scopes = append(out.Scopes, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "serviceUserId":
+			if in.IsNull() {
+				in.Skip()
+				out.ServiceUserID = nil
+			} else {
+				if out.ServiceUserID == nil {
+					out.ServiceUserID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.ServiceUserID).UnmarshalText(data))
+				}
+			}
+		case "version":
+			out.Version = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE5d7ad5EncodeAssetsModulesAuthorizationModel(out *jwriter.Writer, in OAuthClient) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	if in.Secret != "" {
+		const prefix string = ",\"secret\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Secret))
+	}
+	if in.IsPublic {
+		const prefix string = ",\"isPublic\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.IsPublic))
+	}
+	if len(in.RedirectUris) != 0 {
+		const prefix string = ",\"redirectUris\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v4, v5 := range in.RedirectUris {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v5))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.GrantTypes) != 0 {
+		const prefix string = ",\"grantTypes\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v6, v7 := range in.GrantTypes {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v7))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Scopes) != 0 {
+		const prefix string = ",\"scopes\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.Scopes {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.ServiceUserID != nil {
+		const prefix string = ",\"serviceUserId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((*in.ServiceUserID).MarshalText())
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OAuthClient) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE5d7ad5EncodeAssetsModulesAuthorizationModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OAuthClient) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE5d7ad5EncodeAssetsModulesAuthorizationModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OAuthClient) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE5d7ad5DecodeAssetsModulesAuthorizationModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OAuthClient) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE5d7ad5DecodeAssetsModulesAuthorizationModel(l, v)
+}
@@ -0,0 +1,70 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+// OAuthConsent is the scopes the user allowed the client to access, the user is asked again
+// when the client requests other scopes
+type OAuthConsent struct {
+	ID         uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	UserID     uuid.UUID  `json:"userId,omitempty" gorm:"type:uuid;uniqueIndex:idx_oauth_consent_user_client"`
+	ClientID   uuid.UUID  `json:"clientId,omitempty" gorm:"type:uuid;uniqueIndex:idx_oauth_consent_user_client"`
+	Scopes     []string   `json:"scopes,omitempty" gorm:"serializer:json"`
+	CreateDate *time.Time `json:"createDate,omitempty"`
+	commonModel.Versioned
+}
+
+func (consent OAuthConsent) GetID() uuid.UUID {
+	return consent.ID
+}
+
+func (consent *OAuthConsent) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(consent.ID) {
+		consent.ID = uuid.New()
+	}
+	if consent.CreateDate == nil {
+		now := time.Now()
+		consent.CreateDate = &now
+	}
+	return nil
+}
+
+// Covers tells whether all scopes separated by spaces are allowed
+func (consent OAuthConsent) Covers(scope string) bool {
+	for _, it := range strings.Fields(scope) {
+		if !commonUtil.ArrayContains(consent.Scopes, it) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,358 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonBf87f2d7DecodeAssetsModulesAuthorizationModel(in *jlexer.Lexer, out *PasswordResetRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "email":
+			out.Email = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBf87f2d7EncodeAssetsModulesAuthorizationModel(out *jwriter.Writer, in PasswordResetRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Email))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PasswordResetRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBf87f2d7EncodeAssetsModulesAuthorizationModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PasswordResetRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBf87f2d7EncodeAssetsModulesAuthorizationModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PasswordResetRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBf87f2d7DecodeAssetsModulesAuthorizationModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PasswordResetRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBf87f2d7DecodeAssetsModulesAuthorizationModel(l, v)
+}
+func easyjsonBf87f2d7DecodeAssetsModulesAuthorizationModel1(in *jlexer.Lexer, out *PasswordReset) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "token":
+			out.Token = string(in.String())
+		case "newPassword":
+			out.NewPassword = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBf87f2d7EncodeAssetsModulesAuthorizationModel1(out *jwriter.Writer, in PasswordReset) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Token))
+	}
+	{
+		const prefix string = ",\"newPassword\":"
+		out.RawString(prefix)
+		out.String(string(in.NewPassword))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PasswordReset) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBf87f2d7EncodeAssetsModulesAuthorizationModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PasswordReset) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBf87f2d7EncodeAssetsModulesAuthorizationModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PasswordReset) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBf87f2d7DecodeAssetsModulesAuthorizationModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PasswordReset) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBf87f2d7DecodeAssetsModulesAuthorizationModel1(l, v)
+}
+func easyjsonBf87f2d7DecodeAssetsModulesAuthorizationModel2(in *jlexer.Lexer, out *PasswordPolicy) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "minLength":
+			out.MinLength = int(in.Int())
+		case "maxLength":
+			out.MaxLength = int(in.Int())
+		case "requireUppercase":
+			out.RequireUppercase = bool(in.Bool())
+		case "requireLowercase":
+			out.RequireLowercase = bool(in.Bool())
+		case "requireDigit":
+			out.RequireDigit = bool(in.Bool())
+		case "requireSpecial":
+			out.RequireSpecial = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBf87f2d7EncodeAssetsModulesAuthorizationModel2(out *jwriter.Writer, in PasswordPolicy) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.MinLength != 0 {
+		const prefix string = ",\"minLength\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.MinLength))
+	}
+	if in.MaxLength != 0 {
+		const prefix string = ",\"maxLength\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.MaxLength))
+	}
+	if in.RequireUppercase {
+		const prefix string = ",\"requireUppercase\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.RequireUppercase))
+	}
+	if in.RequireLowercase {
+		const prefix string = ",\"requireLowercase\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.RequireLowercase))
+	}
+	if in.RequireDigit {
+		const prefix string = ",\"requireDigit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.RequireDigit))
+	}
+	if in.RequireSpecial {
+		const prefix string = ",\"requireSpecial\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.RequireSpecial))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PasswordPolicy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBf87f2d7EncodeAssetsModulesAuthorizationModel2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PasswordPolicy) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBf87f2d7EncodeAssetsModulesAuthorizationModel2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PasswordPolicy) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBf87f2d7DecodeAssetsModulesAuthorizationModel2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PasswordPolicy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBf87f2d7DecodeAssetsModulesAuthorizationModel2(l, v)
+}
+func easyjsonBf87f2d7DecodeAssetsModulesAuthorizationModel3(in *jlexer.Lexer, out *PasswordChangeRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "currentPassword":
+			out.CurrentPassword = string(in.String())
+		case "newPassword":
+			out.NewPassword = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBf87f2d7EncodeAssetsModulesAuthorizationModel3(out *jwriter.Writer, in PasswordChangeRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"currentPassword\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.CurrentPassword))
+	}
+	{
+		const prefix string = ",\"newPassword\":"
+		out.RawString(prefix)
+		out.String(string(in.NewPassword))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PasswordChangeRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBf87f2d7EncodeAssetsModulesAuthorizationModel3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PasswordChangeRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBf87f2d7EncodeAssetsModulesAuthorizationModel3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PasswordChangeRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBf87f2d7DecodeAssetsModulesAuthorizationModel3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PasswordChangeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBf87f2d7DecodeAssetsModulesAuthorizationModel3(l, v)
+}
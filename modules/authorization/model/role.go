@@ -1,6 +1,7 @@
 package model
 
 import (
+	commonModel "assets/common/model"
 	commonUtil "assets/common/util"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -37,6 +38,9 @@ type Role struct {
 	Name        string       `json:"name,omitempty"`
 	Description string       `json:"description,omitempty"`
 	Authorities []*Authority `json:"authorities,omitempty" gorm:"many2many:role_authority;"`
+	// RequiresMfa obliges the users of the role to sign in with the second factor
+	RequiresMfa bool `json:"requiresMfa,omitempty"`
+	commonModel.Versioned
 }
 
 func (role Role) GetID() uuid.UUID {
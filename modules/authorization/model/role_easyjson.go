@@ -75,6 +75,10 @@ func easyjsonC1e36854DecodeAssetsModulesAuthorizationModel(in *jlexer.Lexer, out
 				}
 				in.Delim(']')
 			}
+		case "requiresMfa":
+			out.RequiresMfa = bool(in.Bool())
+		case "version":
+			out.Version = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -138,6 +142,26 @@ func easyjsonC1e36854EncodeAssetsModulesAuthorizationModel(out *jwriter.Writer,
 			out.RawByte(']')
 		}
 	}
+	if in.RequiresMfa {
+		const prefix string = ",\"requiresMfa\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.RequiresMfa))
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
 	out.RawByte('}')
 }
 
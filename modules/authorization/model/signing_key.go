@@ -0,0 +1,110 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+// SigningKey is a key pair signing the tokens, its id is the kid header of the tokens. The newest key
+// signs the tokens, the retired keys only verify the tokens issued before the rotation
+type SigningKey struct {
+	ID         uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	Algorithm  string     `json:"algorithm,omitempty"`
+	PrivateKey []byte     `json:"-" gorm:"not null;serializer:encrypted"`
+	CreateDate *time.Time `json:"createDate,omitempty"`
+	RetireDate *time.Time `json:"retireDate,omitempty" gorm:"index"`
+	commonModel.Versioned
+}
+
+func (key SigningKey) GetID() uuid.UUID {
+	return key.ID
+}
+
+func (key *SigningKey) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(key.ID) {
+		key.ID = uuid.New()
+	}
+	if key.CreateDate == nil {
+		now := time.Now()
+		key.CreateDate = &now
+	}
+	return nil
+}
+
+// GetSigner parses the private key stored in PKCS #8 form
+func (key SigningKey) GetSigner() (crypto.Signer, error) {
+	privateKey, err := x509.ParsePKCS8PrivateKey(key.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key of signing key %s", key.ID)
+	}
+	return signer, nil
+}
+
+// NewSigningKey generates a key pair for the algorithm: RS*, PS*, ES* or EdDSA
+func NewSigningKey(algorithm string) (SigningKey, error) {
+	var privateKey any
+	var err error
+	switch {
+	case strings.HasPrefix(algorithm, "RS"), strings.HasPrefix(algorithm, "PS"):
+		privateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	case algorithm == "ES256":
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case algorithm == "ES384":
+		privateKey, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case algorithm == "ES512":
+		privateKey, err = ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	case algorithm == "EdDSA":
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		err = fmt.Errorf("unsupported signing algorithm %s", algorithm)
+	}
+	if err != nil {
+		return SigningKey{}, err
+	}
+
+	encoded, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return SigningKey{}, err
+	}
+	return SigningKey{Algorithm: algorithm, PrivateKey: encoded}, nil
+}
@@ -25,12 +25,13 @@ package model
  */
 
 import (
+	commonModel "assets/common/model"
 	commonUtil "assets/common/util"
 	"fmt"
 	"github.com/google/uuid"
-	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 	"strings"
+	"time"
 )
 
 var NilUser = User{}
@@ -46,6 +47,12 @@ type User struct {
 	Roles                 []*Role      `json:"roles,omitempty" gorm:"many2many:user_role;"`
 	AdditionalAuthorities []*Authority `json:"authorities,omitempty" gorm:"many2many:user_additional_authority;"`
 	IsBlocked             bool         `json:"isBlocked,omitempty"`
+	// PasswordChangeRequired makes the user choose a new password on the next login
+	PasswordChangeRequired bool       `json:"passwordChangeRequired,omitempty"`
+	PasswordChangeDate     *time.Time `json:"passwordChangeDate,omitempty"`
+	// IsServiceAccount is the user of the integrations, it signs in by the api keys only
+	IsServiceAccount bool `json:"isServiceAccount,omitempty"`
+	commonModel.Versioned
 }
 
 func (user User) GetID() uuid.UUID {
@@ -82,9 +89,16 @@ func NewUser(username string, firstName string, lastName string, email string, o
 type UserOption func(*User)
 
 func UserWithPassword(password string) UserOption {
-	pwdHash, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	pwdHash := commonUtil.MustOne(commonUtil.HashPassword(password))
 	return func(user *User) {
-		user.Password = string(pwdHash)
+		now := time.Now()
+		user.Password, user.PasswordChangeDate = pwdHash, &now
+	}
+}
+
+func UserWithPasswordChangeRequired() UserOption {
+	return func(user *User) {
+		user.PasswordChangeRequired = true
 	}
 }
 
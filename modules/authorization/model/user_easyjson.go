@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -114,6 +115,24 @@ func easyjson9e1087fdDecodeAssetsModulesAuthorizationModel(in *jlexer.Lexer, out
 			}
 		case "isBlocked":
 			out.IsBlocked = bool(in.Bool())
+		case "passwordChangeRequired":
+			out.PasswordChangeRequired = bool(in.Bool())
+		case "passwordChangeDate":
+			if in.IsNull() {
+				in.Skip()
+				out.PasswordChangeDate = nil
+			} else {
+				if out.PasswordChangeDate == nil {
+					out.PasswordChangeDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PasswordChangeDate).UnmarshalJSON(data))
+				}
+			}
+		case "isServiceAccount":
+			out.IsServiceAccount = bool(in.Bool())
+		case "version":
+			out.Version = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -240,6 +259,46 @@ func easyjson9e1087fdEncodeAssetsModulesAuthorizationModel(out *jwriter.Writer,
 		}
 		out.Bool(bool(in.IsBlocked))
 	}
+	if in.PasswordChangeRequired {
+		const prefix string = ",\"passwordChangeRequired\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.PasswordChangeRequired))
+	}
+	if in.PasswordChangeDate != nil {
+		const prefix string = ",\"passwordChangeDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.PasswordChangeDate).MarshalJSON())
+	}
+	if in.IsServiceAccount {
+		const prefix string = ",\"isServiceAccount\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.IsServiceAccount))
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
 	out.RawByte('}')
 }
 
@@ -0,0 +1,83 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const RecoveryCodesCount = 10
+
+// UserMfa is the TOTP second factor of the user, it is enabled once the user confirms the enrolment
+// by the first code. The secret is stored encrypted, recovery codes are kept hashed and removed when used
+type UserMfa struct {
+	ID            uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	UserID        uuid.UUID  `json:"userId,omitempty" gorm:"type:uuid;uniqueIndex"`
+	Secret        string     `json:"-" gorm:"serializer:encrypted"`
+	IsEnabled     bool       `json:"isEnabled,omitempty"`
+	RecoveryCodes []string   `json:"-" gorm:"serializer:json"`
+	LastUsedStep  int64      `json:"-"`
+	EnableDate    *time.Time `json:"enableDate,omitempty"`
+	commonModel.Versioned
+}
+
+func (mfa UserMfa) GetID() uuid.UUID {
+	return mfa.ID
+}
+
+func (mfa *UserMfa) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(mfa.ID) {
+		mfa.ID = uuid.New()
+	}
+	return nil
+}
+
+// MfaStatus is the second factor state of the user, it is required when any role of the user requires MFA
+type MfaStatus struct {
+	IsEnabled          bool       `json:"isEnabled"`
+	IsRequired         bool       `json:"isRequired"`
+	RecoveryCodesCount int        `json:"recoveryCodesCount"`
+	EnableDate         *time.Time `json:"enableDate,omitempty"`
+}
+
+// MfaEnrolment is the secret of the authenticator app, the provisioning uri is rendered as the QR code
+type MfaEnrolment struct {
+	Secret          string `json:"secret,omitempty"`
+	ProvisioningUri string `json:"provisioningUri,omitempty"`
+}
+
+// MfaRequest confirms the action by the code of the authenticator or a recovery code
+type MfaRequest struct {
+	Code string `json:"code,omitempty"`
+}
+
+// RecoveryCodes are shown to the user once on enrolment and regeneration
+type RecoveryCodes struct {
+	Codes []string `json:"codes"`
+}
@@ -0,0 +1,490 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonCac19e4eDecodeAssetsModulesAuthorizationModel(in *jlexer.Lexer, out *UserMfa) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "userId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserID).UnmarshalText(data))
+			}
+		case "isEnabled":
+			out.IsEnabled = bool(in.Bool())
+		case "enableDate":
+			if in.IsNull() {
+				in.Skip()
+				out.EnableDate = nil
+			} else {
+				if out.EnableDate == nil {
+					out.EnableDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.EnableDate).UnmarshalJSON(data))
+				}
+			}
+		case "version":
+			out.Version = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCac19e4eEncodeAssetsModulesAuthorizationModel(out *jwriter.Writer, in UserMfa) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"userId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.UserID).MarshalText())
+	}
+	if in.IsEnabled {
+		const prefix string = ",\"isEnabled\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.IsEnabled))
+	}
+	if in.EnableDate != nil {
+		const prefix string = ",\"enableDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.EnableDate).MarshalJSON())
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UserMfa) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCac19e4eEncodeAssetsModulesAuthorizationModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UserMfa) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCac19e4eEncodeAssetsModulesAuthorizationModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UserMfa) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCac19e4eDecodeAssetsModulesAuthorizationModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UserMfa) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCac19e4eDecodeAssetsModulesAuthorizationModel(l, v)
+}
+func easyjsonCac19e4eDecodeAssetsModulesAuthorizationModel1(in *jlexer.Lexer, out *RecoveryCodes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "codes":
+			if in.IsNull() {
+				in.Skip()
+				out.Codes = nil
+			} else {
+				in.Delim('[')
+				if out.Codes == nil {
+					if !in.IsDelim(']') {
+						out.Codes = make([]string, 0, 4)
+					} else {
+						out.Codes = []string{}
+					}
+				} else {
+					out.Codes = (out.Codes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Codes = append(out.Codes, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCac19e4eEncodeAssetsModulesAuthorizationModel1(out *jwriter.Writer, in RecoveryCodes) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"codes\":"
+		out.RawString(prefix[1:])
+		if in.Codes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Codes {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RecoveryCodes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCac19e4eEncodeAssetsModulesAuthorizationModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RecoveryCodes) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCac19e4eEncodeAssetsModulesAuthorizationModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RecoveryCodes) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCac19e4eDecodeAssetsModulesAuthorizationModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RecoveryCodes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCac19e4eDecodeAssetsModulesAuthorizationModel1(l, v)
+}
+func easyjsonCac19e4eDecodeAssetsModulesAuthorizationModel2(in *jlexer.Lexer, out *MfaStatus) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "isEnabled":
+			out.IsEnabled = bool(in.Bool())
+		case "isRequired":
+			out.IsRequired = bool(in.Bool())
+		case "recoveryCodesCount":
+			out.RecoveryCodesCount = int(in.Int())
+		case "enableDate":
+			if in.IsNull() {
+				in.Skip()
+				out.EnableDate = nil
+			} else {
+				if out.EnableDate == nil {
+					out.EnableDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.EnableDate).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCac19e4eEncodeAssetsModulesAuthorizationModel2(out *jwriter.Writer, in MfaStatus) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"isEnabled\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.IsEnabled))
+	}
+	{
+		const prefix string = ",\"isRequired\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsRequired))
+	}
+	{
+		const prefix string = ",\"recoveryCodesCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.RecoveryCodesCount))
+	}
+	if in.EnableDate != nil {
+		const prefix string = ",\"enableDate\":"
+		out.RawString(prefix)
+		out.Raw((*in.EnableDate).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MfaStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCac19e4eEncodeAssetsModulesAuthorizationModel2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MfaStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCac19e4eEncodeAssetsModulesAuthorizationModel2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MfaStatus) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCac19e4eDecodeAssetsModulesAuthorizationModel2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MfaStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCac19e4eDecodeAssetsModulesAuthorizationModel2(l, v)
+}
+func easyjsonCac19e4eDecodeAssetsModulesAuthorizationModel3(in *jlexer.Lexer, out *MfaRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCac19e4eEncodeAssetsModulesAuthorizationModel3(out *jwriter.Writer, in MfaRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Code != "" {
+		const prefix string = ",\"code\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MfaRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCac19e4eEncodeAssetsModulesAuthorizationModel3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MfaRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCac19e4eEncodeAssetsModulesAuthorizationModel3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MfaRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCac19e4eDecodeAssetsModulesAuthorizationModel3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MfaRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCac19e4eDecodeAssetsModulesAuthorizationModel3(l, v)
+}
+func easyjsonCac19e4eDecodeAssetsModulesAuthorizationModel4(in *jlexer.Lexer, out *MfaEnrolment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "secret":
+			out.Secret = string(in.String())
+		case "provisioningUri":
+			out.ProvisioningUri = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCac19e4eEncodeAssetsModulesAuthorizationModel4(out *jwriter.Writer, in MfaEnrolment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Secret != "" {
+		const prefix string = ",\"secret\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Secret))
+	}
+	if in.ProvisioningUri != "" {
+		const prefix string = ",\"provisioningUri\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ProvisioningUri))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MfaEnrolment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCac19e4eEncodeAssetsModulesAuthorizationModel4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MfaEnrolment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCac19e4eEncodeAssetsModulesAuthorizationModel4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MfaEnrolment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCac19e4eDecodeAssetsModulesAuthorizationModel4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MfaEnrolment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCac19e4eDecodeAssetsModulesAuthorizationModel4(l, v)
+}
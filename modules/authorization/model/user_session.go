@@ -0,0 +1,68 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	"github.com/google/uuid"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+// UserSession is the sign in of the user on the device, its id is the sid claim of the tokens. The session
+// lives while its refresh token may be exchanged, the address and the device are the ones of the last
+// issued tokens
+type UserSession struct {
+	ID           uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	UserID       uuid.UUID  `json:"userId,omitempty" gorm:"type:uuid;index"`
+	ClientID     string     `json:"clientId,omitempty"`
+	Device       string     `json:"device,omitempty"`
+	Ip           string     `json:"ip,omitempty"`
+	UserAgent    string     `json:"userAgent,omitempty"`
+	CreateDate   *time.Time `json:"createDate,omitempty"`
+	LastUsedDate *time.Time `json:"lastUsedDate,omitempty"`
+	// IssueDate is the time the last tokens are issued, the session is revoked with all tokens of the user
+	// revoked after it
+	IssueDate  *time.Time `json:"-"`
+	ExpireDate *time.Time `json:"expireDate,omitempty"`
+	IsCurrent  bool       `json:"isCurrent,omitempty" gorm:"-"`
+	commonModel.Versioned
+}
+
+func (session UserSession) GetID() uuid.UUID {
+	return session.ID
+}
+
+func (session UserSession) IsExpired() bool {
+	return session.ExpireDate != nil && session.ExpireDate.Before(time.Now())
+}
+
+// GetTokenInfo returns the claims of the last tokens of the session the revocation is checked by
+func (session UserSession) GetTokenInfo() commonModel.TokenInfo {
+	tokenInfo := commonModel.TokenInfo{UserId: session.UserID, SessionId: session.ID.String()}
+	if session.IssueDate != nil {
+		tokenInfo.IssuedAt, tokenInfo.IssuedAtMs = session.IssueDate.Unix(), session.IssueDate.UnixMilli()
+	}
+	return tokenInfo
+}
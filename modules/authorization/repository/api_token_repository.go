@@ -0,0 +1,89 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonDB "assets/common/db"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+var apiTokenRepo ApiTokenRepository
+
+type ApiTokenRepository interface {
+	commonRepository.Repository[model.ApiToken]
+	FindByHash(ctx context.Context, tokenHash string) []model.ApiToken
+	FindByKind(ctx context.Context, kind string) []model.ApiToken
+	FindByUserIdAndKind(ctx context.Context, userId uuid.UUID, kind string) []model.ApiToken
+	UpdateLastUsedDate(ctx context.Context, id uuid.UUID, date time.Time)
+	DeleteByUserId(ctx context.Context, userId uuid.UUID)
+}
+
+type apiTokenRepository struct {
+	commonRepository.Repository[model.ApiToken]
+	*commonDB.DataSource
+}
+
+func GetApiTokenRepository() ApiTokenRepository {
+	if apiTokenRepo != nil {
+		return apiTokenRepo
+	}
+	apiTokenRepo = &apiTokenRepository{
+		commonRepository.NewBaseRepository[model.ApiToken](commonDB.GetDataSource()),
+		commonDB.GetDataSource(),
+	}
+	return apiTokenRepo
+}
+
+func (repo *apiTokenRepository) FindByHash(ctx context.Context, tokenHash string) []model.ApiToken {
+	var result []model.ApiToken
+	commonUtil.Must(repo.DataSource.Where("token_hash = ?", tokenHash).Find(&result).Error)
+	return result
+}
+
+func (repo *apiTokenRepository) FindByKind(ctx context.Context, kind string) []model.ApiToken {
+	var result []model.ApiToken
+	commonUtil.Must(repo.DataSource.Where("kind = ?", kind).Order("create_date desc").Find(&result).Error)
+	return result
+}
+
+func (repo *apiTokenRepository) FindByUserIdAndKind(ctx context.Context, userId uuid.UUID, kind string) []model.ApiToken {
+	var result []model.ApiToken
+	commonUtil.Must(repo.DataSource.Where("user_id = ? and kind = ?", userId, kind).Order("create_date desc").Find(&result).Error)
+	return result
+}
+
+// UpdateLastUsedDate stores only the date of the last use, the version isn't changed by the use of the token
+func (repo *apiTokenRepository) UpdateLastUsedDate(ctx context.Context, id uuid.UUID, date time.Time) {
+	commonUtil.Must(repo.DataSource.Model(&model.ApiToken{ID: id}).Update("last_used_date", date).Error)
+}
+
+func (repo *apiTokenRepository) DeleteByUserId(ctx context.Context, userId uuid.UUID) {
+	commonUtil.Must(repo.DataSource.Where("user_id = ?", userId).Delete(&model.ApiToken{}).Error)
+}
@@ -0,0 +1,99 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonDB "assets/common/db"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"context"
+	"errors"
+	"github.com/go-redis/redis/v8"
+	"time"
+)
+
+const (
+	authorizationCodePrefix = "oauthCodes:"
+	consentTokenPrefix      = "oauthConsentTokens:"
+)
+
+var authorizationCodeRepo AuthorizationCodeRepository
+
+// AuthorizationCodeRepository keeps the issued authorization codes in redis until they are exchanged or expired.
+// The authorizations waiting for the consent of the user are kept by the consent tokens the same way
+type AuthorizationCodeRepository interface {
+	Save(ctx context.Context, code string, authorizationCode model.AuthorizationCode, ttl time.Duration)
+	Consume(ctx context.Context, code string) (model.AuthorizationCode, bool)
+	SaveConsentToken(ctx context.Context, token string, authorizationCode model.AuthorizationCode, ttl time.Duration)
+	ConsumeConsentToken(ctx context.Context, token string) (model.AuthorizationCode, bool)
+}
+
+type authorizationCodeRepository struct {
+}
+
+func GetAuthorizationCodeRepository() AuthorizationCodeRepository {
+	if authorizationCodeRepo != nil {
+		return authorizationCodeRepo
+	}
+	authorizationCodeRepo = &authorizationCodeRepository{}
+	return authorizationCodeRepo
+}
+
+func (repo *authorizationCodeRepository) Save(ctx context.Context, code string, authorizationCode model.AuthorizationCode, ttl time.Duration) {
+	saveAuthorizationCode(ctx, authorizationCodePrefix+code, authorizationCode, ttl)
+}
+
+// Consume returns the code and deletes it at once, so the code is exchanged only once
+func (repo *authorizationCodeRepository) Consume(ctx context.Context, code string) (model.AuthorizationCode, bool) {
+	return consumeAuthorizationCode(ctx, authorizationCodePrefix+code)
+}
+
+func (repo *authorizationCodeRepository) SaveConsentToken(ctx context.Context, token string, authorizationCode model.AuthorizationCode, ttl time.Duration) {
+	saveAuthorizationCode(ctx, consentTokenPrefix+token, authorizationCode, ttl)
+}
+
+// ConsumeConsentToken returns the authorization of the consent token and deletes it at once, so the token is used once
+func (repo *authorizationCodeRepository) ConsumeConsentToken(ctx context.Context, token string) (model.AuthorizationCode, bool) {
+	return consumeAuthorizationCode(ctx, consentTokenPrefix+token)
+}
+
+func saveAuthorizationCode(ctx context.Context, key string, authorizationCode model.AuthorizationCode, ttl time.Duration) {
+	client := commonUtil.MustOne(commonDB.GetRedisCachePool())
+	value := commonUtil.MustOne(authorizationCode.MarshalJSON())
+	commonUtil.Must(client.Set(ctx, key, value, ttl).Err())
+}
+
+func consumeAuthorizationCode(ctx context.Context, key string) (model.AuthorizationCode, bool) {
+	client := commonUtil.MustOne(commonDB.GetRedisCachePool())
+	value, err := client.GetDel(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return model.AuthorizationCode{}, false
+	}
+	commonUtil.Must(err)
+
+	var result model.AuthorizationCode
+	commonUtil.Must(result.UnmarshalJSON(value))
+	return result, true
+}
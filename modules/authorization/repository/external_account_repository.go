@@ -0,0 +1,75 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonDB "assets/common/db"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"context"
+	"github.com/google/uuid"
+)
+
+var externalAccountRepo ExternalAccountRepository
+
+type ExternalAccountRepository interface {
+	commonRepository.Repository[model.ExternalAccount]
+	FindBySubject(ctx context.Context, provider string, subject string) []model.ExternalAccount
+	FindByUserId(ctx context.Context, userId uuid.UUID) []model.ExternalAccount
+	DeleteByUserId(ctx context.Context, userId uuid.UUID)
+}
+
+type externalAccountRepository struct {
+	commonRepository.Repository[model.ExternalAccount]
+	*commonDB.DataSource
+}
+
+func GetExternalAccountRepository() ExternalAccountRepository {
+	if externalAccountRepo != nil {
+		return externalAccountRepo
+	}
+	externalAccountRepo = &externalAccountRepository{
+		commonRepository.NewBaseRepository[model.ExternalAccount](commonDB.GetDataSource()),
+		commonDB.GetDataSource(),
+	}
+	return externalAccountRepo
+}
+
+func (repo *externalAccountRepository) FindBySubject(ctx context.Context, provider string, subject string) []model.ExternalAccount {
+	var result []model.ExternalAccount
+	commonUtil.Must(repo.DataSource.Where("provider = ? and subject = ?", provider, subject).Find(&result).Error)
+	return result
+}
+
+func (repo *externalAccountRepository) FindByUserId(ctx context.Context, userId uuid.UUID) []model.ExternalAccount {
+	var result []model.ExternalAccount
+	commonUtil.Must(repo.DataSource.Where("user_id = ?", userId).Order("create_date").Find(&result).Error)
+	return result
+}
+
+func (repo *externalAccountRepository) DeleteByUserId(ctx context.Context, userId uuid.UUID) {
+	commonUtil.Must(repo.DataSource.Where("user_id = ?", userId).Delete(&model.ExternalAccount{}).Error)
+}
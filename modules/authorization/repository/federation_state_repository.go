@@ -0,0 +1,77 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonDB "assets/common/db"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"context"
+	"errors"
+	"github.com/go-redis/redis/v8"
+	"time"
+)
+
+const federationStatePrefix = "federationStates:"
+
+var federationStateRepo FederationStateRepository
+
+// FederationStateRepository keeps the states of the logins via the external identity providers in redis
+// until the provider calls back
+type FederationStateRepository interface {
+	Save(ctx context.Context, state string, federationState model.FederationState, ttl time.Duration)
+	Consume(ctx context.Context, state string) (model.FederationState, bool)
+}
+
+type federationStateRepository struct {
+}
+
+func GetFederationStateRepository() FederationStateRepository {
+	if federationStateRepo != nil {
+		return federationStateRepo
+	}
+	federationStateRepo = &federationStateRepository{}
+	return federationStateRepo
+}
+
+func (repo *federationStateRepository) Save(ctx context.Context, state string, federationState model.FederationState, ttl time.Duration) {
+	client := commonUtil.MustOne(commonDB.GetRedisCachePool())
+	value := commonUtil.MustOne(federationState.MarshalJSON())
+	commonUtil.Must(client.Set(ctx, federationStatePrefix+state, value, ttl).Err())
+}
+
+// Consume returns the state and deletes it at once, so the callback is accepted only once
+func (repo *federationStateRepository) Consume(ctx context.Context, state string) (model.FederationState, bool) {
+	client := commonUtil.MustOne(commonDB.GetRedisCachePool())
+	value, err := client.GetDel(ctx, federationStatePrefix+state).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return model.FederationState{}, false
+	}
+	commonUtil.Must(err)
+
+	var result model.FederationState
+	commonUtil.Must(result.UnmarshalJSON(value))
+	return result, true
+}
@@ -0,0 +1,75 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonDB "assets/common/db"
+	commonModel "assets/common/model"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"context"
+	"strings"
+)
+
+var loginEventRepo LoginEventRepository
+
+type LoginEventRepository interface {
+	commonRepository.Repository[model.LoginEvent]
+	FindByUsername(ctx context.Context, username string, page commonModel.Pageable) commonModel.Page[model.LoginEvent]
+}
+
+type loginEventRepository struct {
+	commonRepository.Repository[model.LoginEvent]
+	*commonDB.DataSource
+}
+
+func GetLoginEventRepository() LoginEventRepository {
+	if loginEventRepo != nil {
+		return loginEventRepo
+	}
+	loginEventRepo = &loginEventRepository{
+		commonRepository.NewBaseRepository[model.LoginEvent](commonDB.GetDataSource()),
+		commonDB.GetDataSource(),
+	}
+	return loginEventRepo
+}
+
+// FindByUsername returns the latest events first, the events of all usernames are returned for the empty username
+func (repo *loginEventRepository) FindByUsername(ctx context.Context, username string, page commonModel.Pageable) commonModel.Page[model.LoginEvent] {
+	query := repo.DataSource.Model(&model.LoginEvent{})
+	if username != "" {
+		query = query.Where("username = ?", strings.ToLower(username))
+	}
+
+	var total int64
+	commonUtil.Must(query.Count(&total).Error)
+
+	var result []model.LoginEvent
+	query = query.Limit(page.Size).Offset(page.Page * page.Size)
+	query = commonRepository.OrderBy[model.LoginEvent](query, page.Sort, "create_date desc")
+	commonUtil.Must(query.Find(&result).Error)
+	return commonModel.NewPage[model.LoginEvent](result, page).WithTotal(int(total))
+}
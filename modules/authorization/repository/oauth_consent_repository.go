@@ -0,0 +1,68 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonDB "assets/common/db"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"context"
+	"github.com/google/uuid"
+)
+
+var oauthConsentRepo OAuthConsentRepository
+
+type OAuthConsentRepository interface {
+	commonRepository.Repository[model.OAuthConsent]
+	FindByUserAndClient(ctx context.Context, userId uuid.UUID, clientId uuid.UUID) []model.OAuthConsent
+	DeleteByClientId(ctx context.Context, clientId uuid.UUID)
+}
+
+type oauthConsentRepository struct {
+	commonRepository.Repository[model.OAuthConsent]
+	*commonDB.DataSource
+}
+
+func GetOAuthConsentRepository() OAuthConsentRepository {
+	if oauthConsentRepo != nil {
+		return oauthConsentRepo
+	}
+	oauthConsentRepo = &oauthConsentRepository{
+		commonRepository.NewBaseRepository[model.OAuthConsent](commonDB.GetDataSource()),
+		commonDB.GetDataSource(),
+	}
+	return oauthConsentRepo
+}
+
+func (repo *oauthConsentRepository) FindByUserAndClient(ctx context.Context, userId uuid.UUID, clientId uuid.UUID) []model.OAuthConsent {
+	var result []model.OAuthConsent
+	commonUtil.Must(repo.DataSource.Where("user_id = ? and client_id = ?", userId, clientId).Find(&result).Error)
+	return result
+}
+
+func (repo *oauthConsentRepository) DeleteByClientId(ctx context.Context, clientId uuid.UUID) {
+	commonUtil.Must(repo.DataSource.Where("client_id = ?", clientId).Delete(&model.OAuthConsent{}).Error)
+}
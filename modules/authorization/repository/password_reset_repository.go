@@ -0,0 +1,90 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonDB "assets/common/db"
+	commonUtil "assets/common/util"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"time"
+)
+
+const passwordResetPrefix = "passwordResets:"
+
+var passwordResetRepo PasswordResetRepository
+
+// PasswordResetRepository keeps the user ids of the password reset tokens in redis until they are used or expired.
+// The tokens are stored hashed, so the keys can't be used to reset the passwords
+type PasswordResetRepository interface {
+	Save(ctx context.Context, token string, userId uuid.UUID, ttl time.Duration)
+	Find(ctx context.Context, token string) (uuid.UUID, bool)
+	Consume(ctx context.Context, token string) (uuid.UUID, bool)
+}
+
+type passwordResetRepository struct {
+}
+
+func GetPasswordResetRepository() PasswordResetRepository {
+	if passwordResetRepo != nil {
+		return passwordResetRepo
+	}
+	passwordResetRepo = &passwordResetRepository{}
+	return passwordResetRepo
+}
+
+func (repo *passwordResetRepository) Save(ctx context.Context, token string, userId uuid.UUID, ttl time.Duration) {
+	client := commonUtil.MustOne(commonDB.GetRedisCachePool())
+	commonUtil.Must(client.Set(ctx, passwordResetKey(token), userId.String(), ttl).Err())
+}
+
+func (repo *passwordResetRepository) Find(ctx context.Context, token string) (uuid.UUID, bool) {
+	client := commonUtil.MustOne(commonDB.GetRedisCachePool())
+	return parseUserId(client.Get(ctx, passwordResetKey(token)).Result())
+}
+
+// Consume returns the user id of the token and deletes it at once, so the token is used only once
+func (repo *passwordResetRepository) Consume(ctx context.Context, token string) (uuid.UUID, bool) {
+	client := commonUtil.MustOne(commonDB.GetRedisCachePool())
+	return parseUserId(client.GetDel(ctx, passwordResetKey(token)).Result())
+}
+
+func parseUserId(value string, err error) (uuid.UUID, bool) {
+	if errors.Is(err, redis.Nil) {
+		return uuid.Nil, false
+	}
+	commonUtil.Must(err)
+	userId, err := uuid.Parse(value)
+	return userId, err == nil
+}
+
+func passwordResetKey(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return passwordResetPrefix + hex.EncodeToString(hash[:])
+}
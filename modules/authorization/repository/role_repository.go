@@ -30,6 +30,7 @@ import (
 	commonRepository "assets/common/repository"
 	"assets/modules/authorization/model"
 	"context"
+	"gorm.io/gorm"
 )
 
 var roleRepo RoleRepository
@@ -37,6 +38,7 @@ var roleRepo RoleRepository
 type RoleRepository interface {
 	commonRepository.Repository[model.Role]
 	FindByName(ctx context.Context, names ...string) []model.Role
+	GrantAuthorities(ctx context.Context, role model.Role, authorities []*model.Authority)
 }
 
 type roleRepository struct {
@@ -64,3 +66,13 @@ func (repo *roleRepository) FindByName(ctx context.Context, names ...string) []m
 	repo.DataSource.Where("name in ?", names).Find(&result)
 	return result
 }
+
+// GrantAuthorities adds the authorities to the stored role once, so the authority the admin has taken away
+// from the role isn't granted again on the next start
+func (repo *roleRepository) GrantAuthorities(ctx context.Context, role model.Role, authorities []*model.Authority) {
+	for _, authority := range authorities {
+		commonRepository.Migrate(repo.DataSource, "role:"+role.Name+":authority:"+authority.Method, func(tx *gorm.DB) error {
+			return tx.Exec("insert into role_authority (role_id, authority_id) values (?, ?) on conflict do nothing", role.ID, authority.ID).Error
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonDB "assets/common/db"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"context"
+	"gorm.io/gorm"
+	"time"
+)
+
+var signingKeyRepo SigningKeyRepository
+
+type SigningKeyRepository interface {
+	commonRepository.Repository[model.SigningKey]
+	FindActive(ctx context.Context, algorithm string) []model.SigningKey
+	RetireCreatedBefore(ctx context.Context, date time.Time)
+	DeleteRetiredBefore(ctx context.Context, date time.Time)
+}
+
+type signingKeyRepository struct {
+	commonRepository.Repository[model.SigningKey]
+	*commonDB.DataSource
+}
+
+func GetSigningKeyRepository() SigningKeyRepository {
+	if signingKeyRepo != nil {
+		return signingKeyRepo
+	}
+	repo := &signingKeyRepository{
+		commonRepository.NewBaseRepository[model.SigningKey](commonDB.GetDataSource()),
+		commonDB.GetDataSource(),
+	}
+	commonRepository.Migrate(repo.DataSource, "signing_keys:encrypt_private_keys", repo.encryptPrivateKeys)
+	signingKeyRepo = repo
+	return signingKeyRepo
+}
+
+// encryptPrivateKeys stores encrypted the private keys created before the encryption was introduced
+func (repo *signingKeyRepository) encryptPrivateKeys(tx *gorm.DB) error {
+	var keys []model.SigningKey
+	if err := tx.Find(&keys).Error; err != nil {
+		return err
+	}
+	for i := range keys {
+		if err := tx.Model(&keys[i]).Select("private_key").Updates(&keys[i]).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindActive returns the keys of the algorithm which are not retired, the newest first
+func (repo *signingKeyRepository) FindActive(ctx context.Context, algorithm string) []model.SigningKey {
+	var result []model.SigningKey
+	commonUtil.Must(repo.DataSource.
+		Where("algorithm = ? and retire_date is null", algorithm).
+		Order("create_date desc").
+		Find(&result).Error)
+	return result
+}
+
+// RetireCreatedBefore retires the keys superseded by the key created at the date, they only verify tokens since then
+func (repo *signingKeyRepository) RetireCreatedBefore(ctx context.Context, date time.Time) {
+	commonUtil.Must(repo.DataSource.Model(&model.SigningKey{}).
+		Where("create_date < ? and retire_date is null", date).
+		Updates(map[string]any{"retire_date": time.Now(), "version": gorm.Expr("version + 1")}).Error)
+}
+
+func (repo *signingKeyRepository) DeleteRetiredBefore(ctx context.Context, date time.Time) {
+	commonUtil.Must(repo.DataSource.Where("retire_date < ?", date).Delete(&model.SigningKey{}).Error)
+}
@@ -0,0 +1,85 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonDB "assets/common/db"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var userMfaRepo UserMfaRepository
+
+type UserMfaRepository interface {
+	commonRepository.Repository[model.UserMfa]
+	FindByUserId(ctx context.Context, userId uuid.UUID) []model.UserMfa
+	DeleteByUserId(ctx context.Context, userId uuid.UUID)
+}
+
+type userMfaRepository struct {
+	commonRepository.Repository[model.UserMfa]
+	*commonDB.DataSource
+}
+
+func GetUserMfaRepository() UserMfaRepository {
+	if userMfaRepo != nil {
+		return userMfaRepo
+	}
+	repo := &userMfaRepository{
+		commonRepository.NewBaseRepository[model.UserMfa](commonDB.GetDataSource()),
+		commonDB.GetDataSource(),
+	}
+	commonRepository.Migrate(repo.DataSource, "user_mfa:encrypt_secrets", repo.encryptSecrets)
+	userMfaRepo = repo
+	return userMfaRepo
+}
+
+// encryptSecrets stores encrypted the TOTP secrets enrolled before the encryption was introduced
+func (repo *userMfaRepository) encryptSecrets(tx *gorm.DB) error {
+	var mfas []model.UserMfa
+	if err := tx.Find(&mfas).Error; err != nil {
+		return err
+	}
+	for i := range mfas {
+		if err := tx.Model(&mfas[i]).Select("secret").Updates(&mfas[i]).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (repo *userMfaRepository) FindByUserId(ctx context.Context, userId uuid.UUID) []model.UserMfa {
+	var result []model.UserMfa
+	commonUtil.Must(repo.DataSource.Where("user_id = ?", userId).Find(&result).Error)
+	return result
+}
+
+func (repo *userMfaRepository) DeleteByUserId(ctx context.Context, userId uuid.UUID) {
+	commonUtil.Must(repo.DataSource.Where("user_id = ?", userId).Delete(&model.UserMfa{}).Error)
+}
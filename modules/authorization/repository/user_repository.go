@@ -32,6 +32,7 @@ import (
 	"assets/modules/authorization/model"
 	"context"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 var userRepo UserRepository
@@ -42,6 +43,8 @@ type UserRepository interface {
 	FindByEmail(ctx context.Context, emails []string) []model.User
 	RemoveRoles(ctx context.Context, id uuid.UUID, roles []model.Role) model.User
 	RemoveAuthorities(ctx context.Context, id uuid.UUID, authorities []model.Authority) model.User
+	UpdatePassword(ctx context.Context, user model.User)
+	RequirePasswordChangeOnce(ctx context.Context, username string) bool
 }
 
 type userRepository struct {
@@ -89,3 +92,18 @@ func (repo *userRepository) RemoveAuthorities(ctx context.Context, id uuid.UUID,
 	commonUtil.Must(repo.DataSource.Model(&model.User{ID: id}).Association("Authorities").Delete(&authorities))
 	return repo.GetById(ctx, []uuid.UUID{id})[0]
 }
+
+// RequirePasswordChangeOnce makes the user change the password on the next login, it is done once across
+// the restarts, so the password chosen afterward is kept. It tells whether the change is required now
+func (repo *userRepository) RequirePasswordChangeOnce(ctx context.Context, username string) bool {
+	return commonRepository.Migrate(repo.DataSource, "user:"+username+":password_change_required", func(tx *gorm.DB) error {
+		return tx.Model(&model.User{}).Where("username = ?", username).Update("password_change_required", true).Error
+	})
+}
+
+// UpdatePassword stores only the password of the user and its change state, the other fields are kept
+func (repo *userRepository) UpdatePassword(ctx context.Context, user model.User) {
+	commonUtil.Must(repo.DataSource.Model(&model.User{ID: user.ID}).
+		Select("password", "password_change_required", "password_change_date").
+		Updates(&user).Error)
+}
@@ -0,0 +1,102 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonDB "assets/common/db"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+var userSessionRepo UserSessionRepository
+
+type UserSessionRepository interface {
+	commonRepository.Repository[model.UserSession]
+	Save(ctx context.Context, session model.UserSession)
+	FindByUserId(ctx context.Context, userId uuid.UUID) []model.UserSession
+	UpdateLastUsedDate(ctx context.Context, id uuid.UUID, date time.Time)
+	DeleteByUserId(ctx context.Context, userId uuid.UUID)
+	FindAfter(ctx context.Context, afterId uuid.UUID, limit int) []model.UserSession
+	DeleteExpiredBefore(ctx context.Context, date time.Time) int64
+}
+
+type userSessionRepository struct {
+	commonRepository.Repository[model.UserSession]
+	*commonDB.DataSource
+}
+
+func GetUserSessionRepository() UserSessionRepository {
+	if userSessionRepo != nil {
+		return userSessionRepo
+	}
+	userSessionRepo = &userSessionRepository{
+		commonRepository.NewBaseRepository[model.UserSession](commonDB.GetDataSource()),
+		commonDB.GetDataSource(),
+	}
+	return userSessionRepo
+}
+
+// Save creates the session or updates the address, the device and the dates of the tokens issued
+// within it, the creation date and the owner of the session are kept
+func (repo *userSessionRepository) Save(ctx context.Context, session model.UserSession) {
+	commonUtil.Must(repo.DataSource.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"device", "ip", "user_agent", "last_used_date", "issue_date", "expire_date"}),
+	}).Create(&session).Error)
+}
+
+func (repo *userSessionRepository) FindByUserId(ctx context.Context, userId uuid.UUID) []model.UserSession {
+	var result []model.UserSession
+	commonUtil.Must(repo.DataSource.Where("user_id = ?", userId).Order("last_used_date desc").Find(&result).Error)
+	return result
+}
+
+// UpdateLastUsedDate stores only the date of the last use, the version isn't changed by the use of the session
+func (repo *userSessionRepository) UpdateLastUsedDate(ctx context.Context, id uuid.UUID, date time.Time) {
+	commonUtil.Must(repo.DataSource.Model(&model.UserSession{ID: id}).Update("last_used_date", date).Error)
+}
+
+func (repo *userSessionRepository) DeleteByUserId(ctx context.Context, userId uuid.UUID) {
+	commonUtil.Must(repo.DataSource.Where("user_id = ?", userId).Delete(&model.UserSession{}).Error)
+}
+
+// FindAfter returns the sessions ordered by id which follow the one, so all sessions are walked by the pages
+func (repo *userSessionRepository) FindAfter(ctx context.Context, afterId uuid.UUID, limit int) []model.UserSession {
+	var result []model.UserSession
+	commonUtil.Must(repo.DataSource.Where("id > ?", afterId).Order("id").Limit(limit).Find(&result).Error)
+	return result
+}
+
+// DeleteExpiredBefore deletes the sessions which refresh tokens expired before the date
+func (repo *userSessionRepository) DeleteExpiredBefore(ctx context.Context, date time.Time) int64 {
+	result := repo.DataSource.Where("expire_date < ?", date).Delete(&model.UserSession{})
+	commonUtil.Must(result.Error)
+	return result.RowsAffected
+}
@@ -0,0 +1,234 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/config"
+	commonError "assets/common/custom_error"
+	commonMiddleware "assets/common/middleware"
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"assets/modules/authorization/repository"
+	"assets/modules/authorization/util"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+const (
+	personalAccessTokenPrefix = "assets_pat_"
+	apiKeyPrefix              = "assets_key_"
+	apiTokenSecretSize        = 32
+	// apiTokenVisiblePrefix is the number of the characters of the secret shown to recognize the token
+	apiTokenVisiblePrefix = 4
+	// lastUsedPrecision limits the writes of the last used date, the token of the script is used many times a minute
+	lastUsedPrecision = time.Minute
+)
+
+var apiTokenSrv ApiTokenService
+
+// ApiTokenService manages the personal access tokens of the users and the api keys of the service accounts.
+// Both are sent by the integrations in the Authorization header as "Token <token>"
+type ApiTokenService interface {
+	GetPersonalTokens(ctx context.Context) []model.ApiToken
+	CreatePersonalToken(ctx context.Context, token model.ApiToken) model.ApiToken
+	DeletePersonalToken(ctx context.Context, id uuid.UUID)
+
+	GetApiKeys(ctx context.Context) []model.ApiToken
+	CreateApiKey(ctx context.Context, token model.ApiToken) model.ApiToken
+	DeleteApiKey(ctx context.Context, id uuid.UUID)
+
+	Resolve(ctx context.Context, token string) commonModel.TokenInfo
+}
+
+type apiTokenService struct {
+	repository     repository.ApiTokenRepository
+	userRepository repository.UserRepository
+	userService    UserService
+}
+
+func GetApiTokenService() ApiTokenService {
+	if apiTokenSrv != nil {
+		return apiTokenSrv
+	}
+	service := &apiTokenService{
+		repository:     repository.GetApiTokenRepository(),
+		userRepository: repository.GetUserRepository(),
+		userService:    GetUserService(),
+	}
+	commonMiddleware.SetApiTokenResolver(service.Resolve)
+	apiTokenSrv = service
+	return apiTokenSrv
+}
+
+func (service *apiTokenService) GetPersonalTokens(ctx context.Context) []model.ApiToken {
+	tokenInfo := commonUtil.MustGetCurrentTokenInfo(ctx)
+	return service.repository.FindByUserIdAndKind(ctx, tokenInfo.UserId, model.PersonalAccessTokenKind)
+}
+
+// CreatePersonalToken issues the token of the current user, it expires not later than the longest validity.
+// The token is returned only by this call
+func (service *apiTokenService) CreatePersonalToken(ctx context.Context, token model.ApiToken) model.ApiToken {
+	user := service.getUser(ctx, commonUtil.MustGetCurrentTokenInfo(ctx).UserId)
+
+	maxExpireDate := time.Now().Add(config.CoreConfig.AuthorizationServer.GetPersonalAccessTokenValidity())
+	if token.ExpireDate == nil {
+		token.ExpireDate = &maxExpireDate
+	}
+	if token.ExpireDate.After(maxExpireDate) {
+		panic(commonError.IllegalArgumentError)
+	}
+	return service.create(ctx, user, model.PersonalAccessTokenKind, personalAccessTokenPrefix, token)
+}
+
+func (service *apiTokenService) DeletePersonalToken(ctx context.Context, id uuid.UUID) {
+	tokenInfo := commonUtil.MustGetCurrentTokenInfo(ctx)
+	tokens := service.repository.GetById(ctx, []uuid.UUID{id})
+	if len(tokens) == 0 || tokens[0].Kind != model.PersonalAccessTokenKind || tokens[0].UserID != tokenInfo.UserId {
+		panic(commonError.NotFoundError)
+	}
+	service.repository.DeleteById(ctx, id)
+}
+
+func (service *apiTokenService) GetApiKeys(ctx context.Context) []model.ApiToken {
+	return service.repository.FindByKind(ctx, model.ApiKeyKind)
+}
+
+// CreateApiKey issues the key of the service account, the key without the expire date never expires.
+// The key is returned only by this call
+func (service *apiTokenService) CreateApiKey(ctx context.Context, token model.ApiToken) model.ApiToken {
+	user := service.getUser(ctx, token.UserID)
+	if !user.IsServiceAccount {
+		panic(commonError.IllegalArgumentError)
+	}
+	return service.create(ctx, user, model.ApiKeyKind, apiKeyPrefix, token)
+}
+
+func (service *apiTokenService) DeleteApiKey(ctx context.Context, id uuid.UUID) {
+	tokens := service.repository.GetById(ctx, []uuid.UUID{id})
+	if len(tokens) == 0 || tokens[0].Kind != model.ApiKeyKind {
+		panic(commonError.NotFoundError)
+	}
+	service.repository.DeleteById(ctx, id)
+}
+
+// Resolve returns the token info of the token with the authorities the user still has of the ones
+// the token is scoped to
+func (service *apiTokenService) Resolve(ctx context.Context, token string) commonModel.TokenInfo {
+	tokens := service.repository.FindByHash(ctx, hashApiToken(token))
+	if len(tokens) == 0 {
+		panic(commonError.TokenInvalidError)
+	}
+	apiToken := tokens[0]
+	if apiToken.IsExpired() {
+		panic(commonError.TokenExpiredError)
+	}
+
+	user := service.getUser(ctx, apiToken.UserID)
+	if user.IsBlocked {
+		panic(commonError.UserBlockedError)
+	}
+
+	if now := time.Now(); apiToken.LastUsedDate == nil || now.Sub(*apiToken.LastUsedDate) > lastUsedPrecision {
+		service.repository.UpdateLastUsedDate(ctx, apiToken.ID, now)
+	}
+
+	userAuthorities := util.GetUserAuthorities(user)
+	tokenInfo := commonModel.TokenInfo{
+		UserId:    user.ID,
+		Username:  user.Username,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Email:     user.Email,
+		Authorities: commonUtil.ArrayFilter(apiToken.Authorities, func(authority string) bool {
+			return commonUtil.ArrayContains(userAuthorities, authority)
+		}),
+		TokenType: commonModel.ApiTokenType,
+		StandardClaims: jwt.StandardClaims{
+			Id:     apiToken.ID.String(),
+			Issuer: config.CoreConfig.AuthorizationServer.GetIssuer(),
+		},
+	}
+	if apiToken.CreateDate != nil {
+		tokenInfo.IssuedAt, tokenInfo.IssuedAtMs = apiToken.CreateDate.Unix(), apiToken.CreateDate.UnixMilli()
+	}
+	if apiToken.ExpireDate != nil {
+		tokenInfo.ExpiresAt = apiToken.ExpireDate.Unix()
+	}
+	return tokenInfo
+}
+
+// create issues the token scoped to the authorities both the user and the current token have, so nobody
+// issues a token with more rights than their own. The secret is generated and only its hash is stored
+func (service *apiTokenService) create(ctx context.Context, user model.User, kind string, prefix string, token model.ApiToken) model.ApiToken {
+	token.Name = strings.TrimSpace(token.Name)
+	if token.Name == "" || len(token.Authorities) == 0 {
+		panic(commonError.IllegalArgumentError)
+	}
+	if token.ExpireDate != nil && token.ExpireDate.Before(time.Now()) {
+		panic(commonError.IllegalArgumentError)
+	}
+	userAuthorities := util.GetUserAuthorities(user)
+	callerAuthorities := commonUtil.MustGetCurrentTokenInfo(ctx).Authorities
+	isOwner := commonUtil.ArrayContains(callerAuthorities, model.OwnerAuthority.Method)
+	for _, authority := range token.Authorities {
+		if !commonUtil.ArrayContains(userAuthorities, authority) {
+			panic(commonError.NotEnoughRightsError)
+		}
+		if !isOwner && !commonUtil.ArrayContains(callerAuthorities, authority) {
+			panic(commonError.NotEnoughRightsError)
+		}
+	}
+
+	secret := prefix + commonUtil.RandomString(apiTokenSecretSize)
+	token.ID, token.Kind, token.UserID = uuid.Nil, kind, user.ID
+	token.Authorities = commonUtil.Unique(token.Authorities)
+	token.Prefix = secret[:len(prefix)+apiTokenVisiblePrefix]
+	token.TokenHash = hashApiToken(secret)
+	token.LastUsedDate, token.CreateDate = nil, nil
+
+	token = service.repository.Create(ctx, []model.ApiToken{token})[0]
+	token.Token = secret
+	return token
+}
+
+// getUser returns the user with the authorities of the roles
+func (service *apiTokenService) getUser(ctx context.Context, id uuid.UUID) model.User {
+	users := service.userRepository.GetById(ctx, []uuid.UUID{id})
+	if len(users) == 0 {
+		panic(commonError.NotFoundError)
+	}
+	return service.userService.FindByUsername(ctx, users[0].Username)
+}
+
+func hashApiToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
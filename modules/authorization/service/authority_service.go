@@ -107,12 +107,40 @@ func (service *authorityService) init() *authorityService {
 	model.CreateAssetAuthority = service.createIfNotExists(ctx, model.CreateAssetAuthority)
 	model.UpdateAssetAuthority = service.createIfNotExists(ctx, model.UpdateAssetAuthority)
 	model.DeleteAssetAuthority = service.createIfNotExists(ctx, model.DeleteAssetAuthority)
+	model.EditAssetShareAuthority = service.createIfNotExists(ctx, model.EditAssetShareAuthority)
+	model.EditAssetGrantAuthority = service.createIfNotExists(ctx, model.EditAssetGrantAuthority)
+	model.ManageAllAssetsAuthority = service.createIfNotExists(ctx, model.ManageAllAssetsAuthority)
 
 	model.ReadCashFlowAuthority = service.createIfNotExists(ctx, model.ReadCashFlowAuthority)
 	model.CreateCashFlowAuthority = service.createIfNotExists(ctx, model.CreateCashFlowAuthority)
 	model.UpdateCashFlowAuthority = service.createIfNotExists(ctx, model.UpdateCashFlowAuthority)
 	model.DeleteCashFlowAuthority = service.createIfNotExists(ctx, model.DeleteCashFlowAuthority)
 
+	model.ReadWorkOrderAuthority = service.createIfNotExists(ctx, model.ReadWorkOrderAuthority)
+	model.CreateWorkOrderAuthority = service.createIfNotExists(ctx, model.CreateWorkOrderAuthority)
+	model.UpdateWorkOrderAuthority = service.createIfNotExists(ctx, model.UpdateWorkOrderAuthority)
+	model.DeleteWorkOrderAuthority = service.createIfNotExists(ctx, model.DeleteWorkOrderAuthority)
+
+	model.ReadPreventiveTaskAuthority = service.createIfNotExists(ctx, model.ReadPreventiveTaskAuthority)
+	model.CreatePreventiveTaskAuthority = service.createIfNotExists(ctx, model.CreatePreventiveTaskAuthority)
+	model.UpdatePreventiveTaskAuthority = service.createIfNotExists(ctx, model.UpdatePreventiveTaskAuthority)
+	model.DeletePreventiveTaskAuthority = service.createIfNotExists(ctx, model.DeletePreventiveTaskAuthority)
+
+	model.ReadNotificationAuthority = service.createIfNotExists(ctx, model.ReadNotificationAuthority)
+	model.EditNotificationAuthority = service.createIfNotExists(ctx, model.EditNotificationAuthority)
+
+	model.ReadReconciliationAuthority = service.createIfNotExists(ctx, model.ReadReconciliationAuthority)
+	model.EditReconciliationAuthority = service.createIfNotExists(ctx, model.EditReconciliationAuthority)
+
+	model.ReadInvestmentAuthority = service.createIfNotExists(ctx, model.ReadInvestmentAuthority)
+	model.EditInvestmentAuthority = service.createIfNotExists(ctx, model.EditInvestmentAuthority)
+	model.ReadDocumentAuthority = service.createIfNotExists(ctx, model.ReadDocumentAuthority)
+	model.EditDocumentAuthority = service.createIfNotExists(ctx, model.EditDocumentAuthority)
+	model.ReadTagAuthority = service.createIfNotExists(ctx, model.ReadTagAuthority)
+	model.EditTagAuthority = service.createIfNotExists(ctx, model.EditTagAuthority)
+	model.ReadViewAuthority = service.createIfNotExists(ctx, model.ReadViewAuthority)
+	model.EditViewAuthority = service.createIfNotExists(ctx, model.EditViewAuthority)
+
 	model.CreateAttachmentAuthority = service.createIfNotExists(ctx, model.CreateAttachmentAuthority)
 	model.DeleteAttachmentAuthority = service.createIfNotExists(ctx, model.DeleteAttachmentAuthority)
 
@@ -132,6 +160,11 @@ func (service *authorityService) init() *authorityService {
 	model.DeleteUserAuthority = service.createIfNotExists(ctx, model.DeleteUserAuthority)
 	model.EditRoleUserAuthority = service.createIfNotExists(ctx, model.EditRoleUserAuthority)
 	model.EditAuthorityUserAuthority = service.createIfNotExists(ctx, model.EditAuthorityUserAuthority)
+	model.ReadLoginEventAuthority = service.createIfNotExists(ctx, model.ReadLoginEventAuthority)
+	model.ReadApiKeyAuthority = service.createIfNotExists(ctx, model.ReadApiKeyAuthority)
+	model.EditApiKeyAuthority = service.createIfNotExists(ctx, model.EditApiKeyAuthority)
+	model.ReadOAuthClientAuthority = service.createIfNotExists(ctx, model.ReadOAuthClientAuthority)
+	model.EditOAuthClientAuthority = service.createIfNotExists(ctx, model.EditOAuthClientAuthority)
 
 	model.ReadCityAuthority = service.createIfNotExists(ctx, model.ReadCityAuthority)
 	model.CreateCityAuthority = service.createIfNotExists(ctx, model.CreateCityAuthority)
@@ -25,23 +25,56 @@ package service
  */
 
 import (
+	commonCache "assets/common/cache"
+	"assets/common/config"
 	commonError "assets/common/custom_error"
+	commonModel "assets/common/model"
 	commonUtil "assets/common/util"
 	"assets/modules/authorization/model"
+	"assets/modules/authorization/repository"
 	"assets/modules/authorization/util"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxMfaAttempts is the number of wrong codes the MFA token is revoked after
+const maxMfaAttempts = 5
+
+const (
+	externalLoginValidity   = 10 * time.Minute
+	externalLoginSecretSize = 32
 )
 
 var authorizationSrv AuthorizationService
 
 type AuthorizationService interface {
-	GenerateToken(ctx context.Context, username string, password string) model.AuthorizationResponse
-	RefreshToken(ctx context.Context, refreshToken string) model.AuthorizationResponse
+	GenerateToken(ctx context.Context, username string, password string, newPassword string, source model.LoginSource) model.AuthorizationResponse
+	RefreshToken(ctx context.Context, refreshToken string, client *model.OAuthClient) model.AuthorizationResponse
+	VerifyMfa(ctx context.Context, mfaToken string, code string, source model.LoginSource) model.AuthorizationResponse
+	RevokeToken(ctx context.Context, token string)
+	Logout(ctx context.Context)
+
+	GetIdentityProviders(ctx context.Context) []string
+	StartExternalLogin(ctx context.Context, provider string, redirectPath string) (string, string)
+	FinishExternalLogin(ctx context.Context, provider string, code string, state string) (model.AuthorizationResponse, string)
 }
 
 type authorizationService struct {
-	userService UserService
-	roleService RoleService
+	userService               UserService
+	roleService               RoleService
+	signingKeyService         SigningKeyService
+	mfaService                MfaService
+	passwordService           PasswordService
+	loginProtectionService    LoginProtectionService
+	externalAccountRepository repository.ExternalAccountRepository
+	federationStateRepository repository.FederationStateRepository
+	identityProviders         map[string]IdentityProvider
 }
 
 func GetAuthorizationService() AuthorizationService {
@@ -49,20 +82,32 @@ func GetAuthorizationService() AuthorizationService {
 		return authorizationSrv
 	}
 	authorizationSrv = &authorizationService{
-		userService: GetUserService(),
-		roleService: GetRoleService(),
+		userService:               GetUserService(),
+		roleService:               GetRoleService(),
+		signingKeyService:         GetSigningKeyService(),
+		mfaService:                GetMfaService(),
+		passwordService:           GetPasswordService(),
+		loginProtectionService:    GetLoginProtectionService(),
+		externalAccountRepository: repository.GetExternalAccountRepository(),
+		federationStateRepository: repository.GetFederationStateRepository(),
+		identityProviders:         newIdentityProviders(config.CoreConfig.AuthorizationServer.IdentityProviders),
 	}
 	return authorizationSrv
 }
 
-func (service *authorizationService) GenerateToken(ctx context.Context, username string, password string) model.AuthorizationResponse {
+// GenerateToken issues the tokens by the password. The failed attempts are throttled, and while the second
+// factor is pending the failures aren't forgotten, so the codes can't be guessed by logging in again.
+// The user obliged to change the password gets no tokens until the new password comes with the current one
+func (service *authorizationService) GenerateToken(ctx context.Context, username string, password string, newPassword string, source model.LoginSource) model.AuthorizationResponse {
 	if username == "" || password == "" {
 		panic(commonError.UsernameAndPasswordMastNotBeNullError)
 	}
+	service.loginProtectionService.Check(ctx, username, source)
 
 	if isAuthorize := anyAuthorize(ctx, username, password,
 		service.userService.Authorize,
 	); !isAuthorize {
+		service.loginProtectionService.Fail(ctx, username, source)
 		panic(commonError.UsernameOrPasswordIsIncorrectError)
 	}
 
@@ -70,21 +115,320 @@ func (service *authorizationService) GenerateToken(ctx context.Context, username
 	if commonUtil.IsZeroObject(user.ID) {
 		panic(commonError.NotFoundError)
 	}
+	if user.IsBlocked {
+		panic(commonError.UserBlockedError)
+	}
+	if user.IsServiceAccount {
+		panic(commonError.NotEnoughRightsError)
+	}
+	if user.PasswordChangeRequired {
+		if newPassword == "" {
+			return model.AuthorizationResponse{PasswordChangeRequired: true}
+		}
+		user = service.passwordService.Set(ctx, user, newPassword)
+	}
 
-	return commonUtil.MustOne(util.NewAuthorizationResponse(user))
+	if response, ok := service.requiresMfa(ctx, user); ok {
+		return response
+	}
+	service.loginProtectionService.Succeed(ctx, user, source)
+	return commonUtil.MustOne(util.NewAuthorizationResponse(user, "", service.signingKeyService.GetSigningKey(ctx)))
 }
-func (service *authorizationService) RefreshToken(ctx context.Context, refreshToken string) model.AuthorizationResponse {
+
+// VerifyMfa exchanges the MFA token of the password login for the tokens by the code of the authenticator
+// or a recovery code. The MFA token is used once and revoked after several wrong codes
+func (service *authorizationService) VerifyMfa(ctx context.Context, mfaToken string, code string, source model.LoginSource) model.AuthorizationResponse {
+	token := commonUtil.ParseJwtToken(mfaToken)
+	if token.TokenType != commonModel.MfaTokenType {
+		panic(commonError.TokenInvalidError)
+	}
+	if commonCache.IsTokenRevoked(ctx, token) {
+		panic(commonError.TokenRevokedError)
+	}
+	service.loginProtectionService.Check(ctx, token.Username, source)
+
+	user := service.userService.FindByUsername(ctx, token.Username)
+	if commonUtil.IsZeroObject(user.ID) {
+		panic(commonError.NotFoundError)
+	}
+	if user.IsBlocked {
+		panic(commonError.UserBlockedError)
+	}
+	if !service.mfaService.Verify(ctx, user.ID, code) {
+		service.loginProtectionService.Fail(ctx, user.Username, source)
+		if commonCache.CountAttempt(ctx, "mfa:"+token.Id, time.Until(time.Unix(token.ExpiresAt, 0))) >= maxMfaAttempts {
+			log.WithContext(ctx).Warnf("AuthorizationService: MFA token of user %s is revoked after %d wrong codes", user.Username, maxMfaAttempts)
+			commonCache.RevokeToken(ctx, token)
+		}
+		panic(commonError.MfaCodeIsIncorrectError)
+	}
+
+	commonCache.RevokeToken(ctx, token)
+	service.loginProtectionService.Succeed(ctx, user, source)
+	return commonUtil.MustOne(util.NewAuthorizationResponse(user, "", service.signingKeyService.GetSigningKey(ctx)))
+}
+
+// RefreshToken exchanges the refresh token for new tokens of the same session, every refresh token
+// is exchanged once. A replayed refresh token may be stolen, so the whole session is revoked then.
+// The refresh tokens of the OAuth clients are exchanged by the same client only, if it is still allowed
+// the refresh token grant. The scopes the client isn't allowed anymore are dropped
+func (service *authorizationService) RefreshToken(ctx context.Context, refreshToken string, client *model.OAuthClient) model.AuthorizationResponse {
+	clientId := ""
+	if client != nil {
+		if !client.HasGrantType(model.RefreshTokenGrant) {
+			panic(commonError.UnauthorizedClientError)
+		}
+		clientId = client.ID.String()
+	}
+
 	token := commonUtil.ParseJwtToken(refreshToken)
 	if token.Username == "" {
 		panic(commonError.UsernameAndPasswordMastNotBeNullError)
 	}
+	if token.TokenType != commonModel.RefreshTokenType {
+		panic(commonError.TokenInvalidError)
+	}
+	if token.ClientId != clientId {
+		panic(commonError.InvalidGrantError)
+	}
+	if commonCache.IsTokenRevoked(ctx, token) {
+		panic(commonError.TokenRevokedError)
+	}
+	if !commonCache.MarkRefreshTokenUsed(ctx, token) {
+		log.WithContext(ctx).Warnf("AuthorizationService: refresh token %s is replayed, session %s is revoked", token.Id, token.SessionId)
+		commonCache.RevokeSession(ctx, token.SessionId)
+		panic(commonError.TokenRevokedError)
+	}
 
 	user := service.userService.FindByUsername(ctx, token.Username)
 	if commonUtil.IsZeroObject(user.ID) {
 		panic(commonError.NotFoundError)
 	}
+	if user.IsBlocked {
+		panic(commonError.UserBlockedError)
+	}
+
+	var opts []util.TokenOption
+	if client != nil {
+		opts = append(opts, util.WithClient(clientId, client.GetAllowedScope(token.Scope)))
+	}
+	return commonUtil.MustOne(util.NewAuthorizationResponse(user, token.SessionId, service.signingKeyService.GetSigningKey(ctx), opts...))
+}
+
+// RevokeToken puts the access or refresh token into the denylist. Invalid and expired tokens are ignored,
+// since they can't be used anyway
+func (service *authorizationService) RevokeToken(ctx context.Context, token string) {
+	defer func() {
+		if err := recover(); err != nil {
+			log.WithContext(ctx).Infof("AuthorizationService: invalid token isn't revoked: %v", err)
+		}
+	}()
+	commonCache.RevokeToken(ctx, commonUtil.ParseJwtToken(token))
+}
+
+// Logout revokes all tokens of the current session
+func (service *authorizationService) Logout(ctx context.Context) {
+	tokenInfo := commonUtil.MustGetCurrentTokenInfo(ctx)
+	if tokenInfo.SessionId == "" {
+		commonCache.RevokeToken(ctx, tokenInfo)
+		return
+	}
+	commonCache.RevokeSession(ctx, tokenInfo.SessionId)
+}
+
+func (service *authorizationService) GetIdentityProviders(ctx context.Context) []string {
+	result := make([]string, 0, len(service.identityProviders))
+	for name := range service.identityProviders {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// StartExternalLogin returns the url of the external identity provider the user signs in at. The login is
+// bound to the state by the nonce and the PKCE verifier, the user is redirected to the local path afterward.
+// The state is returned as well, so it is kept by the browser the login is started in
+func (service *authorizationService) StartExternalLogin(ctx context.Context, provider string, redirectPath string) (string, string) {
+	identityProvider := service.getIdentityProvider(provider)
+	if redirectPath != "" && (!strings.HasPrefix(redirectPath, "/") || strings.HasPrefix(redirectPath, "//") || strings.HasPrefix(redirectPath, "/\\")) {
+		panic(commonError.IllegalArgumentError)
+	}
+
+	state := commonUtil.RandomString(externalLoginSecretSize)
+	federationState := model.FederationState{
+		Provider:     provider,
+		Nonce:        commonUtil.RandomString(externalLoginSecretSize),
+		CodeVerifier: commonUtil.RandomString(externalLoginSecretSize),
+		RedirectPath: redirectPath,
+	}
+	service.federationStateRepository.Save(ctx, state, federationState, externalLoginValidity)
+
+	challenge := sha256.Sum256([]byte(federationState.CodeVerifier))
+	authorizationUrl, err := identityProvider.GetAuthorizationUrl(ctx, getExternalLoginCallbackUri(provider), state,
+		federationState.Nonce, base64.RawURLEncoding.EncodeToString(challenge[:]))
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Errorf("AuthorizationService: identity provider %s is unavailable", provider)
+		panic(commonError.ExternalLoginFailedError)
+	}
+	return authorizationUrl, state
+}
 
-	return commonUtil.MustOne(util.NewAuthorizationResponse(user))
+// FinishExternalLogin issues the tokens to the user linked to the external account, the account is linked
+// to the user of the same verified email on the first login when the provider is trusted. The roles mapped
+// by the provider are synced on every login, the other roles of the user are kept. The MFA token is returned
+// instead of the tokens when the user has to enter the second factor
+func (service *authorizationService) FinishExternalLogin(ctx context.Context, provider string, code string, state string) (model.AuthorizationResponse, string) {
+	identityProvider := service.getIdentityProvider(provider)
+	federationState, ok := service.federationStateRepository.Consume(ctx, state)
+	if !ok || federationState.Provider != provider {
+		panic(commonError.ExternalLoginFailedError)
+	}
+
+	identity, err := identityProvider.Exchange(ctx, getExternalLoginCallbackUri(provider), code, federationState.CodeVerifier, federationState.Nonce)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Warnf("AuthorizationService: login via identity provider %s failed", provider)
+		panic(commonError.ExternalLoginFailedError)
+	}
+
+	user := service.linkExternalAccount(ctx, identity)
+	service.mapRoles(ctx, user, identity)
+
+	user = service.userService.FindByUsername(ctx, user.Username)
+	if user.IsBlocked {
+		panic(commonError.UserBlockedError)
+	}
+	if response, ok := service.requiresMfa(ctx, user); ok {
+		return response, federationState.RedirectPath
+	}
+	return commonUtil.MustOne(util.NewAuthorizationResponse(user, "", service.signingKeyService.GetSigningKey(ctx))), federationState.RedirectPath
+}
+
+// requiresMfa returns the MFA token of the login when the user has enabled MFA or is obliged to use it
+func (service *authorizationService) requiresMfa(ctx context.Context, user model.User) (model.AuthorizationResponse, bool) {
+	enabled := service.mfaService.IsEnabled(ctx, user.ID)
+	if !enabled && !service.mfaService.IsRequired(ctx, user) {
+		return model.NilAuthorizationResponse, false
+	}
+	return model.AuthorizationResponse{
+		MfaToken:             commonUtil.MustOne(util.NewMfaToken(user, service.signingKeyService.GetSigningKey(ctx))),
+		MfaEnrolmentRequired: !enabled,
+	}, true
+}
+
+func (service *authorizationService) getIdentityProvider(provider string) IdentityProvider {
+	identityProvider, ok := service.identityProviders[provider]
+	if !ok {
+		panic(commonError.NotFoundError)
+	}
+	return identityProvider
+}
+
+func (service *authorizationService) linkExternalAccount(ctx context.Context, identity model.ExternalIdentity) model.User {
+	now := time.Now()
+	if accounts := service.externalAccountRepository.FindBySubject(ctx, identity.Provider, identity.Subject); len(accounts) != 0 {
+		account := accounts[0]
+		account.LastLoginDate = &now
+		service.externalAccountRepository.Update(ctx, []model.ExternalAccount{account})
+		return service.userService.GetById(ctx, account.UserID)
+	}
+
+	property := config.CoreConfig.AuthorizationServer.IdentityProviders[identity.Provider]
+	var user model.User
+	if property.TrustEmail && identity.Email != "" && identity.EmailVerified {
+		user = service.userService.FindByEmail(ctx, identity.Email)
+		if !commonUtil.IsZeroObject(user.ID) && isPrivileged(service.userService.FindByUsername(ctx, user.Username)) {
+			log.WithContext(ctx).Warnf("AuthorizationService: account %s of identity provider %s isn't linked to privileged user %s", identity.Subject, identity.Provider, user.Username)
+			panic(commonError.ExternalAccountNotLinkedError)
+		}
+	}
+	if commonUtil.IsZeroObject(user.ID) {
+		if !property.CreateUsers {
+			panic(commonError.ExternalAccountNotLinkedError)
+		}
+		user = service.userService.Create(ctx, service.newExternalUser(ctx, identity))
+	}
+
+	service.externalAccountRepository.Create(ctx, []model.ExternalAccount{{
+		UserID:        user.ID,
+		Provider:      identity.Provider,
+		Subject:       identity.Subject,
+		Email:         identity.Email,
+		LastLoginDate: &now,
+	}})
+	log.WithContext(ctx).Infof("AuthorizationService: account %s of identity provider %s is linked to user %s", identity.Subject, identity.Provider, user.Username)
+	return user
+}
+
+// newExternalUser returns the user of the external account without password, so the user signs in only
+// via the provider. The unverified email isn't kept, since the accounts are linked by the email
+func (service *authorizationService) newExternalUser(ctx context.Context, identity model.ExternalIdentity) model.User {
+	email := ""
+	if identity.EmailVerified {
+		email = identity.Email
+	}
+
+	username := identity.Provider + ":" + identity.Subject
+	for _, candidate := range []string{identity.PreferredUsername, email} {
+		if candidate != "" && commonUtil.IsZeroObject(service.userService.FindByUsername(ctx, candidate).ID) {
+			username = candidate
+			break
+		}
+	}
+	return model.NewUser(username, identity.GivenName, identity.FamilyName, email)
+}
+
+// mapRoles grants the user the roles mapped from the claims of the provider and revokes the other roles
+// managed by the provider. The admin role is never managed by the providers
+func (service *authorizationService) mapRoles(ctx context.Context, user model.User, identity model.ExternalIdentity) {
+	property := config.CoreConfig.AuthorizationServer.IdentityProviders[identity.Provider]
+	isMappable := func(name string) bool { return name != model.AdminRole.Name }
+	mappedRoles := commonUtil.ArrayFilter(property.GetMappedRoles(identity.Roles), isMappable)
+	managedRoles := commonUtil.ArrayFilter(property.GetManagedRoles(), isMappable)
+
+	var addedRoles, removedRoles []uuid.UUID
+	for _, name := range commonUtil.Unique(mappedRoles) {
+		if findRoleInArray(user.Roles, name) != nil {
+			continue
+		}
+		role := service.roleService.FindByName(ctx, name)
+		if commonUtil.IsZeroObject(role.ID) {
+			log.WithContext(ctx).Warnf("AuthorizationService: role %s mapped by identity provider %s doesn't exist", name, identity.Provider)
+			continue
+		}
+		addedRoles = append(addedRoles, role.ID)
+	}
+	for _, role := range user.Roles {
+		if commonUtil.ArrayContains(managedRoles, role.Name) && !commonUtil.ArrayContains(mappedRoles, role.Name) {
+			removedRoles = append(removedRoles, role.ID)
+		}
+	}
+
+	if len(addedRoles) != 0 {
+		service.userService.AddRoles(ctx, user.ID, addedRoles)
+	}
+	if len(removedRoles) != 0 {
+		service.userService.RemoveRoles(ctx, user.ID, removedRoles)
+	}
+}
+
+func getExternalLoginCallbackUri(provider string) string {
+	return config.CoreConfig.AuthorizationServer.GetIssuer() + "/api/authorization/federation/" + provider + "/callback"
+}
+
+// isPrivileged tells whether the user administers the system, the external accounts are never linked
+// to such users by the email
+func isPrivileged(user model.User) bool {
+	return user.IsServiceAccount || findRoleInArray(user.Roles, model.AdminRole.Name) != nil ||
+		commonUtil.ArrayContains(util.GetUserAuthorities(user), model.OwnerAuthority.Method)
+}
+
+func findRoleInArray(roles []*model.Role, name string) *model.Role {
+	for _, role := range roles {
+		if role.Name == name {
+			return role
+		}
+	}
+	return nil
 }
 
 func anyAuthorize(ctx context.Context, username string, password string, funcs ...func(ctx context.Context, username string, password string) bool) bool {
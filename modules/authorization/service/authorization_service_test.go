@@ -0,0 +1,127 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonCache "assets/common/cache"
+	"assets/common/config"
+	commonError "assets/common/custom_error"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"assets/modules/authorization/util"
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+var (
+	testRedis     *miniredis.Miniredis
+	testRedisOnce sync.Once
+)
+
+// startRedis points the redis cache to the in-memory redis shared by the tests of the package,
+// the redis is emptied for every test
+func startRedis(t *testing.T) {
+	testRedisOnce.Do(func() {
+		testRedis = commonUtil.MustOne(miniredis.Run())
+		config.CoreConfig.Database.Redis.Host = testRedis.Host()
+		config.CoreConfig.Database.Redis.Port = commonUtil.MustOne(strconv.Atoi(testRedis.Port()))
+	})
+	testRedis.FlushAll()
+}
+
+// useSharedSignKey signs the tokens by the shared sign key for the test
+func useSharedSignKey(t *testing.T) {
+	conf := config.CoreConfig.AuthorizationServer
+	t.Cleanup(func() { config.CoreConfig.AuthorizationServer = conf })
+
+	config.CoreConfig.AuthorizationServer.EncodingAlg = "HS256"
+	config.CoreConfig.AuthorizationServer.SignKey = "test-sign-key"
+	config.CoreConfig.AuthorizationServer.AccessTokenValiditySeconds = 300
+	config.CoreConfig.AuthorizationServer.RefreshTokenValiditySeconds = 3600
+}
+
+type fakeUserService struct {
+	UserService
+	user model.User
+}
+
+func (service fakeUserService) FindByUsername(ctx context.Context, username string) model.User {
+	if username != service.user.Username {
+		return model.User{}
+	}
+	return service.user
+}
+
+type fakeSigningKeyService struct {
+	SigningKeyService
+}
+
+func (service fakeSigningKeyService) GetSigningKey(ctx context.Context) *model.SigningKey {
+	return nil
+}
+
+func TestRefreshTokenReplay(t *testing.T) {
+	startRedis(t)
+	useSharedSignKey(t)
+	ctx := context.Background()
+	user := model.User{ID: uuid.New(), Username: "user"}
+	service := &authorizationService{userService: fakeUserService{user: user}, signingKeyService: fakeSigningKeyService{}}
+
+	login := commonUtil.MustOne(util.NewAuthorizationResponse(user, "", nil))
+	otherLogin := commonUtil.MustOne(util.NewAuthorizationResponse(user, "", nil))
+
+	refreshed := service.RefreshToken(ctx, login.RefreshToken, nil)
+	if refreshed.SessionId != login.SessionId {
+		t.Fatalf("RefreshToken() session = %s, want %s", refreshed.SessionId, login.SessionId)
+	}
+	if err := catchPanic(func() { service.RefreshToken(ctx, login.RefreshToken, nil) }); err != commonError.TokenRevokedError {
+		t.Fatalf("RefreshToken() of the replayed token = %v, want TokenRevokedError", err)
+	}
+
+	if !commonCache.IsTokenRevoked(ctx, refreshed.TokenInfo) {
+		t.Error("the access token issued for the replayed refresh token isn't revoked")
+	}
+	if err := catchPanic(func() { service.RefreshToken(ctx, refreshed.RefreshToken, nil) }); err != commonError.TokenRevokedError {
+		t.Errorf("RefreshToken() of the rotated token = %v, want TokenRevokedError", err)
+	}
+	if commonCache.IsTokenRevoked(ctx, otherLogin.TokenInfo) {
+		t.Error("the token of the other session is revoked")
+	}
+	if err := catchPanic(func() { service.RefreshToken(ctx, otherLogin.RefreshToken, nil) }); err != nil {
+		t.Errorf("RefreshToken() of the other session = %v", err)
+	}
+}
+
+func catchPanic(run func()) (err any) {
+	defer func() {
+		err = recover()
+	}()
+	run()
+	return nil
+}
@@ -0,0 +1,51 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/config"
+	"assets/modules/authorization/model"
+	"context"
+)
+
+// IdentityProvider is the connector of the external identity provider the users sign in with
+type IdentityProvider interface {
+	GetName() string
+	// GetAuthorizationUrl returns the url the user is redirected to for signing in, the provider calls
+	// the redirect uri back with the code and the state
+	GetAuthorizationUrl(ctx context.Context, redirectUri string, state string, nonce string, codeChallenge string) (string, error)
+	// Exchange redeems the code for the identity of the signed-in user
+	Exchange(ctx context.Context, redirectUri string, code string, codeVerifier string, nonce string) (model.ExternalIdentity, error)
+}
+
+// newIdentityProviders returns the connectors of the configured providers by their names, all of them
+// are OpenID Connect providers so far
+func newIdentityProviders(properties map[string]config.IdentityProviderProperty) map[string]IdentityProvider {
+	result := make(map[string]IdentityProvider, len(properties))
+	for name, property := range properties {
+		result[name] = newOidcIdentityProvider(name, property)
+	}
+	return result
+}
@@ -0,0 +1,154 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonCache "assets/common/cache"
+	"assets/common/config"
+	commonError "assets/common/custom_error"
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"assets/modules/authorization/repository"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	backoffLock = "backoff"
+	lockoutLock = "lockout"
+)
+
+var loginProtectionSrv LoginProtectionService
+
+// LoginProtectionService throttles guessing of the passwords and the second factor codes. The failures are
+// counted per username and per ip, so neither spreading the attempts over the users nor over the addresses helps
+type LoginProtectionService interface {
+	Check(ctx context.Context, username string, source model.LoginSource)
+	Fail(ctx context.Context, username string, source model.LoginSource)
+	Succeed(ctx context.Context, user model.User, source model.LoginSource)
+	Unlock(ctx context.Context, userId uuid.UUID, source model.LoginSource)
+	GetEvents(ctx context.Context, username string, page commonModel.Pageable) commonModel.Page[model.LoginEvent]
+}
+
+type loginProtectionService struct {
+	repository  repository.LoginEventRepository
+	userService UserService
+}
+
+func GetLoginProtectionService() LoginProtectionService {
+	if loginProtectionSrv != nil {
+		return loginProtectionSrv
+	}
+	loginProtectionSrv = &loginProtectionService{
+		repository:  repository.GetLoginEventRepository(),
+		userService: GetUserService(),
+	}
+	return loginProtectionSrv
+}
+
+// Check rejects the attempt while the username or the ip waits for the delay after the failures
+// or the account is locked
+func (service *loginProtectionService) Check(ctx context.Context, username string, source model.LoginSource) {
+	if reason, retryAfter := commonCache.GetAttemptsLock(ctx, userAttemptsKey(username)); retryAfter > 0 {
+		log.WithContext(ctx).Warnf("LoginProtectionService: Attempt of %s is rejected for %s, %s", username, retryAfter, reason)
+		if reason == lockoutLock {
+			panic(commonError.WithRetryAfter(commonError.AccountLockedError, retryAfter))
+		}
+		panic(commonError.WithRetryAfter(commonError.TooManyAttemptsError, retryAfter))
+	}
+	if _, retryAfter := commonCache.GetAttemptsLock(ctx, ipAttemptsKey(source.Ip)); retryAfter > 0 {
+		log.WithContext(ctx).Warnf("LoginProtectionService: Attempt from %s is rejected for %s", source.Ip, retryAfter)
+		panic(commonError.WithRetryAfter(commonError.TooManyAttemptsError, retryAfter))
+	}
+}
+
+// Fail counts the failure, delays the next attempt exponentially and locks the account
+// once the failures of the username reach the maximum
+func (service *loginProtectionService) Fail(ctx context.Context, username string, source model.LoginSource) {
+	prop := config.CoreConfig.AuthorizationServer.LoginProtection
+	userFailures := commonCache.CountAttempt(ctx, userAttemptsKey(username), prop.GetWindow())
+	ipFailures := commonCache.CountAttempt(ctx, ipAttemptsKey(source.Ip), prop.GetWindow())
+	service.audit(ctx, model.NewLoginEvent(model.LoginFailedEvent, username, source))
+
+	if userFailures >= prop.GetMaxUserFailures() {
+		log.WithContext(ctx).Warnf("LoginProtectionService: Account %s is locked after %d failures", username, userFailures)
+		commonCache.LockAttempts(ctx, userAttemptsKey(username), lockoutLock, prop.GetLockoutDuration())
+		event := model.NewLoginEvent(model.AccountLockedEvent, username, source)
+		event.Details = fmt.Sprintf("%d failures", userFailures)
+		service.audit(ctx, event)
+	} else if delay := prop.GetDelay(userFailures); delay > 0 {
+		commonCache.LockAttempts(ctx, userAttemptsKey(username), backoffLock, delay)
+	}
+
+	if ipFailures >= prop.GetMaxIpFailures() {
+		log.WithContext(ctx).Warnf("LoginProtectionService: Attempts from %s are rejected after %d failures", source.Ip, ipFailures)
+		commonCache.LockAttempts(ctx, ipAttemptsKey(source.Ip), lockoutLock, prop.GetLockoutDuration())
+	} else if delay := prop.GetDelay(ipFailures); delay > 0 {
+		commonCache.LockAttempts(ctx, ipAttemptsKey(source.Ip), backoffLock, delay)
+	}
+}
+
+// Succeed forgets the failures of the user. The failures of the ip are kept, otherwise
+// an own account would let to reset them
+func (service *loginProtectionService) Succeed(ctx context.Context, user model.User, source model.LoginSource) {
+	commonCache.ResetAttempts(ctx, userAttemptsKey(user.Username))
+	event := model.NewLoginEvent(model.LoginSucceededEvent, user.Username, source)
+	event.UserID = &user.ID
+	service.audit(ctx, event)
+}
+
+func (service *loginProtectionService) Unlock(ctx context.Context, userId uuid.UUID, source model.LoginSource) {
+	user := service.userService.GetById(ctx, userId)
+	commonCache.ResetAttempts(ctx, userAttemptsKey(user.Username))
+
+	event := model.NewLoginEvent(model.AccountUnlockedEvent, user.Username, source)
+	event.UserID = &user.ID
+	event.Details = fmt.Sprintf("unlocked by %s", commonUtil.MustGetCurrentTokenInfo(ctx).Username)
+	service.audit(ctx, event)
+}
+
+func (service *loginProtectionService) GetEvents(ctx context.Context, username string, page commonModel.Pageable) commonModel.Page[model.LoginEvent] {
+	return service.repository.FindByUsername(ctx, username, page)
+}
+
+func (service *loginProtectionService) audit(ctx context.Context, event model.LoginEvent) {
+	if event.UserID == nil {
+		if user := service.userService.FindByUsername(ctx, event.Username); !commonUtil.IsZeroObject(user.ID) {
+			event.UserID = &user.ID
+		}
+	}
+	service.repository.Create(ctx, []model.LoginEvent{event})
+}
+
+func userAttemptsKey(username string) string {
+	return "login:user:" + username
+}
+
+func ipAttemptsKey(ip string) string {
+	return "login:ip:" + ip
+}
@@ -0,0 +1,195 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonError "assets/common/custom_error"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"assets/modules/authorization/repository"
+	"assets/modules/authorization/util"
+	"context"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+// totpIssuer is the account issuer shown by the authenticator apps
+const totpIssuer = "Assets"
+
+var mfaSrv MfaService
+
+type MfaService interface {
+	GetStatus(ctx context.Context, user model.User) model.MfaStatus
+	IsEnabled(ctx context.Context, userId uuid.UUID) bool
+	IsRequired(ctx context.Context, user model.User) bool
+
+	StartEnrolment(ctx context.Context, user model.User) model.MfaEnrolment
+	ConfirmEnrolment(ctx context.Context, userId uuid.UUID, code string) model.RecoveryCodes
+	RegenerateRecoveryCodes(ctx context.Context, userId uuid.UUID, code string) model.RecoveryCodes
+	Disable(ctx context.Context, user model.User, code string)
+	Reset(ctx context.Context, userId uuid.UUID)
+	Verify(ctx context.Context, userId uuid.UUID, code string) bool
+}
+
+type mfaService struct {
+	repository repository.UserMfaRepository
+}
+
+func GetMfaService() MfaService {
+	if mfaSrv != nil {
+		return mfaSrv
+	}
+	mfaSrv = &mfaService{repository: repository.GetUserMfaRepository()}
+	return mfaSrv
+}
+
+func (service *mfaService) GetStatus(ctx context.Context, user model.User) model.MfaStatus {
+	status := model.MfaStatus{IsRequired: service.IsRequired(ctx, user)}
+	if mfa, ok := service.find(ctx, user.ID); ok && mfa.IsEnabled {
+		status.IsEnabled, status.EnableDate, status.RecoveryCodesCount = true, mfa.EnableDate, len(mfa.RecoveryCodes)
+	}
+	return status
+}
+
+func (service *mfaService) IsEnabled(ctx context.Context, userId uuid.UUID) bool {
+	mfa, ok := service.find(ctx, userId)
+	return ok && mfa.IsEnabled
+}
+
+// IsRequired tells whether any role of the user obliges to use the second factor
+func (service *mfaService) IsRequired(ctx context.Context, user model.User) bool {
+	for _, role := range user.Roles {
+		if role.RequiresMfa {
+			return true
+		}
+	}
+	return false
+}
+
+// StartEnrolment generates the new secret of the user, it is used once the user confirms it by the first code.
+// The enabled second factor is never replaced, so it can't be taken over by the password only
+func (service *mfaService) StartEnrolment(ctx context.Context, user model.User) model.MfaEnrolment {
+	mfa, ok := service.find(ctx, user.ID)
+	if ok && mfa.IsEnabled {
+		panic(commonError.MfaAlreadyEnabledError)
+	}
+
+	mfa.UserID, mfa.Secret, mfa.LastUsedStep, mfa.RecoveryCodes = user.ID, util.GenerateTotpSecret(), 0, nil
+	if ok {
+		service.repository.Update(ctx, []model.UserMfa{mfa})
+	} else {
+		service.repository.Create(ctx, []model.UserMfa{mfa})
+	}
+	return model.MfaEnrolment{
+		Secret:          mfa.Secret,
+		ProvisioningUri: util.GetTotpProvisioningUri(totpIssuer, user.Username, mfa.Secret),
+	}
+}
+
+// ConfirmEnrolment enables the second factor by the first code of the authenticator and returns the recovery codes
+func (service *mfaService) ConfirmEnrolment(ctx context.Context, userId uuid.UUID, code string) model.RecoveryCodes {
+	mfa, ok := service.find(ctx, userId)
+	if !ok {
+		panic(commonError.MfaNotEnabledError)
+	}
+	if mfa.IsEnabled {
+		panic(commonError.MfaAlreadyEnabledError)
+	}
+	step, valid := util.ValidateTotp(mfa.Secret, code, time.Now(), mfa.LastUsedStep)
+	if !valid {
+		panic(commonError.MfaCodeIsIncorrectError)
+	}
+
+	now := time.Now()
+	codes := util.GenerateRecoveryCodes(model.RecoveryCodesCount)
+	mfa.IsEnabled, mfa.EnableDate, mfa.LastUsedStep = true, &now, step
+	mfa.RecoveryCodes = commonUtil.Map(codes, util.HashRecoveryCode)
+	service.repository.Update(ctx, []model.UserMfa{mfa})
+	log.WithContext(ctx).Infof("MfaService: MFA of user %s is enabled", userId)
+	return model.RecoveryCodes{Codes: codes}
+}
+
+func (service *mfaService) RegenerateRecoveryCodes(ctx context.Context, userId uuid.UUID, code string) model.RecoveryCodes {
+	if !service.Verify(ctx, userId, code) {
+		panic(commonError.MfaCodeIsIncorrectError)
+	}
+	mfa, _ := service.find(ctx, userId)
+	codes := util.GenerateRecoveryCodes(model.RecoveryCodesCount)
+	mfa.RecoveryCodes = commonUtil.Map(codes, util.HashRecoveryCode)
+	service.repository.Update(ctx, []model.UserMfa{mfa})
+	return model.RecoveryCodes{Codes: codes}
+}
+
+// Disable removes the second factor by the code, the users obliged to use MFA by their roles can't disable it
+func (service *mfaService) Disable(ctx context.Context, user model.User, code string) {
+	if service.IsRequired(ctx, user) {
+		panic(commonError.NotEnoughRightsError)
+	}
+	if !service.Verify(ctx, user.ID, code) {
+		panic(commonError.MfaCodeIsIncorrectError)
+	}
+	service.Reset(ctx, user.ID)
+}
+
+// Reset removes the second factor of the user, e.g. when the authenticator and the recovery codes are lost
+func (service *mfaService) Reset(ctx context.Context, userId uuid.UUID) {
+	service.repository.DeleteByUserId(ctx, userId)
+	log.WithContext(ctx).Infof("MfaService: MFA of user %s is removed", userId)
+}
+
+// Verify checks the code of the authenticator or the recovery code, both are accepted once. Concurrent uses
+// of the same code fail on the version of the second factor
+func (service *mfaService) Verify(ctx context.Context, userId uuid.UUID, code string) bool {
+	mfa, ok := service.find(ctx, userId)
+	if !ok || !mfa.IsEnabled {
+		return false
+	}
+
+	if step, valid := util.ValidateTotp(mfa.Secret, code, time.Now(), mfa.LastUsedStep); valid {
+		mfa.LastUsedStep = step
+		service.repository.Update(ctx, []model.UserMfa{mfa})
+		return true
+	}
+
+	hash := util.HashRecoveryCode(code)
+	for i, recoveryCode := range mfa.RecoveryCodes {
+		if recoveryCode == hash {
+			mfa.RecoveryCodes = append(mfa.RecoveryCodes[:i], mfa.RecoveryCodes[i+1:]...)
+			service.repository.Update(ctx, []model.UserMfa{mfa})
+			log.WithContext(ctx).Infof("MfaService: recovery code of user %s is used, %d left", userId, len(mfa.RecoveryCodes))
+			return true
+		}
+	}
+	return false
+}
+
+func (service *mfaService) find(ctx context.Context, userId uuid.UUID) (model.UserMfa, bool) {
+	result := service.repository.FindByUserId(ctx, userId)
+	if len(result) == 0 {
+		return model.UserMfa{}, false
+	}
+	return result[0], true
+}
@@ -0,0 +1,159 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonError "assets/common/custom_error"
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"assets/modules/authorization/repository"
+	"context"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const clientSecretSize = 32
+
+var oauthClientSrv OAuthClientService
+
+type OAuthClientService interface {
+	GetById(ctx context.Context, id uuid.UUID) model.OAuthClient
+	GetAll(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.OAuthClient]
+	Create(ctx context.Context, client model.OAuthClient) model.OAuthClient
+	Update(ctx context.Context, client model.OAuthClient) model.OAuthClient
+	DeleteById(ctx context.Context, id uuid.UUID)
+
+	RegenerateSecret(ctx context.Context, id uuid.UUID) model.OAuthClient
+	Authenticate(ctx context.Context, clientId string, secret string) model.OAuthClient
+}
+
+type oauthClientService struct {
+	repository          repository.OAuthClientRepository
+	consentRepository   repository.OAuthConsentRepository
+	authorityRepository repository.AuthorityRepository
+	userService         UserService
+}
+
+func GetOAuthClientService() OAuthClientService {
+	if oauthClientSrv != nil {
+		return oauthClientSrv
+	}
+	oauthClientSrv = &oauthClientService{
+		repository:          repository.GetOAuthClientRepository(),
+		consentRepository:   repository.GetOAuthConsentRepository(),
+		authorityRepository: repository.GetAuthorityRepository(),
+		userService:         GetUserService(),
+	}
+	return oauthClientSrv
+}
+
+func (service *oauthClientService) GetById(ctx context.Context, id uuid.UUID) model.OAuthClient {
+	result := service.repository.GetById(ctx, []uuid.UUID{id})
+	if len(result) == 0 {
+		panic(commonError.NotFoundError)
+	}
+	return result[0]
+}
+
+func (service *oauthClientService) GetAll(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.OAuthClient] {
+	return service.repository.GetAllWithPage(ctx, page)
+}
+
+// Create registers the client, the secret of the confidential client is returned only once
+func (service *oauthClientService) Create(ctx context.Context, client model.OAuthClient) model.OAuthClient {
+	service.validate(ctx, client)
+
+	client.Secret, client.SecretHash = "", ""
+	secret := ""
+	if !client.IsPublic {
+		secret = commonUtil.RandomString(clientSecretSize)
+		client.SecretHash = string(commonUtil.MustOne(bcrypt.GenerateFromPassword([]byte(secret), bcrypt.DefaultCost)))
+	}
+	client = service.repository.Create(ctx, []model.OAuthClient{client})[0]
+	client.Secret = secret
+	return client
+}
+
+// Update keeps the stored secret, it is changed by RegenerateSecret only
+func (service *oauthClientService) Update(ctx context.Context, client model.OAuthClient) model.OAuthClient {
+	service.validate(ctx, client)
+
+	stored := service.GetById(ctx, client.ID)
+	if client.IsPublic != stored.IsPublic {
+		panic(commonError.IllegalArgumentError)
+	}
+	client.Secret, client.SecretHash = "", stored.SecretHash
+	return service.repository.Update(ctx, []model.OAuthClient{client})[0]
+}
+
+func (service *oauthClientService) DeleteById(ctx context.Context, id uuid.UUID) {
+	service.repository.DeleteById(ctx, id)
+	service.consentRepository.DeleteByClientId(ctx, id)
+}
+
+func (service *oauthClientService) RegenerateSecret(ctx context.Context, id uuid.UUID) model.OAuthClient {
+	client := service.GetById(ctx, id)
+	if client.IsPublic {
+		panic(commonError.IllegalArgumentError)
+	}
+	secret := commonUtil.RandomString(clientSecretSize)
+	client.SecretHash = string(commonUtil.MustOne(bcrypt.GenerateFromPassword([]byte(secret), bcrypt.DefaultCost)))
+	client = service.repository.Update(ctx, []model.OAuthClient{client})[0]
+	client.Secret = secret
+	return client
+}
+
+// Authenticate returns the client by the credentials, public clients are identified by the id only
+func (service *oauthClientService) Authenticate(ctx context.Context, clientId string, secret string) model.OAuthClient {
+	id, err := uuid.Parse(clientId)
+	if err != nil {
+		panic(commonError.InvalidClientError)
+	}
+	result := service.repository.GetById(ctx, []uuid.UUID{id})
+	if len(result) == 0 {
+		panic(commonError.InvalidClientError)
+	}
+
+	client := result[0]
+	if !client.IsPublic && bcrypt.CompareHashAndPassword([]byte(client.SecretHash), []byte(secret)) != nil {
+		panic(commonError.InvalidClientError)
+	}
+	return client
+}
+
+func (service *oauthClientService) validate(ctx context.Context, client model.OAuthClient) {
+	if !client.IsValid() {
+		panic(commonError.IllegalArgumentError)
+	}
+	// the scopes besides the OpenID Connect ones have to name the known authorities
+	authorityScopes := commonUtil.Unique(client.GetAuthorityScopes())
+	if len(authorityScopes) > 0 && len(service.authorityRepository.FindByMethod(ctx, authorityScopes...)) != len(authorityScopes) {
+		panic(commonError.IllegalArgumentError)
+	}
+	if client.ServiceUserID != nil {
+		service.userService.GetById(ctx, *client.ServiceUserID)
+	}
+}
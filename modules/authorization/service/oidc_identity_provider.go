@@ -0,0 +1,334 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/config"
+	"assets/modules/authorization/model"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// oidcKeysReloadInterval limits reloading the keys of the provider on the unknown key ids
+const oidcKeysReloadInterval = time.Minute
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JwksUri               string `json:"jwks_uri"`
+}
+
+type oidcTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	IdToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// oidcIdentityProvider is the generic OpenID Connect relying party, the endpoints and keys of the provider
+// are found by the discovery document of its issuer
+type oidcIdentityProvider struct {
+	name     string
+	property config.IdentityProviderProperty
+	client   *http.Client
+
+	mutex        sync.Mutex
+	discovery    *oidcDiscovery
+	keys         map[string]any
+	keysLoadDate time.Time
+}
+
+func newOidcIdentityProvider(name string, property config.IdentityProviderProperty) IdentityProvider {
+	return &oidcIdentityProvider{
+		name:     name,
+		property: property,
+		client:   &http.Client{Timeout: property.GetTimeout()},
+	}
+}
+
+func (provider *oidcIdentityProvider) GetName() string {
+	return provider.name
+}
+
+func (provider *oidcIdentityProvider) GetAuthorizationUrl(ctx context.Context, redirectUri string, state string, nonce string, codeChallenge string) (string, error) {
+	discovery, err := provider.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", provider.property.ClientId)
+	query.Set("redirect_uri", redirectUri)
+	query.Set("scope", strings.Join(provider.property.GetScopes(), " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", model.S256CodeChallenge)
+
+	separator := "?"
+	if strings.Contains(discovery.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return discovery.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+func (provider *oidcIdentityProvider) Exchange(ctx context.Context, redirectUri string, code string, codeVerifier string, nonce string) (model.ExternalIdentity, error) {
+	discovery, err := provider.getDiscovery(ctx)
+	if err != nil {
+		return model.ExternalIdentity{}, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", model.AuthorizationCodeGrant)
+	form.Set("code", code)
+	form.Set("redirect_uri", redirectUri)
+	form.Set("code_verifier", codeVerifier)
+	form.Set("client_id", provider.property.ClientId)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return model.ExternalIdentity{}, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if provider.property.ClientSecret != "" {
+		request.SetBasicAuth(url.QueryEscape(provider.property.ClientId), url.QueryEscape(provider.property.ClientSecret))
+	}
+
+	var tokenResponse oidcTokenResponse
+	if err = provider.do(request, &tokenResponse); err != nil {
+		return model.ExternalIdentity{}, err
+	}
+	if tokenResponse.Error != "" {
+		return model.ExternalIdentity{}, fmt.Errorf("%s: %s", tokenResponse.Error, tokenResponse.ErrorDescription)
+	}
+
+	claims, err := provider.verifyIdToken(ctx, discovery, tokenResponse.IdToken, nonce)
+	if err != nil {
+		return model.ExternalIdentity{}, err
+	}
+	if discovery.UserinfoEndpoint != "" && tokenResponse.AccessToken != "" {
+		if err = provider.mergeUserInfo(ctx, discovery, tokenResponse.AccessToken, claims); err != nil {
+			return model.ExternalIdentity{}, err
+		}
+	}
+	return provider.newExternalIdentity(claims), nil
+}
+
+// verifyIdToken checks the signature of the ID token by the keys of the provider and that the token
+// is issued by the provider to this client for the login of the nonce
+func (provider *oidcIdentityProvider) verifyIdToken(ctx context.Context, discovery *oidcDiscovery, idToken string, nonce string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (any, error) {
+		switch token.Method.(type) {
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS, *jwt.SigningMethodECDSA, *jwt.SigningMethodEd25519:
+		default:
+			return nil, fmt.Errorf("unexpected signing method %s of ID token", token.Method.Alg())
+		}
+		kid, _ := token.Header["kid"].(string)
+		return provider.getKey(ctx, discovery, kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !claims.VerifyIssuer(discovery.Issuer, true) || !claims.VerifyAudience(provider.property.ClientId, true) {
+		return nil, errors.New("ID token is issued by other issuer or to other client")
+	}
+	if claimNonce, _ := claims["nonce"].(string); claimNonce != nonce {
+		return nil, errors.New("nonce of ID token doesn't match")
+	}
+	if subject, _ := claims["sub"].(string); subject == "" {
+		return nil, errors.New("ID token has no subject")
+	}
+	return claims, nil
+}
+
+// mergeUserInfo adds the claims of the userinfo endpoint missing in the ID token, some providers put
+// only the subject into the ID token
+func (provider *oidcIdentityProvider) mergeUserInfo(ctx context.Context, discovery *oidcDiscovery, accessToken string, claims jwt.MapClaims) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, discovery.UserinfoEndpoint, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+accessToken)
+
+	userInfo := map[string]any{}
+	if err = provider.do(request, &userInfo); err != nil {
+		return err
+	}
+	if userInfo["sub"] != claims["sub"] {
+		return errors.New("subject of userinfo doesn't match ID token")
+	}
+	for key, value := range userInfo {
+		if _, ok := claims[key]; !ok {
+			claims[key] = value
+		}
+	}
+	return nil
+}
+
+func (provider *oidcIdentityProvider) newExternalIdentity(claims jwt.MapClaims) model.ExternalIdentity {
+	getString := func(key string) string {
+		value, _ := claims[key].(string)
+		return value
+	}
+
+	identity := model.ExternalIdentity{
+		Provider:          provider.name,
+		Subject:           getString("sub"),
+		Email:             strings.ToLower(getString("email")),
+		GivenName:         getString("given_name"),
+		FamilyName:        getString("family_name"),
+		PreferredUsername: strings.ToLower(getString("preferred_username")),
+	}
+	switch verified := claims["email_verified"].(type) {
+	case bool:
+		identity.EmailVerified = verified
+	case string:
+		identity.EmailVerified = strings.EqualFold(verified, "true")
+	}
+	if provider.property.RoleClaim != "" {
+		identity.Roles = getClaimValues(claims, provider.property.RoleClaim)
+	}
+	return identity
+}
+
+func (provider *oidcIdentityProvider) getDiscovery(ctx context.Context) (*oidcDiscovery, error) {
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
+	if provider.discovery != nil {
+		return provider.discovery, nil
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, provider.property.GetIssuer()+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	var discovery oidcDiscovery
+	if err = provider.do(request, &discovery); err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != provider.property.GetIssuer() {
+		return nil, fmt.Errorf("discovery document of %s is issued by %s", provider.property.GetIssuer(), discovery.Issuer)
+	}
+	provider.discovery = &discovery
+	return provider.discovery, nil
+}
+
+// getKey returns the key of the provider by the id, the keys are reloaded when the provider rotates them
+func (provider *oidcIdentityProvider) getKey(ctx context.Context, discovery *oidcDiscovery, kid string) (any, error) {
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
+	if key, ok := provider.findKey(kid); ok {
+		return key, nil
+	}
+	if time.Since(provider.keysLoadDate) < oidcKeysReloadInterval {
+		return nil, fmt.Errorf("unknown key %s of ID token", kid)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, discovery.JwksUri, nil)
+	if err != nil {
+		return nil, err
+	}
+	var jwkSet model.JwkSet
+	if err = provider.do(request, &jwkSet); err != nil {
+		return nil, err
+	}
+
+	provider.keys, provider.keysLoadDate = make(map[string]any, len(jwkSet.Keys)), time.Now()
+	for _, jwk := range jwkSet.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		if key, err := jwk.GetPublicKey(); err == nil {
+			provider.keys[jwk.Kid] = key
+		}
+	}
+	if key, ok := provider.findKey(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key %s of ID token", kid)
+}
+
+// findKey returns the key by the id, the only key of the provider is used for the tokens without the id
+func (provider *oidcIdentityProvider) findKey(kid string) (any, bool) {
+	if kid == "" && len(provider.keys) == 1 {
+		for _, key := range provider.keys {
+			return key, true
+		}
+	}
+	key, ok := provider.keys[kid]
+	return key, ok
+}
+
+func (provider *oidcIdentityProvider) do(request *http.Request, result any) error {
+	request.Header.Set("Accept", "application/json")
+	response, err := provider.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	// the token endpoint describes the errors in the body
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusBadRequest {
+		return fmt.Errorf("identity provider %s responded with %d to %s", provider.name, response.StatusCode, request.URL.Path)
+	}
+	return json.NewDecoder(response.Body).Decode(result)
+}
+
+// getClaimValues returns the values of the claim by the path separated by dots, e.g. realm_access.roles
+func getClaimValues(claims map[string]any, path string) []string {
+	var value any = claims
+	for _, key := range strings.Split(path, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+
+	switch values := value.(type) {
+	case string:
+		return strings.Fields(values)
+	case []any:
+		var result []string
+		for _, it := range values {
+			if str, ok := it.(string); ok {
+				result = append(result, str)
+			}
+		}
+		return result
+	}
+	return nil
+}
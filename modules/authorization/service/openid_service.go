@@ -0,0 +1,303 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/config"
+	commonError "assets/common/custom_error"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"assets/modules/authorization/repository"
+	"assets/modules/authorization/util"
+	"context"
+	"github.com/google/uuid"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	authorizationCodeSize     = 32
+	authorizationCodeValidity = time.Minute
+	consentTokenValidity      = 10 * time.Minute
+)
+
+var openIdSrv OpenIdService
+
+// OpenIdService is the OpenID Connect provider of the registered clients
+type OpenIdService interface {
+	// Authorize returns the redirect uri of the client with the issued code or the error, the consent request
+	// is returned instead when the current user hasn't allowed the client the requested scopes yet. The consent
+	// is accepted with the consent token of the consent request only
+	Authorize(ctx context.Context, request model.AuthorizationRequest) (string, *model.ConsentRequest)
+	ExchangeCode(ctx context.Context, client model.OAuthClient, code string, redirectUri string, codeVerifier string) model.AuthorizationResponse
+	ClientCredentials(ctx context.Context, client model.OAuthClient, scope string) model.AuthorizationResponse
+	GetUserInfo(ctx context.Context) model.UserInfo
+	GetConfiguration(ctx context.Context) model.OpenIdConfiguration
+}
+
+type openIdService struct {
+	clientService     OAuthClientService
+	userService       UserService
+	signingKeyService SigningKeyService
+	consentRepository repository.OAuthConsentRepository
+	codeRepository    repository.AuthorizationCodeRepository
+}
+
+func GetOpenIdService() OpenIdService {
+	if openIdSrv != nil {
+		return openIdSrv
+	}
+	openIdSrv = &openIdService{
+		clientService:     GetOAuthClientService(),
+		userService:       GetUserService(),
+		signingKeyService: GetSigningKeyService(),
+		consentRepository: repository.GetOAuthConsentRepository(),
+		codeRepository:    repository.GetAuthorizationCodeRepository(),
+	}
+	return openIdSrv
+}
+
+// Authorize handles the authorization code flow (RFC 6749, RFC 7636). Errors of the unknown client or
+// redirect uri are not redirected, since the redirect uri can't be trusted then
+func (service *openIdService) Authorize(ctx context.Context, request model.AuthorizationRequest) (string, *model.ConsentRequest) {
+	tokenInfo := commonUtil.MustGetCurrentTokenInfo(ctx)
+	if tokenInfo.ClientId != "" {
+		// the tokens of the clients can't authorize other clients
+		panic(commonError.NotEnoughRightsError)
+	}
+	client := service.getClient(ctx, request.ClientID)
+	if request.RedirectUri == "" && len(client.RedirectUris) == 1 {
+		request.RedirectUri = client.RedirectUris[0]
+	}
+	if !client.HasRedirectUri(request.RedirectUri) {
+		panic(commonError.InvalidRedirectUriError)
+	}
+
+	if request.ResponseType != "code" {
+		return redirectUri(request, url.Values{"error": {"unsupported_response_type"}}), nil
+	}
+	if !client.HasGrantType(model.AuthorizationCodeGrant) {
+		return redirectUri(request, url.Values{"error": {"unauthorized_client"}}), nil
+	}
+	scope := client.GetAllowedScope(request.Scope)
+	if scope == "" || len(strings.Fields(scope)) != len(commonUtil.Unique(strings.Fields(request.Scope))) {
+		return redirectUri(request, url.Values{"error": {"invalid_scope"}}), nil
+	}
+	if request.CodeChallenge != "" && request.CodeChallengeMethod == "" {
+		request.CodeChallengeMethod = model.PlainCodeChallenge
+	}
+	if !isValidCodeChallenge(client, request) {
+		return redirectUri(request, url.Values{"error": {"invalid_request"}, "error_description": {"code challenge is required"}}), nil
+	}
+
+	authorizationCode := model.AuthorizationCode{
+		ClientID:            client.ID,
+		UserID:              tokenInfo.UserId,
+		RedirectUri:         request.RedirectUri,
+		Scope:               scope,
+		Nonce:               request.Nonce,
+		CodeChallenge:       request.CodeChallenge,
+		CodeChallengeMethod: request.CodeChallengeMethod,
+		AuthTime:            tokenInfo.IssuedAt,
+	}
+	if request.Consent != "" {
+		service.verifyConsentToken(ctx, request.ConsentToken, authorizationCode)
+	}
+	switch request.Consent {
+	case model.DenyConsent:
+		return redirectUri(request, url.Values{"error": {"access_denied"}}), nil
+	case model.ApproveConsent:
+		service.saveConsent(ctx, tokenInfo.UserId, client.ID, scope)
+	default:
+		consents := service.consentRepository.FindByUserAndClient(ctx, tokenInfo.UserId, client.ID)
+		if len(consents) == 0 || !consents[0].Covers(scope) {
+			consentToken := commonUtil.RandomString(authorizationCodeSize)
+			service.codeRepository.SaveConsentToken(ctx, consentToken, authorizationCode, consentTokenValidity)
+			return "", &model.ConsentRequest{ClientID: client.ID, ClientName: client.Name, Scopes: strings.Fields(scope), ConsentToken: consentToken}
+		}
+	}
+
+	code := commonUtil.RandomString(authorizationCodeSize)
+	service.codeRepository.Save(ctx, code, authorizationCode, authorizationCodeValidity)
+	return redirectUri(request, url.Values{"code": {code}}), nil
+}
+
+// ExchangeCode issues the tokens of the code to the client which requested it, the ID token is issued
+// when the openid scope is allowed. The refresh token is issued to the clients allowed the refresh token grant only
+func (service *openIdService) ExchangeCode(ctx context.Context, client model.OAuthClient, code string, redirectUri string, codeVerifier string) model.AuthorizationResponse {
+	if !client.HasGrantType(model.AuthorizationCodeGrant) {
+		panic(commonError.UnauthorizedClientError)
+	}
+	authorizationCode, ok := service.codeRepository.Consume(ctx, code)
+	if !ok || authorizationCode.ClientID != client.ID || authorizationCode.RedirectUri != redirectUri {
+		panic(commonError.InvalidGrantError)
+	}
+	if !authorizationCode.VerifyCodeVerifier(codeVerifier) {
+		panic(commonError.InvalidGrantError)
+	}
+
+	user := service.getUser(ctx, authorizationCode.UserID)
+	key := service.signingKeyService.GetSigningKey(ctx)
+	response := commonUtil.MustOne(util.NewAuthorizationResponse(user, "", key, util.WithClient(client.ID.String(), authorizationCode.Scope)))
+	if commonUtil.ArrayContains(strings.Fields(authorizationCode.Scope), model.OpenIdScope) {
+		response.IdToken = commonUtil.MustOne(util.GenerateIdToken(user, client.ID.String(), authorizationCode.Scope,
+			authorizationCode.Nonce, authorizationCode.AuthTime, response.SessionId, key))
+	}
+	if !client.HasGrantType(model.RefreshTokenGrant) {
+		response.RefreshToken = ""
+	}
+	return response
+}
+
+// ClientCredentials issues the access token of the service user of the client, no refresh token is issued
+// since the client can always get a new token by its credentials (RFC 6749, section 4.4.3). All scopes
+// of the client are granted when no scope is requested
+func (service *openIdService) ClientCredentials(ctx context.Context, client model.OAuthClient, scope string) model.AuthorizationResponse {
+	if client.IsPublic || !client.HasGrantType(model.ClientCredentialsGrant) || client.ServiceUserID == nil {
+		panic(commonError.UnauthorizedClientError)
+	}
+	if scope == "" {
+		scope = strings.Join(client.Scopes, " ")
+	}
+
+	user := service.getUser(ctx, *client.ServiceUserID)
+	response := commonUtil.MustOne(util.NewAuthorizationResponse(user, "", service.signingKeyService.GetSigningKey(ctx),
+		util.WithClient(client.ID.String(), client.GetAllowedScope(scope))))
+	response.RefreshToken = ""
+	return response
+}
+
+// GetUserInfo returns the claims of the current user, the tokens issued to the clients need the openid scope
+// and get the claims of their scopes only
+func (service *openIdService) GetUserInfo(ctx context.Context) model.UserInfo {
+	tokenInfo := commonUtil.MustGetCurrentTokenInfo(ctx)
+	scopes := model.Scopes
+	if tokenInfo.ClientId != "" {
+		scopes = strings.Fields(tokenInfo.Scope)
+	}
+	if !commonUtil.ArrayContains(scopes, model.OpenIdScope) {
+		panic(commonError.NotEnoughRightsError)
+	}
+
+	user := service.userService.GetById(ctx, tokenInfo.UserId)
+	userInfo := model.UserInfo{Subject: user.ID.String()}
+	if commonUtil.ArrayContains(scopes, model.ProfileScope) {
+		userInfo.Name, userInfo.GivenName, userInfo.FamilyName = user.GetFullName(), user.FirstName, user.LastName
+		userInfo.PreferredUsername = user.Username
+	}
+	if commonUtil.ArrayContains(scopes, model.EmailScope) {
+		userInfo.Email = user.Email
+	}
+	return userInfo
+}
+
+func (service *openIdService) GetConfiguration(ctx context.Context) model.OpenIdConfiguration {
+	conf := config.CoreConfig.AuthorizationServer
+	issuer := conf.GetIssuer()
+	return model.OpenIdConfiguration{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             issuer + "/api/authorization/authorize",
+		TokenEndpoint:                     issuer + "/api/authorization/oauth/token",
+		UserinfoEndpoint:                  issuer + "/api/authorization/userinfo",
+		RevocationEndpoint:                issuer + "/api/authorization/oauth/revoke",
+		EndSessionEndpoint:                issuer + "/api/authorization/logout",
+		JwksUri:                           issuer + "/.well-known/jwks.json",
+		ScopesSupported:                   model.Scopes,
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{model.AuthorizationCodeGrant, model.RefreshTokenGrant, model.ClientCredentialsGrant, model.PasswordGrant},
+		SubjectTypesSupported:             []string{"public"},
+		IdTokenSigningAlgValuesSupported:  []string{conf.EncodingAlg},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{model.S256CodeChallenge, model.PlainCodeChallenge},
+		ClaimsSupported:                   []string{"sub", "iss", "aud", "exp", "iat", "auth_time", "nonce", "sid", "name", "given_name", "family_name", "preferred_username", "email"},
+	}
+}
+
+func (service *openIdService) getClient(ctx context.Context, clientId string) model.OAuthClient {
+	id, err := uuid.Parse(clientId)
+	if err != nil {
+		panic(commonError.InvalidClientError)
+	}
+	return service.clientService.GetById(ctx, id)
+}
+
+// getUser returns the user with the authorities to put into the tokens
+func (service *openIdService) getUser(ctx context.Context, id uuid.UUID) model.User {
+	user := service.userService.FindByUsername(ctx, service.userService.GetById(ctx, id).Username)
+	if commonUtil.IsZeroObject(user.ID) {
+		panic(commonError.NotFoundError)
+	}
+	if user.IsBlocked {
+		panic(commonError.UserBlockedError)
+	}
+	return user
+}
+
+// verifyConsentToken checks the consent is sent for the authorization the consent was requested for
+func (service *openIdService) verifyConsentToken(ctx context.Context, consentToken string, authorizationCode model.AuthorizationCode) {
+	if consentToken == "" {
+		panic(commonError.ConsentTokenInvalidError)
+	}
+	pending, ok := service.codeRepository.ConsumeConsentToken(ctx, consentToken)
+	if !ok || pending.UserID != authorizationCode.UserID || pending.ClientID != authorizationCode.ClientID ||
+		pending.RedirectUri != authorizationCode.RedirectUri || pending.Scope != authorizationCode.Scope {
+		panic(commonError.ConsentTokenInvalidError)
+	}
+}
+
+func (service *openIdService) saveConsent(ctx context.Context, userId uuid.UUID, clientId uuid.UUID, scope string) {
+	consents := service.consentRepository.FindByUserAndClient(ctx, userId, clientId)
+	if len(consents) == 0 {
+		service.consentRepository.Create(ctx, []model.OAuthConsent{{UserID: userId, ClientID: clientId, Scopes: strings.Fields(scope)}})
+		return
+	}
+	consent := consents[0]
+	consent.Scopes = commonUtil.Unique(append(consent.Scopes, strings.Fields(scope)...))
+	service.consentRepository.Update(ctx, []model.OAuthConsent{consent})
+}
+
+// isValidCodeChallenge requires the public clients to use PKCE with the S256 method, the confidential
+// clients may use it optionally
+func isValidCodeChallenge(client model.OAuthClient, request model.AuthorizationRequest) bool {
+	if request.CodeChallenge == "" {
+		return !client.IsPublic
+	}
+	if client.IsPublic {
+		return request.CodeChallengeMethod == model.S256CodeChallenge
+	}
+	return request.CodeChallengeMethod == model.S256CodeChallenge || request.CodeChallengeMethod == model.PlainCodeChallenge
+}
+
+func redirectUri(request model.AuthorizationRequest, params url.Values) string {
+	if request.State != "" {
+		params.Set("state", request.State)
+	}
+	separator := "?"
+	if strings.Contains(request.RedirectUri, "?") {
+		separator = "&"
+	}
+	return request.RedirectUri + separator + params.Encode()
+}
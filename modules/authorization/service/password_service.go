@@ -0,0 +1,174 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonCache "assets/common/cache"
+	"assets/common/config"
+	commonError "assets/common/custom_error"
+	"assets/common/mail"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"assets/modules/authorization/repository"
+	"context"
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"net/url"
+	"strings"
+)
+
+const (
+	passwordResetTokenSize = 32
+	// maxPasswordResetRequests is the number of the reset emails sent to the address within the token validity
+	maxPasswordResetRequests = 3
+)
+
+var passwordSrv PasswordService
+
+type PasswordService interface {
+	GetPolicy(ctx context.Context) model.PasswordPolicy
+	Set(ctx context.Context, user model.User, password string) model.User
+	Change(ctx context.Context, currentPassword string, newPassword string)
+	RequestReset(ctx context.Context, email string)
+	Reset(ctx context.Context, token string, newPassword string)
+}
+
+type passwordService struct {
+	userService     UserService
+	resetRepository repository.PasswordResetRepository
+}
+
+func GetPasswordService() PasswordService {
+	if passwordSrv != nil {
+		return passwordSrv
+	}
+	passwordSrv = &passwordService{
+		userService:     GetUserService(),
+		resetRepository: repository.GetPasswordResetRepository(),
+	}
+	return passwordSrv
+}
+
+func (service *passwordService) GetPolicy(ctx context.Context) model.PasswordPolicy {
+	policy := config.CoreConfig.AuthorizationServer.PasswordPolicy
+	return model.PasswordPolicy{
+		MinLength:        policy.GetMinLength(),
+		MaxLength:        policy.GetMaxLength(),
+		RequireUppercase: policy.RequireUppercase,
+		RequireLowercase: policy.RequireLowercase,
+		RequireDigit:     policy.RequireDigit,
+		RequireSpecial:   policy.RequireSpecial,
+	}
+}
+
+// Set stores the password chosen by the user, it has to meet the policy and differ from the current one
+func (service *passwordService) Set(ctx context.Context, user model.User, password string) model.User {
+	if !config.CoreConfig.AuthorizationServer.PasswordPolicy.IsSatisfied(password, user.Username) ||
+		commonUtil.VerifyPassword(user.Password, password) {
+		panic(commonError.PasswordPolicyViolationError)
+	}
+	return service.userService.SetPassword(ctx, user, password, false)
+}
+
+// Change replaces the password of the current user, all tokens of the user are revoked,
+// so the other devices sign in with the new password
+func (service *passwordService) Change(ctx context.Context, currentPassword string, newPassword string) {
+	user := service.userService.GetCurrent(ctx)
+	if !commonUtil.VerifyPassword(user.Password, currentPassword) {
+		panic(commonError.CurrentPasswordIsIncorrectError)
+	}
+	service.Set(ctx, user, newPassword)
+	commonCache.RevokeUserTokens(ctx, user.ID)
+	log.WithContext(ctx).Infof("PasswordService: Password of %s is changed", user.Username)
+}
+
+// RequestReset sends the link with the single-use token to the email of the user. Nothing tells
+// whether the email belongs to any user, and the emails to the same address are limited
+func (service *passwordService) RequestReset(ctx context.Context, email string) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		panic(commonError.IllegalArgumentError)
+	}
+
+	validity := config.CoreConfig.AuthorizationServer.GetPasswordResetValidity()
+	if commonCache.CountAttempt(ctx, "passwordReset:"+email, validity) > maxPasswordResetRequests {
+		log.WithContext(ctx).Warnf("PasswordService: Too many password reset requests for %s", email)
+		return
+	}
+
+	user := service.userService.FindByEmail(ctx, email)
+	if commonUtil.IsZeroObject(user.ID) || user.IsBlocked || user.IsServiceAccount {
+		log.WithContext(ctx).Infof("PasswordService: No active user with email %s to reset password", email)
+		return
+	}
+
+	token := commonUtil.RandomString(passwordResetTokenSize)
+	service.resetRepository.Save(ctx, token, user.ID, validity)
+
+	// the email is sent in background, so the response time doesn't tell whether the user exists
+	go func() {
+		ctx := context.Background()
+		defer commonUtil.DefaultRecovery(ctx)
+		if err := mail.Send(config.CoreConfig.Notification.Smtp, user.Email, "Password reset", getPasswordResetBody(user, token)); err != nil {
+			log.WithContext(ctx).WithError(err).Errorf("PasswordService: Couldn't send password reset email to %s", user.Username)
+		}
+	}()
+}
+
+// Reset sets the new password by the token of the email, the token is used once. All tokens of the user
+// are revoked, since the password may be reset because it was stolen
+func (service *passwordService) Reset(ctx context.Context, token string, newPassword string) {
+	userId, ok := service.resetRepository.Find(ctx, token)
+	if !ok {
+		panic(commonError.PasswordResetTokenInvalidError)
+	}
+	user := service.userService.GetById(ctx, userId)
+	if user.IsBlocked {
+		panic(commonError.UserBlockedError)
+	}
+	if !config.CoreConfig.AuthorizationServer.PasswordPolicy.IsSatisfied(newPassword, user.Username) {
+		panic(commonError.PasswordPolicyViolationError)
+	}
+
+	if _, ok = service.resetRepository.Consume(ctx, token); !ok {
+		panic(commonError.PasswordResetTokenInvalidError)
+	}
+	service.userService.SetPassword(ctx, user, newPassword, false)
+	commonCache.RevokeUserTokens(ctx, user.ID)
+	log.WithContext(ctx).Infof("PasswordService: Password of %s is reset", user.Username)
+}
+
+func getPasswordResetBody(user model.User, token string) string {
+	link := config.CoreConfig.AuthorizationServer.PasswordResetUrl
+	if resetUrl, err := url.Parse(link); err == nil {
+		query := resetUrl.Query()
+		query.Set("token", token)
+		resetUrl.RawQuery = query.Encode()
+		link = resetUrl.String()
+	}
+	return fmt.Sprintf("Password of %s is requested to reset. Follow the link to choose a new password, it is valid for %s:\n%s\n\n"+
+		"Ignore this email if you didn't request the reset.",
+		user.Username, config.CoreConfig.AuthorizationServer.GetPasswordResetValidity(), link)
+}
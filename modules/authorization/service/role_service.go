@@ -109,8 +109,11 @@ func (service *roleService) init() *roleService {
 func (service *roleService) createIfNotExists(ctx context.Context, role model.Role) model.Role {
 	roleFromDB := service.FindByName(ctx, role.Name)
 	if commonUtil.IsZeroObject(roleFromDB.ID) {
-		return service.Create(ctx, role)
+		roleFromDB = service.Create(ctx, role)
 	}
 
-	return service.repository.Update(ctx, []model.Role{roleFromDB})[0]
+	// authorities added to the predefined role after it was stored are granted once, the changes of the admin are kept
+	service.repository.GrantAuthorities(ctx, roleFromDB, role.Authorities)
+	defer service.cache.Evict(ctx)
+	return service.GetById(ctx, roleFromDB.ID)
 }
@@ -0,0 +1,184 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonCache "assets/common/cache"
+	"assets/common/config"
+	commonError "assets/common/custom_error"
+	commonMiddleware "assets/common/middleware"
+	commonModel "assets/common/model"
+	"assets/common/scheduler"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"assets/modules/authorization/repository"
+	"assets/modules/authorization/util"
+	"context"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+// sessionPurgeBatchSize is the number of the sessions checked for the revocation with one redis lookup
+const sessionPurgeBatchSize = 500
+
+var sessionSrv SessionService
+
+// SessionService tracks the sessions the tokens are issued within, so the users see where they are signed in
+// and sign out there. The sessions revoked or expired in the meantime aren't listed, they are purged on schedule
+type SessionService interface {
+	Track(ctx context.Context, tokenInfo commonModel.TokenInfo, source model.LoginSource)
+	Touch(ctx context.Context, tokenInfo commonModel.TokenInfo)
+	GetSessions(ctx context.Context, userId uuid.UUID) []model.UserSession
+	Revoke(ctx context.Context, userId uuid.UUID, id uuid.UUID)
+	RevokeAll(ctx context.Context, userId uuid.UUID, exceptSessionId string)
+	PurgeSessions(ctx context.Context)
+}
+
+type sessionService struct {
+	repository repository.UserSessionRepository
+}
+
+func GetSessionService() SessionService {
+	if sessionSrv != nil {
+		return sessionSrv
+	}
+	service := &sessionService{
+		repository: repository.GetUserSessionRepository(),
+	}
+	commonMiddleware.SetSessionTracker(service.Touch)
+	scheduler.Schedule("sessions", time.Hour, service.PurgeSessions)
+	sessionSrv = service
+	return sessionSrv
+}
+
+// Track records the session of the issued tokens, it lives as long as the refresh token
+func (service *sessionService) Track(ctx context.Context, tokenInfo commonModel.TokenInfo, source model.LoginSource) {
+	id, err := uuid.Parse(tokenInfo.SessionId)
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	issueDate := tokenInfo.GetIssueTime()
+	expireDate := issueDate.Add(time.Duration(config.CoreConfig.AuthorizationServer.RefreshTokenValiditySeconds) * time.Second)
+	service.repository.Save(ctx, model.UserSession{
+		ID:           id,
+		UserID:       tokenInfo.UserId,
+		ClientID:     tokenInfo.ClientId,
+		Device:       util.GetDevice(source.UserAgent),
+		Ip:           source.Ip,
+		UserAgent:    source.UserAgent,
+		CreateDate:   &now,
+		LastUsedDate: &now,
+		IssueDate:    &issueDate,
+		ExpireDate:   &expireDate,
+	})
+}
+
+// Touch records the use of the session by the request, the date is written at most once a minute
+func (service *sessionService) Touch(ctx context.Context, tokenInfo commonModel.TokenInfo) {
+	id, err := uuid.Parse(tokenInfo.SessionId)
+	if err != nil || !commonCache.Throttle(ctx, "sessions:"+tokenInfo.SessionId, lastUsedPrecision) {
+		return
+	}
+	service.repository.UpdateLastUsedDate(ctx, id, time.Now())
+}
+
+// GetSessions returns the active sessions of the user, the session of the current token is marked
+func (service *sessionService) GetSessions(ctx context.Context, userId uuid.UUID) []model.UserSession {
+	currentSessionId := commonUtil.MustGetCurrentTokenInfo(ctx).SessionId
+
+	var sessions []model.UserSession
+	for _, session := range service.repository.FindByUserId(ctx, userId) {
+		if !session.IsExpired() {
+			sessions = append(sessions, session)
+		}
+	}
+
+	var result []model.UserSession
+	for i, revoked := range commonCache.AreTokensRevoked(ctx, getTokenInfos(sessions)) {
+		if !revoked {
+			sessions[i].IsCurrent = sessions[i].ID.String() == currentSessionId
+			result = append(result, sessions[i])
+		}
+	}
+	return result
+}
+
+// Revoke revokes all tokens of the session of the user
+func (service *sessionService) Revoke(ctx context.Context, userId uuid.UUID, id uuid.UUID) {
+	sessions := service.repository.GetById(ctx, []uuid.UUID{id})
+	if len(sessions) == 0 || sessions[0].UserID != userId {
+		panic(commonError.NotFoundError)
+	}
+	commonCache.RevokeSession(ctx, id.String())
+	service.repository.DeleteById(ctx, id)
+	log.WithContext(ctx).Infof("SessionService: Session %s of user %s is revoked", id, userId)
+}
+
+// RevokeAll revokes the sessions of the user except the one, all tokens of the user are revoked
+// when no session is kept, including the ones issued before the sessions were tracked
+func (service *sessionService) RevokeAll(ctx context.Context, userId uuid.UUID, exceptSessionId string) {
+	for _, session := range service.repository.FindByUserId(ctx, userId) {
+		if session.ID.String() != exceptSessionId {
+			commonCache.RevokeSession(ctx, session.ID.String())
+			service.repository.DeleteById(ctx, session.ID)
+		}
+	}
+	if exceptSessionId == "" {
+		commonCache.RevokeUserTokens(ctx, userId)
+	}
+	log.WithContext(ctx).Infof("SessionService: Sessions of user %s are revoked", userId)
+}
+
+// PurgeSessions deletes the sessions which expired or were revoked along with all tokens of the user
+func (service *sessionService) PurgeSessions(ctx context.Context) {
+	expired := service.repository.DeleteExpiredBefore(ctx, time.Now())
+
+	var revoked int
+	for sessions := service.repository.FindAfter(ctx, uuid.Nil, sessionPurgeBatchSize); len(sessions) != 0; {
+		var ids []uuid.UUID
+		for i, isRevoked := range commonCache.AreTokensRevoked(ctx, getTokenInfos(sessions)) {
+			if isRevoked {
+				ids = append(ids, sessions[i].ID)
+			}
+		}
+		if len(ids) != 0 {
+			service.repository.DeleteById(ctx, ids...)
+			revoked += len(ids)
+		}
+		sessions = service.repository.FindAfter(ctx, sessions[len(sessions)-1].ID, sessionPurgeBatchSize)
+	}
+	log.WithContext(ctx).Infof("SessionService: %d expired and %d revoked sessions purged", expired, revoked)
+}
+
+func getTokenInfos(sessions []model.UserSession) []commonModel.TokenInfo {
+	tokenInfos := make([]commonModel.TokenInfo, len(sessions))
+	for i, session := range sessions {
+		tokenInfos[i] = session.GetTokenInfo()
+	}
+	return tokenInfos
+}
@@ -0,0 +1,150 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonCache "assets/common/cache"
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"assets/modules/authorization/repository"
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"testing"
+	"time"
+)
+
+type fakeSessionRepository struct {
+	repository.UserSessionRepository
+	sessions map[uuid.UUID]model.UserSession
+	deleted  []uuid.UUID
+}
+
+func (repo *fakeSessionRepository) FindByUserId(ctx context.Context, userId uuid.UUID) []model.UserSession {
+	var result []model.UserSession
+	for _, session := range repo.sessions {
+		if session.UserID == userId {
+			result = append(result, session)
+		}
+	}
+	return result
+}
+
+func (repo *fakeSessionRepository) FindAfter(ctx context.Context, afterId uuid.UUID, limit int) []model.UserSession {
+	var result []model.UserSession
+	for _, session := range repo.sessions {
+		if session.ID.String() > afterId.String() {
+			result = append(result, session)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID.String() < result[j].ID.String() })
+	return result[:min(limit, len(result))]
+}
+
+func (repo *fakeSessionRepository) DeleteById(ctx context.Context, ids ...uuid.UUID) {
+	for _, id := range ids {
+		delete(repo.sessions, id)
+	}
+	repo.deleted = append(repo.deleted, ids...)
+}
+
+func (repo *fakeSessionRepository) DeleteExpiredBefore(ctx context.Context, date time.Time) int64 {
+	var count int64
+	for id, session := range repo.sessions {
+		if session.ExpireDate.Before(date) {
+			repo.DeleteById(ctx, id)
+			count++
+		}
+	}
+	return count
+}
+
+// newSessions stores the live, the expired and the revoked sessions of the user and the session
+// of the user which tokens are all revoked
+func newSessions(ctx context.Context, userId uuid.UUID) (*fakeSessionRepository, map[string]uuid.UUID) {
+	issueDate, expireDate, expiredDate := time.Now().Add(-time.Minute), time.Now().Add(time.Hour), time.Now().Add(-time.Second)
+	ids := map[string]uuid.UUID{"current": uuid.New(), "live": uuid.New(), "expired": uuid.New(), "revoked": uuid.New(), "revokedUser": uuid.New()}
+	revokedUserId := uuid.New()
+
+	repo := &fakeSessionRepository{sessions: map[uuid.UUID]model.UserSession{}}
+	for name, id := range ids {
+		session := model.UserSession{ID: id, UserID: userId, IssueDate: &issueDate, ExpireDate: &expireDate}
+		switch name {
+		case "expired":
+			session.ExpireDate = &expiredDate
+		case "revokedUser":
+			session.UserID = revokedUserId
+		}
+		repo.sessions[id] = session
+	}
+	commonCache.RevokeSession(ctx, ids["revoked"].String())
+	commonCache.RevokeUserTokens(ctx, revokedUserId)
+	return repo, ids
+}
+
+func TestGetSessions(t *testing.T) {
+	startRedis(t)
+	userId := uuid.New()
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	repo, ids := newSessions(ctx, userId)
+	commonUtil.SetCurrentTokenInfo(ctx, commonModel.TokenInfo{UserId: userId, SessionId: ids["current"].String()})
+	service := &sessionService{repository: repo}
+
+	sessions := service.GetSessions(ctx, userId)
+	got := make(map[uuid.UUID]bool)
+	for _, session := range sessions {
+		got[session.ID] = session.IsCurrent
+	}
+	want := map[uuid.UUID]bool{ids["current"]: true, ids["live"]: false}
+	if _, ok := got[ids["live"]]; len(got) != len(want) || !ok || !got[ids["current"]] || got[ids["live"]] {
+		t.Errorf("GetSessions() = %v, want %v", got, want)
+	}
+	if len(repo.deleted) != 0 {
+		t.Errorf("GetSessions() deleted %v, want none", repo.deleted)
+	}
+}
+
+func TestPurgeSessions(t *testing.T) {
+	startRedis(t)
+	ctx := context.Background()
+	repo, ids := newSessions(ctx, uuid.New())
+	service := &sessionService{repository: repo}
+
+	service.PurgeSessions(ctx)
+
+	want := []uuid.UUID{ids["expired"], ids["revoked"], ids["revokedUser"]}
+	for _, id := range want {
+		if !slices.Contains(repo.deleted, id) {
+			t.Errorf("PurgeSessions() deleted %v, want %v", repo.deleted, want)
+		}
+	}
+	if len(repo.deleted) != len(want) {
+		t.Errorf("PurgeSessions() deleted %v, want %v", repo.deleted, want)
+	}
+}
@@ -0,0 +1,199 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"assets/common/config"
+	commonError "assets/common/custom_error"
+	"assets/common/scheduler"
+	commonUtil "assets/common/util"
+	"assets/modules/authorization/model"
+	"assets/modules/authorization/repository"
+	"context"
+	"crypto"
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"sync"
+	"time"
+)
+
+const (
+	// keyActivationDelay lets all instances load a new key before it signs tokens
+	keyActivationDelay = 5 * time.Minute
+	keyReloadDelay     = time.Minute
+	// keyRotationLockTtl keeps the other instances from rotating the keys while one instance does it
+	keyRotationLockTtl = time.Minute
+	keyWaitAttempts    = 10
+	keyWaitDelay       = 500 * time.Millisecond
+)
+
+var signingKeySrv SigningKeyService
+
+type SigningKeyService interface {
+	GetSigningKey(ctx context.Context) *model.SigningKey
+	GetJwks(ctx context.Context) model.JwkSet
+	RotateKeys(ctx context.Context)
+}
+
+type verificationKey struct {
+	algorithm string
+	publicKey crypto.PublicKey
+}
+
+type signingKeyService struct {
+	repository       repository.SigningKeyRepository
+	mutex            sync.RWMutex
+	verificationKeys map[string]verificationKey
+	loadDate         time.Time
+}
+
+func GetSigningKeyService() SigningKeyService {
+	if signingKeySrv != nil {
+		return signingKeySrv
+	}
+
+	service := &signingKeyService{repository: repository.GetSigningKeyRepository()}
+	commonUtil.SetJwtKeyResolver(service.resolveKey)
+	if !config.CoreConfig.AuthorizationServer.IsLegacyAlg() {
+		service.RotateKeys(context.Background())
+		scheduler.Schedule("signingKeys", time.Hour, service.RotateKeys)
+	}
+	signingKeySrv = service
+
+	return signingKeySrv
+}
+
+// GetSigningKey returns the key signing the tokens, nil means the legacy shared sign key
+func (service *signingKeyService) GetSigningKey(ctx context.Context) *model.SigningKey {
+	conf := config.CoreConfig.AuthorizationServer
+	if conf.IsLegacyAlg() {
+		return nil
+	}
+
+	// the first key is created by the instance rotating the keys, the others wait for it
+	keys := service.repository.FindActive(ctx, conf.EncodingAlg)
+	for attempt := 0; len(keys) == 0 && attempt < keyWaitAttempts; attempt++ {
+		service.RotateKeys(ctx)
+		if keys = service.repository.FindActive(ctx, conf.EncodingAlg); len(keys) == 0 {
+			time.Sleep(keyWaitDelay)
+		}
+	}
+	if len(keys) == 0 {
+		panic(commonError.CouldNotConnectError)
+	}
+	return getSigningKey(keys)
+}
+
+// GetJwks returns the public keys of all keys which may have signed valid tokens
+func (service *signingKeyService) GetJwks(ctx context.Context) model.JwkSet {
+	result := model.JwkSet{Keys: []model.Jwk{}}
+	if config.CoreConfig.AuthorizationServer.IsLegacyAlg() {
+		return result
+	}
+	for _, key := range service.repository.GetAll(ctx) {
+		jwk, err := model.NewJwk(key)
+		if err != nil {
+			log.WithContext(ctx).WithError(err).Errorf("SigningKeyService: couldn't publish key %s", key.ID)
+			continue
+		}
+		result.Keys = append(result.Keys, jwk)
+	}
+	return result
+}
+
+// RotateKeys creates a new key when the newest one is older than the rotation interval. The keys superseded
+// by the signing key are retired and deleted once the tokens signed by them are expired. The keys are rotated
+// by one instance at a time
+func (service *signingKeyService) RotateKeys(ctx context.Context) {
+	if !scheduler.Lock(ctx, "signingKeyRotation", keyRotationLockTtl) {
+		return
+	}
+
+	conf := config.CoreConfig.AuthorizationServer
+	interval := conf.KeyRotationInterval
+	if interval == 0 {
+		interval = 30 * 24 * time.Hour
+	}
+
+	keys := service.repository.FindActive(ctx, conf.EncodingAlg)
+	if len(keys) == 0 || keys[0].CreateDate.Before(time.Now().Add(-interval)) {
+		keys = append([]model.SigningKey{service.createKey(ctx)}, keys...)
+	}
+	service.repository.RetireCreatedBefore(ctx, *getSigningKey(keys).CreateDate)
+	service.repository.DeleteRetiredBefore(ctx, time.Now().Add(-conf.GetMaxTokenValidity()))
+}
+
+func (service *signingKeyService) createKey(ctx context.Context) model.SigningKey {
+	key := commonUtil.MustOne(model.NewSigningKey(config.CoreConfig.AuthorizationServer.EncodingAlg))
+	key = service.repository.Create(ctx, []model.SigningKey{key})[0]
+	log.WithContext(ctx).Infof("SigningKeyService: signing key %s created", key.ID)
+	return key
+}
+
+// resolveKey returns the public key by the kid, the keys are reloaded when the kid is unknown
+func (service *signingKeyService) resolveKey(kid string) (string, any, error) {
+	service.mutex.RLock()
+	key, ok := service.verificationKeys[kid]
+	reload := !ok && time.Since(service.loadDate) > keyReloadDelay
+	service.mutex.RUnlock()
+
+	if reload {
+		service.loadKeys()
+		service.mutex.RLock()
+		key, ok = service.verificationKeys[kid]
+		service.mutex.RUnlock()
+	}
+	if !ok {
+		return "", nil, fmt.Errorf("unknown signing key %s", kid)
+	}
+	return key.algorithm, key.publicKey, nil
+}
+
+func (service *signingKeyService) loadKeys() {
+	keys := make(map[string]verificationKey)
+	for _, key := range service.repository.GetAll(context.Background()) {
+		signer, err := key.GetSigner()
+		if err != nil {
+			log.WithError(err).Errorf("SigningKeyService: couldn't load key %s", key.ID)
+			continue
+		}
+		keys[key.ID.String()] = verificationKey{algorithm: key.Algorithm, publicKey: signer.Public()}
+	}
+
+	service.mutex.Lock()
+	defer service.mutex.Unlock()
+	service.verificationKeys, service.loadDate = keys, time.Now()
+}
+
+// getSigningKey picks the newest key old enough to be known to all instances, the newest one
+// is used when there is no such key. The keys are ordered from the newest
+func getSigningKey(keys []model.SigningKey) *model.SigningKey {
+	for i := range keys {
+		if keys[i].CreateDate.Before(time.Now().Add(-keyActivationDelay)) {
+			return &keys[i]
+		}
+	}
+	return &keys[0]
+}
@@ -26,13 +26,16 @@ package service
 
 import (
 	commonCache "assets/common/cache"
+	"assets/common/config"
 	commonModel "assets/common/model"
 	commonUtil "assets/common/util"
 	"assets/modules/authorization/model"
 	"assets/modules/authorization/repository"
 	"context"
+	"fmt"
 	"github.com/google/uuid"
-	"golang.org/x/crypto/bcrypt"
+	log "github.com/sirupsen/logrus"
+	"os"
 	"strings"
 	"time"
 )
@@ -55,13 +58,18 @@ type UserService interface {
 	RemoveAuthorities(ctx context.Context, id uuid.UUID, authoritiesIds []uuid.UUID) model.User
 
 	Authorize(ctx context.Context, username string, password string) bool
+	SetPassword(ctx context.Context, user model.User, password string, changeRequired bool) model.User
 }
 
 type userService struct {
-	repository          repository.UserRepository
-	roleRepository      repository.RoleRepository
-	authorityRepository repository.AuthorityRepository
-	cache               *commonCache.Cache[commonModel.Page[model.User]]
+	repository                repository.UserRepository
+	roleRepository            repository.RoleRepository
+	authorityRepository       repository.AuthorityRepository
+	externalAccountRepository repository.ExternalAccountRepository
+	mfaRepository             repository.UserMfaRepository
+	apiTokenRepository        repository.ApiTokenRepository
+	sessionRepository         repository.UserSessionRepository
+	cache                     *commonCache.Cache[commonModel.Page[model.User]]
 }
 
 func GetUserService() UserService {
@@ -70,10 +78,14 @@ func GetUserService() UserService {
 	}
 
 	userSrv = (&userService{
-		repository:          repository.GetUserRepository(),
-		roleRepository:      repository.GetRoleRepository(),
-		authorityRepository: repository.GetAuthorityRepository(),
-		cache:               commonCache.NewCache[commonModel.Page[model.User]]("users", 24*time.Hour),
+		repository:                repository.GetUserRepository(),
+		roleRepository:            repository.GetRoleRepository(),
+		authorityRepository:       repository.GetAuthorityRepository(),
+		externalAccountRepository: repository.GetExternalAccountRepository(),
+		mfaRepository:             repository.GetUserMfaRepository(),
+		apiTokenRepository:        repository.GetApiTokenRepository(),
+		sessionRepository:         repository.GetUserSessionRepository(),
+		cache:                     commonCache.NewCache[commonModel.Page[model.User]]("users", 24*time.Hour),
 	}).init()
 
 	return userSrv
@@ -96,14 +108,30 @@ func (service *userService) Create(ctx context.Context, user model.User) model.U
 	return service.repository.Create(ctx, []model.User{user})[0]
 }
 
+// Update keeps the stored password and its change date, the password is never sent to clients, so an updated
+// user comes without it. Tokens issued to the user are revoked once the user is blocked
 func (service *userService) Update(ctx context.Context, user model.User) model.User {
+	var wasBlocked bool
+	if stored := service.repository.GetById(ctx, []uuid.UUID{user.ID}); len(stored) != 0 {
+		user.Password, user.PasswordChangeDate, wasBlocked = stored[0].Password, stored[0].PasswordChangeDate, stored[0].IsBlocked
+	}
 	defer service.cache.Evict(ctx)
-	return service.repository.Update(ctx, []model.User{user})[0]
+	user = service.repository.Update(ctx, []model.User{user})[0]
+	if user.IsBlocked && !wasBlocked {
+		commonCache.RevokeUserTokens(ctx, user.ID)
+	}
+	return user
 }
 
+// DeleteById revokes the tokens issued to the user before the user is deleted, so they don't outlive the user
 func (service *userService) DeleteById(ctx context.Context, id uuid.UUID) {
 	defer service.cache.Evict(ctx)
+	commonCache.RevokeUserTokens(ctx, id)
 	service.repository.DeleteById(ctx, id)
+	service.externalAccountRepository.DeleteByUserId(ctx, id)
+	service.mfaRepository.DeleteByUserId(ctx, id)
+	service.apiTokenRepository.DeleteByUserId(ctx, id)
+	service.sessionRepository.DeleteByUserId(ctx, id)
 }
 
 func (service *userService) FindByUsername(ctx context.Context, username string) model.User {
@@ -149,24 +177,59 @@ func (service *userService) RemoveAuthorities(ctx context.Context, id uuid.UUID,
 	return service.repository.RemoveAuthorities(ctx, id, service.authorityRepository.GetById(ctx, authoritiesIds))
 }
 
+// Authorize checks the password of the user, the password hashed by the weaker algorithm
+// or parameters is rehashed at once
 func (service *userService) Authorize(ctx context.Context, username string, password string) bool {
 	users := service.repository.FindByUsername(ctx, []string{username})
-	if len(users) == 0 {
+	if len(users) == 0 || !commonUtil.VerifyPassword(users[0].Password, password) {
 		return false
 	}
-	return bcrypt.CompareHashAndPassword([]byte(users[0].Password), []byte(password)) == nil
+
+	if user := users[0]; commonUtil.NeedsRehash(user.Password) {
+		if hash, err := commonUtil.HashPassword(password); err == nil {
+			user.Password = hash
+			service.repository.UpdatePassword(ctx, user)
+		} else {
+			log.WithContext(ctx).WithError(err).Errorf("UserService: Couldn't rehash password of %s", username)
+		}
+	}
+	return true
 }
 
+// SetPassword stores the password without checking the policy, the user has to change it
+// on the next login when the change is required
+func (service *userService) SetPassword(ctx context.Context, user model.User, password string, changeRequired bool) model.User {
+	model.UserWithPassword(password)(&user)
+	user.PasswordChangeRequired = changeRequired
+	defer service.cache.Evict(ctx)
+	service.repository.UpdatePassword(ctx, user)
+	return user
+}
+
+// init seeds the administrator with the configured password or with the random one printed once to stderr,
+// so it doesn't get to the collected logs. The password has to be changed on the first login, the administrator
+// seeded before is made to change it once as well
 func (service *userService) init() *userService {
 	ctx := context.Background()
+	if service.repository.RequirePasswordChangeOnce(ctx, "assets") {
+		service.cache.Evict(ctx)
+	}
+
 	assetss := service.repository.FindByUsername(ctx, []string{"assets"})
 	if len(assetss) == 0 {
+		password := config.CoreConfig.AuthorizationServer.AdminPassword
+		if password == "" {
+			password = commonUtil.RandomString(18)
+			fmt.Fprintf(os.Stderr, "Administrator assets is created with the password %s, change it on the first login\n", password)
+			log.WithContext(ctx).Warn("UserService: Administrator assets is created with the random password printed to stderr")
+		}
 		assets := service.Create(ctx, model.NewUser(
 			"assets",
 			"assets",
 			"",
 			"admin@deadline.team",
-			model.UserWithPassword("Deadline@777"),
+			model.UserWithPassword(password),
+			model.UserWithPasswordChangeRequired(),
 		))
 		service.AddRoles(ctx, assets.ID, []uuid.UUID{model.AdminRole.ID})
 	}
@@ -31,25 +31,51 @@ import (
 	"assets/modules/authorization/model"
 	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
+	"strings"
 	"time"
 )
 
-func NewAuthorizationResponse(user model.User) (model.AuthorizationResponse, error) {
+// mfaTokenValiditySeconds is the time the user has to enter the second factor after the password
+const mfaTokenValiditySeconds = 300
+
+// TokenOption sets the additional claims of the issued tokens
+type TokenOption func(*commonModel.TokenInfo)
+
+// WithClient restricts the tokens to the OAuth client and the scopes it is granted. The tokens carry only
+// the authorities of the user named by the scopes and no roles, since the roles would grant all their authorities
+func WithClient(clientId string, scope string) TokenOption {
+	return func(tokenInfo *commonModel.TokenInfo) {
+		scopes := strings.Fields(scope)
+		tokenInfo.ClientId = clientId
+		tokenInfo.Scope = scope
+		tokenInfo.Roles = nil
+		tokenInfo.Authorities = commonUtil.ArrayFilter(tokenInfo.Authorities, func(authority string) bool {
+			return commonUtil.ArrayContains(scopes, authority)
+		})
+	}
+}
+
+// NewAuthorizationResponse issues the access and refresh tokens of the session signed by the key, a new session
+// is started when the session id is empty. The tokens are signed by the shared sign key when the key is nil
+func NewAuthorizationResponse(user model.User, sessionId string, key *model.SigningKey, opts ...TokenOption) (model.AuthorizationResponse, error) {
 	conf := commonConfig.CoreConfig.AuthorizationServer
+	if sessionId == "" {
+		sessionId = uuid.New().String()
+	}
 
-	accessToken, id, err := GenerateJwtToken(user, conf.AccessTokenValiditySeconds, "")
+	accessToken, id, err := GenerateJwtToken(user, conf.AccessTokenValiditySeconds, commonModel.AccessTokenType, sessionId, "", key, opts...)
 	if err != nil {
 		return model.NilAuthorizationResponse, err
 	}
-	refreshToken, _, err := GenerateJwtToken(user, conf.RefreshTokenValiditySeconds, id)
+	refreshToken, _, err := GenerateJwtToken(user, conf.RefreshTokenValiditySeconds, commonModel.RefreshTokenType, sessionId, id, key, opts...)
 	if err != nil {
 		return model.NilAuthorizationResponse, err
 	}
-	accessTokenString, err := accessToken.SignedString([]byte(conf.SignKey))
+	accessTokenString, err := signJwtToken(accessToken, key)
 	if err != nil {
 		return model.NilAuthorizationResponse, err
 	}
-	refreshTokenString, err := refreshToken.SignedString([]byte(conf.SignKey))
+	refreshTokenString, err := signJwtToken(refreshToken, key)
 	if err != nil {
 		return model.NilAuthorizationResponse, err
 	}
@@ -63,23 +89,25 @@ func NewAuthorizationResponse(user model.User) (model.AuthorizationResponse, err
 	return response, nil
 }
 
-func GenerateJwtToken(user model.User, expiredAfterSecond int, parentId string) (*jwt.Token, string, error) {
+// NewMfaToken issues the token of the login waiting for the second factor, it carries no authorities
+func NewMfaToken(user model.User, key *model.SigningKey) (string, error) {
+	token, _, err := GenerateJwtToken(model.User{ID: user.ID, Username: user.Username}, mfaTokenValiditySeconds, commonModel.MfaTokenType, "", "", key)
+	if err != nil {
+		return "", err
+	}
+	return signJwtToken(token, key)
+}
+
+func GenerateJwtToken(user model.User, expiredAfterSecond int, tokenType string, sessionId string, parentId string, key *model.SigningKey, opts ...TokenOption) (*jwt.Token, string, error) {
 	conf := commonConfig.CoreConfig.AuthorizationServer
 
 	id := uuid.New().String()
-	issuedAt := time.Now().Unix()
+	now := time.Now()
+	issuedAt := now.Unix()
 	expiresAt := issuedAt + int64(expiredAfterSecond)
 
 	roles := commonUtil.Map(user.Roles, func(role *model.Role) string { return role.Name })
-
-	var authorities []string
-	for _, role := range user.Roles {
-		authoritiesFromRole := commonUtil.Map(role.Authorities, func(authority *model.Authority) string { return authority.Method })
-		authorities = append(authorities, authoritiesFromRole...)
-	}
-	authoritiesFromUser := commonUtil.Map(user.AdditionalAuthorities, func(authority *model.Authority) string { return authority.Method })
-	authorities = append(authorities, authoritiesFromUser...)
-	authorities = commonUtil.Unique(authorities)
+	authorities := GetUserAuthorities(user)
 
 	claims := commonModel.TokenInfo{
 		UserId:      user.ID,
@@ -90,13 +118,82 @@ func GenerateJwtToken(user model.User, expiredAfterSecond int, parentId string)
 		Roles:       roles,
 		Authorities: authorities,
 		ParentId:    parentId,
+		TokenType:   tokenType,
+		SessionId:   sessionId,
+		IssuedAtMs:  now.UnixMilli(),
 		StandardClaims: jwt.StandardClaims{
 			ExpiresAt: expiresAt,
 			Id:        id,
 			IssuedAt:  issuedAt,
-			Issuer:    "DeadlineTeam",
+			Issuer:    conf.GetIssuer(),
 		},
 	}
+	for _, opt := range opts {
+		opt(&claims)
+	}
+
+	return newJwtToken(claims, key), id, nil
+}
 
-	return jwt.NewWithClaims(jwt.GetSigningMethod(conf.EncodingAlg), claims), id, nil
+// GetUserAuthorities returns the authorities of the roles of the user and its additional authorities
+func GetUserAuthorities(user model.User) []string {
+	var authorities []string
+	for _, role := range user.Roles {
+		authoritiesFromRole := commonUtil.Map(role.Authorities, func(authority *model.Authority) string { return authority.Method })
+		authorities = append(authorities, authoritiesFromRole...)
+	}
+	authoritiesFromUser := commonUtil.Map(user.AdditionalAuthorities, func(authority *model.Authority) string { return authority.Method })
+	authorities = append(authorities, authoritiesFromUser...)
+	return commonUtil.Unique(authorities)
+}
+
+// GenerateIdToken issues the OpenID Connect ID token of the user to the client, the profile and email claims
+// are set when the scope allows them
+func GenerateIdToken(user model.User, clientId string, scope string, nonce string, authTime int64, sessionId string, key *model.SigningKey) (string, error) {
+	conf := commonConfig.CoreConfig.AuthorizationServer
+	issuedAt := time.Now().Unix()
+
+	claims := model.IdTokenClaims{
+		Nonce:     nonce,
+		AuthTime:  authTime,
+		SessionId: sessionId,
+		StandardClaims: jwt.StandardClaims{
+			Audience:  clientId,
+			ExpiresAt: issuedAt + int64(conf.AccessTokenValiditySeconds),
+			Id:        uuid.New().String(),
+			IssuedAt:  issuedAt,
+			Issuer:    conf.GetIssuer(),
+			Subject:   user.ID.String(),
+		},
+	}
+	scopes := strings.Fields(scope)
+	if commonUtil.ArrayContains(scopes, model.ProfileScope) {
+		claims.Name, claims.GivenName, claims.FamilyName = user.GetFullName(), user.FirstName, user.LastName
+		claims.PreferredUsername = user.Username
+	}
+	if commonUtil.ArrayContains(scopes, model.EmailScope) {
+		claims.Email = user.Email
+	}
+
+	return signJwtToken(newJwtToken(claims, key), key)
+}
+
+func newJwtToken(claims jwt.Claims, key *model.SigningKey) *jwt.Token {
+	if key == nil {
+		return jwt.NewWithClaims(jwt.GetSigningMethod(commonConfig.CoreConfig.AuthorizationServer.EncodingAlg), claims)
+	}
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), claims)
+	token.Header["kid"] = key.ID.String()
+	return token
+}
+
+func signJwtToken(token *jwt.Token, key *model.SigningKey) (string, error) {
+	if key == nil {
+		return token.SignedString([]byte(commonConfig.CoreConfig.AuthorizationServer.SignKey))
+	}
+	signer, err := key.GetSigner()
+	if err != nil {
+		return "", err
+	}
+	return token.SignedString(signer)
 }
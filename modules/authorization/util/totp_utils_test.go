@@ -0,0 +1,123 @@
+package util
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA1 secret of the test vectors of RFC 6238, appendix B
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestGenerateTotp(t *testing.T) {
+	key, err := totpEncoding.DecodeString(rfcSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, test := range tests {
+		if got := generateTotp(key, test.unix/totpPeriod); got != test.want {
+			t.Errorf("generateTotp(%d) = %s, want %s", test.unix, got, test.want)
+		}
+	}
+}
+
+func TestValidateTotp(t *testing.T) {
+	key, err := totpEncoding.DecodeString(rfcSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	date := time.Unix(1111111111, 0)
+	step := date.Unix() / totpPeriod
+	codeOf := func(step int64) string { return generateTotp(key, step) }
+
+	tests := []struct {
+		name         string
+		secret       string
+		code         string
+		lastUsedStep int64
+		wantStep     int64
+		wantOk       bool
+	}{
+		{"current step", rfcSecret, codeOf(step), 0, step, true},
+		{"lower case secret", "gezdgnbvgy3tqojqgezdgnbvgy3tqojq", codeOf(step), 0, step, true},
+		{"previous step within skew", rfcSecret, codeOf(step - 1), 0, step - 1, true},
+		{"next step within skew", rfcSecret, codeOf(step + 1), 0, step + 1, true},
+		{"step beyond skew", rfcSecret, codeOf(step - 2), 0, 0, false},
+		{"replayed step", rfcSecret, codeOf(step), step, 0, false},
+		{"step before last used one", rfcSecret, codeOf(step - 1), step - 1, 0, false},
+		{"step after last used one", rfcSecret, codeOf(step), step - 1, step, true},
+		{"wrong code", rfcSecret, "000000", 0, 0, false},
+		{"short code", rfcSecret, codeOf(step)[:5], 0, 0, false},
+		{"invalid secret", "not base32!", codeOf(step), 0, 0, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gotStep, gotOk := ValidateTotp(test.secret, test.code, date, test.lastUsedStep)
+			if gotStep != test.wantStep || gotOk != test.wantOk {
+				t.Errorf("ValidateTotp() = (%d, %v), want (%d, %v)", gotStep, gotOk, test.wantStep, test.wantOk)
+			}
+		})
+	}
+}
+
+func TestHashRecoveryCode(t *testing.T) {
+	want := HashRecoveryCode("k3x9p-2mf7q")
+	for _, code := range []string{"k3x9p-2mf7q", "K3X9P-2MF7Q", "k3x9p2mf7q", " k3x9p 2mf7q"} {
+		if got := HashRecoveryCode(code); got != want {
+			t.Errorf("HashRecoveryCode(%q) differs from the hash of the normalized code", code)
+		}
+	}
+	if HashRecoveryCode("k3x9p-2mf7r") == want {
+		t.Error("HashRecoveryCode() of different codes is the same")
+	}
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes := GenerateRecoveryCodes(10)
+	if len(codes) != 10 {
+		t.Fatalf("GenerateRecoveryCodes(10) returned %d codes", len(codes))
+	}
+	seen := map[string]bool{}
+	for _, code := range codes {
+		if len(code) != 11 || code[5] != '-' {
+			t.Errorf("recovery code %q isn't of the xxxxx-xxxxx form", code)
+		}
+		if seen[code] {
+			t.Errorf("recovery code %q is repeated", code)
+		}
+		seen[code] = true
+	}
+}
@@ -77,13 +77,23 @@ func (controller *cityController) RegisterHttpController(router *gin.Engine) {
 	cityRouter.PUT(
 		"/:id",
 		commonMiddleware.HasAnyAuthorities("UPDATE_CITY"),
-		commonResolver.Resolver[model.City],
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.UpdateResolver[model.City],
+		controller.update,
+	)
+
+	cityRouter.PATCH(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("UPDATE_CITY"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.PatchResolver(controller.service.GetById),
 		controller.update,
 	)
 
 	cityRouter.DELETE(
 		"/:id",
 		commonMiddleware.HasAnyAuthorities("DELETE_CITY"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
 		controller.deleteById,
 	)
 }
@@ -103,7 +113,7 @@ func (controller *cityController) RegisterHttpController(router *gin.Engine) {
 func (controller *cityController) getById(ctx *gin.Context) {
 	id := uuid.MustParse(ctx.Param("id"))
 	log.WithContext(ctx).Infof("CityController: GetById(id: %s): Start", id)
-	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetById(ctx, id))
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.GetById(ctx, id))
 	log.WithContext(ctx).Info("CityController: GetById(): End")
 }
 
@@ -140,7 +150,7 @@ func (controller *cityController) getAll(ctx *gin.Context) {
 func (controller *cityController) create(ctx *gin.Context) {
 	log.WithContext(ctx).Info("CityController: Create(): Start")
 	city := ctx.MustGet("RequestBody").(model.City)
-	ctx.AbortWithStatusJSON(http.StatusCreated, controller.service.Create(ctx, city))
+	commonUtil.AbortWithEntity(ctx, http.StatusCreated, controller.service.Create(ctx, city))
 	log.WithContext(ctx).Info("CityController: Create(): End")
 }
 
@@ -156,10 +166,11 @@ func (controller *cityController) create(ctx *gin.Context) {
 // @Failure      400
 // @Failure      500
 // @Router       /api/city/cities/{id} [PUT]
+// @Router       /api/city/cities/{id} [PATCH]
 func (controller *cityController) update(ctx *gin.Context) {
 	log.WithContext(ctx).Info("CityController: Update(): Start")
 	city := ctx.MustGet("RequestBody").(model.City)
-	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.Update(ctx, city))
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Update(ctx, city))
 	log.WithContext(ctx).Info("CityController: Update(): End")
 }
 
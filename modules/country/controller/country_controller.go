@@ -77,13 +77,23 @@ func (controller *countryController) RegisterHttpController(router *gin.Engine)
 	countryRouter.PUT(
 		"/:id",
 		commonMiddleware.HasAnyAuthorities("UPDATE_COUNTRY"),
-		commonResolver.Resolver[model.Country],
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.UpdateResolver[model.Country],
+		controller.update,
+	)
+
+	countryRouter.PATCH(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("UPDATE_COUNTRY"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.PatchResolver(controller.service.GetById),
 		controller.update,
 	)
 
 	countryRouter.DELETE(
 		"/:id",
 		commonMiddleware.HasAnyAuthorities("DELETE_COUNTRY"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
 		controller.deleteById,
 	)
 }
@@ -103,7 +113,7 @@ func (controller *countryController) RegisterHttpController(router *gin.Engine)
 func (controller *countryController) getById(ctx *gin.Context) {
 	id := uuid.MustParse(ctx.Param("id"))
 	log.WithContext(ctx).Infof("CountryController: GetById(id: %s): Start", id)
-	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetById(ctx, id))
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.GetById(ctx, id))
 	log.WithContext(ctx).Info("CountryController: GetById(): End")
 }
 
@@ -140,7 +150,7 @@ func (controller *countryController) getAll(ctx *gin.Context) {
 func (controller *countryController) create(ctx *gin.Context) {
 	log.WithContext(ctx).Info("CountryController: Create(): Start")
 	country := ctx.MustGet("RequestBody").(model.Country)
-	ctx.AbortWithStatusJSON(http.StatusCreated, controller.service.Create(ctx, country))
+	commonUtil.AbortWithEntity(ctx, http.StatusCreated, controller.service.Create(ctx, country))
 	log.WithContext(ctx).Info("CountryController: Create(): End")
 }
 
@@ -156,10 +166,11 @@ func (controller *countryController) create(ctx *gin.Context) {
 // @Failure      400
 // @Failure      500
 // @Router       /api/country/countries/{id} [PUT]
+// @Router       /api/country/countries/{id} [PATCH]
 func (controller *countryController) update(ctx *gin.Context) {
 	log.WithContext(ctx).Info("CountryController: Update(): Start")
 	country := ctx.MustGet("RequestBody").(model.Country)
-	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.Update(ctx, country))
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Update(ctx, country))
 	log.WithContext(ctx).Info("CountryController: Update(): End")
 }
 
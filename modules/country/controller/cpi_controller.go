@@ -0,0 +1,133 @@
+package controller
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonController "assets/common/controller"
+	commonMiddleware "assets/common/middleware"
+	commonUtil "assets/common/util"
+	"assets/modules/country/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+var cpiCntr commonController.HttpController
+
+type cpiController struct {
+	service service.CpiService
+}
+
+func GetCpiController() commonController.HttpController {
+	if cpiCntr != nil {
+		return cpiCntr
+	}
+	cpiCntr = &cpiController{service: service.GetCpiService()}
+	return cpiCntr
+}
+
+func (controller *cpiController) RegisterHttpController(router *gin.Engine) {
+	cpiRouter := router.Group("/api/country/countries/:id/cpi", commonMiddleware.SecurityHandler)
+
+	cpiRouter.GET(
+		"",
+		commonMiddleware.HasAnyAuthorities("READ_COUNTRY"),
+		controller.getByCountryId,
+	)
+
+	cpiRouter.POST(
+		"",
+		commonMiddleware.HasAnyAuthorities("UPDATE_COUNTRY"),
+		controller.importSeries,
+	)
+
+	cpiRouter.DELETE(
+		"",
+		commonMiddleware.HasAnyAuthorities("UPDATE_COUNTRY"),
+		controller.deleteByCountryId,
+	)
+}
+
+// cpiController godoc
+// @Security BearerAuth
+// @Summary      getByCountryId
+// @Description  Get consumer price index series of the country ordered by date
+// @Tags         Cpi controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Country.ID"
+// @Success      200	{array}  model.CpiIndex
+// @Failure      400
+// @Failure      500
+// @Router       /api/country/countries/{id}/cpi [GET]
+func (controller *cpiController) getByCountryId(ctx *gin.Context) {
+	countryId := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("CpiController: GetByCountryId(countryId: %s): Start", countryId)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetByCountryId(ctx, countryId))
+	log.WithContext(ctx).Info("CpiController: GetByCountryId(): End")
+}
+
+// cpiController godoc
+// @Security BearerAuth
+// @Summary      importSeries
+// @Description  Import CSV consumer price index series of the country, values at the existing dates are replaced
+// @Tags         Cpi controller
+// @Accept       mpfd
+// @Produce      json
+// @Param        id		path     string  true  "Country.ID"
+// @Param        kind	query	string  false  "INDEX for index values (default), CHANGE for percents to the previous period"
+// @Param        file	formData	file  true  "CSV series with date and value columns"
+// @Success      201	{array}  model.CpiIndex
+// @Failure      400
+// @Failure      500
+// @Router       /api/country/countries/{id}/cpi [POST]
+func (controller *cpiController) importSeries(ctx *gin.Context) {
+	countryId := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("CpiController: Import(countryId: %s): Start", countryId)
+	fileHeader := commonUtil.MustOne(ctx.FormFile("file"))
+	ctx.AbortWithStatusJSON(http.StatusCreated, controller.service.Import(ctx, countryId, ctx.Query("kind"), fileHeader))
+	log.WithContext(ctx).Info("CpiController: Import(): End")
+}
+
+// cpiController godoc
+// @Security BearerAuth
+// @Summary      deleteByCountryId
+// @Description  Delete consumer price index series of the country
+// @Tags         Cpi controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Country.ID"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/country/countries/{id}/cpi [DELETE]
+func (controller *cpiController) deleteByCountryId(ctx *gin.Context) {
+	countryId := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("CpiController: DeleteByCountryId(countryId: %s): Start", countryId)
+	controller.service.DeleteByCountryId(ctx, countryId)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("CpiController: DeleteByCountryId(): End")
+}
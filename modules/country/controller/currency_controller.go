@@ -77,13 +77,23 @@ func (controller *currencyController) RegisterHttpController(router *gin.Engine)
 	currencyRouter.PUT(
 		"/:id",
 		commonMiddleware.HasAnyAuthorities("UPDATE_CURRENCY"),
-		commonResolver.Resolver[model.Currency],
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.UpdateResolver[model.Currency],
+		controller.update,
+	)
+
+	currencyRouter.PATCH(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("UPDATE_CURRENCY"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.PatchResolver(controller.service.GetById),
 		controller.update,
 	)
 
 	currencyRouter.DELETE(
 		"/:id",
 		commonMiddleware.HasAnyAuthorities("DELETE_CURRENCY"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
 		controller.deleteById,
 	)
 }
@@ -103,7 +113,7 @@ func (controller *currencyController) RegisterHttpController(router *gin.Engine)
 func (controller *currencyController) getById(ctx *gin.Context) {
 	id := uuid.MustParse(ctx.Param("id"))
 	log.WithContext(ctx).Infof("CurrencyController: GetById(id: %s): Start", id)
-	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetById(ctx, id))
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.GetById(ctx, id))
 	log.WithContext(ctx).Info("CurrencyController: GetById(): End")
 }
 
@@ -140,7 +150,7 @@ func (controller *currencyController) getAll(ctx *gin.Context) {
 func (controller *currencyController) create(ctx *gin.Context) {
 	log.WithContext(ctx).Info("CurrencyController: Create(): Start")
 	currency := ctx.MustGet("RequestBody").(model.Currency)
-	ctx.AbortWithStatusJSON(http.StatusCreated, controller.service.Create(ctx, currency))
+	commonUtil.AbortWithEntity(ctx, http.StatusCreated, controller.service.Create(ctx, currency))
 	log.WithContext(ctx).Info("CurrencyController: Create(): End")
 }
 
@@ -156,10 +166,11 @@ func (controller *currencyController) create(ctx *gin.Context) {
 // @Failure      400
 // @Failure      500
 // @Router       /api/currency/currencies/{id} [PUT]
+// @Router       /api/currency/currencies/{id} [PATCH]
 func (controller *currencyController) update(ctx *gin.Context) {
 	log.WithContext(ctx).Info("CurrencyController: Update(): Start")
 	currency := ctx.MustGet("RequestBody").(model.Currency)
-	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.Update(ctx, currency))
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Update(ctx, currency))
 	log.WithContext(ctx).Info("CurrencyController: Update(): End")
 }
 
@@ -1,6 +1,7 @@
 package model
 
 import (
+	commonModel "assets/common/model"
 	commonUtil "assets/common/util"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -36,6 +37,7 @@ type City struct {
 	ID        uuid.UUID `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
 	CountryId uuid.UUID `json:"countryId,omitempty"`
 	Name      string    `json:"name,omitempty"`
+	commonModel.Versioned
 }
 
 func (city City) GetID() uuid.UUID {
@@ -46,6 +46,8 @@ func easyjson66d84ff1DecodeAssetsModulesCountryModel(in *jlexer.Lexer, out *City
 			}
 		case "name":
 			out.Name = string(in.String())
+		case "version":
+			out.Version = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -86,6 +88,16 @@ func easyjson66d84ff1EncodeAssetsModulesCountryModel(out *jwriter.Writer, in Cit
 		}
 		out.String(string(in.Name))
 	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
 	out.RawByte('}')
 }
 
@@ -1,6 +1,7 @@
 package model
 
 import (
+	commonModel "assets/common/model"
 	commonUtil "assets/common/util"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -44,6 +45,7 @@ type Country struct {
 	Pictogram []byte    `json:"pictogram,omitempty"`
 	Cities    []*City   `json:"cities,omitempty"`
 	Currency  *Currency `json:"currency,omitempty"`
+	commonModel.Versioned
 }
 
 func (country Country) GetID() uuid.UUID {
@@ -92,6 +92,18 @@ func easyjson548aff28DecodeAssetsModulesCountryModel(in *jlexer.Lexer, out *Coun
 				}
 				in.Delim(']')
 			}
+		case "currency":
+			if in.IsNull() {
+				in.Skip()
+				out.Currency = nil
+			} else {
+				if out.Currency == nil {
+					out.Currency = new(Currency)
+				}
+				(*out.Currency).UnmarshalEasyJSON(in)
+			}
+		case "version":
+			out.Version = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -215,6 +227,26 @@ func easyjson548aff28EncodeAssetsModulesCountryModel(out *jwriter.Writer, in Cou
 			out.RawByte(']')
 		}
 	}
+	if in.Currency != nil {
+		const prefix string = ",\"currency\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Currency).MarshalEasyJSON(out)
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
 	out.RawByte('}')
 }
 
@@ -0,0 +1,91 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"sort"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const (
+	CpiKindIndex  = "INDEX"
+	CpiKindChange = "CHANGE"
+)
+
+var CpiKinds = []string{CpiKindIndex, CpiKindChange}
+
+// CpiIndex is a value of the consumer price index of the country at the date
+type CpiIndex struct {
+	ID        uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	CountryID uuid.UUID  `json:"countryId,omitempty" gorm:"type:uuid;uniqueIndex:idx_cpi_index_country_date"`
+	Date      *time.Time `json:"date,omitempty" gorm:"uniqueIndex:idx_cpi_index_country_date"`
+	Value     float64    `json:"value"`
+	commonModel.Versioned
+}
+
+func (index CpiIndex) GetID() uuid.UUID {
+	return index.ID
+}
+
+func (index *CpiIndex) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(index.ID) {
+		index.ID = uuid.New()
+	}
+	return nil
+}
+
+func NewCpiIndex(countryId uuid.UUID, date time.Time, value float64) CpiIndex {
+	return CpiIndex{
+		CountryID: countryId,
+		Date:      &date,
+		Value:     value,
+	}
+}
+
+// CpiSeries is the consumer price index series of the country ordered by date
+type CpiSeries []CpiIndex
+
+func (series CpiSeries) IsEmpty() bool {
+	return len(series) == 0
+}
+
+// ValueAt returns the latest index value known at the date. Dates before the series get its first value,
+// so amounts outside the series are not adjusted beyond the known data
+func (series CpiSeries) ValueAt(date time.Time) float64 {
+	i := sort.Search(len(series), func(i int) bool { return series[i].Date.After(date) })
+	if i == 0 {
+		return series[0].Value
+	}
+	return series[i-1].Value
+}
+
+// Deflate converts the amount of the date into the money of the base date
+func (series CpiSeries) Deflate(amount float64, date time.Time, baseDate time.Time) float64 {
+	return amount * series.ValueAt(baseDate) / series.ValueAt(date)
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	commonModel "assets/common/model"
 	commonUtil "assets/common/util"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -38,6 +39,7 @@ type Currency struct {
 	AlphabeticCode string    `json:"alphabeticCode,omitempty"`
 	NumericCode    string    `json:"numericCode,omitempty"`
 	Symbol         string    `json:"symbol,omitempty"`
+	commonModel.Versioned
 }
 
 func (currency Currency) GetID() uuid.UUID {
@@ -50,6 +50,8 @@ func easyjsonE5a98965DecodeAssetsModulesCountryModel(in *jlexer.Lexer, out *Curr
 			out.NumericCode = string(in.String())
 		case "symbol":
 			out.Symbol = string(in.String())
+		case "version":
+			out.Version = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -110,6 +112,16 @@ func easyjsonE5a98965EncodeAssetsModulesCountryModel(out *jwriter.Writer, in Cur
 		}
 		out.String(string(in.Symbol))
 	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
 	out.RawByte('}')
 }
 
@@ -0,0 +1,81 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonDB "assets/common/db"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/country/model"
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+)
+
+var cpiIndexRepo CpiIndexRepository
+
+type CpiIndexRepository interface {
+	commonRepository.Repository[model.CpiIndex]
+	FindByCountryId(ctx context.Context, countryId uuid.UUID) model.CpiSeries
+	Upsert(ctx context.Context, indexes []model.CpiIndex)
+	DeleteByCountryId(ctx context.Context, countryId uuid.UUID)
+}
+
+type cpiIndexRepository struct {
+	commonRepository.Repository[model.CpiIndex]
+	*commonDB.DataSource
+}
+
+func GetCpiIndexRepository() CpiIndexRepository {
+	if cpiIndexRepo != nil {
+		return cpiIndexRepo
+	}
+	cpiIndexRepo = &cpiIndexRepository{
+		commonRepository.NewBaseRepository[model.CpiIndex](commonDB.GetDataSource()),
+		commonDB.GetDataSource(),
+	}
+	return cpiIndexRepo
+}
+
+func (repo *cpiIndexRepository) FindByCountryId(ctx context.Context, countryId uuid.UUID) model.CpiSeries {
+	var result []model.CpiIndex
+	commonUtil.Must(repo.DataSource.Where("country_id = ?", countryId).Order("date").Find(&result).Error)
+	return result
+}
+
+// Upsert creates the indexes and replaces values of the existing ones at the same dates
+func (repo *cpiIndexRepository) Upsert(ctx context.Context, indexes []model.CpiIndex) {
+	if len(indexes) == 0 {
+		return
+	}
+	commonUtil.Must(repo.DataSource.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "country_id"}, {Name: "date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"value"}),
+	}).Create(&indexes).Error)
+}
+
+func (repo *cpiIndexRepository) DeleteByCountryId(ctx context.Context, countryId uuid.UUID) {
+	commonUtil.Must(repo.DataSource.Where("country_id = ?", countryId).Delete(&model.CpiIndex{}).Error)
+}
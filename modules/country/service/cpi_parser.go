@@ -0,0 +1,153 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type cpiRecord struct {
+	date  time.Time
+	value float64
+}
+
+var cpiColumns = map[string][]string{
+	"date":  {"date", "period", "month", "дата", "период", "месяц"},
+	"value": {"value", "index", "cpi", "change", "значение", "индекс", "ипц"},
+}
+
+var cpiDateLayouts = []string{time.DateOnly, "2006-01", "01.2006", "02.01.2006", "2006/01", "01/2006"}
+
+// parseCpi reads a CSV series of the date and value columns. The header row is optional,
+// without it the first column is the date and the second one is the value
+func parseCpi(data []byte) ([]cpiRecord, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = detectCpiDelimiter(data)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("series is empty")
+	}
+
+	dateColumn, valueColumn, start := 0, 1, 0
+	if _, err := parseCpiDate(records[0][0]); err != nil {
+		columns := mapCpiColumns(records[0])
+		var hasDate, hasValue bool
+		if dateColumn, hasDate = columns["date"]; !hasDate {
+			return nil, errors.New("date column not found")
+		}
+		if valueColumn, hasValue = columns["value"]; !hasValue {
+			return nil, errors.New("value column not found")
+		}
+		start = 1
+	}
+
+	var result []cpiRecord
+	for i, record := range records[start:] {
+		if strings.Join(record, "") == "" {
+			continue
+		}
+		if dateColumn >= len(record) || valueColumn >= len(record) {
+			return nil, fmt.Errorf("line %d: not enough columns", i+start+1)
+		}
+
+		date, err := parseCpiDate(record[dateColumn])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+start+1, err)
+		}
+		value, err := parseCpiValue(record[valueColumn])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+start+1, err)
+		}
+		result = append(result, cpiRecord{date: date, value: value})
+	}
+	if len(result) == 0 {
+		return nil, errors.New("series is empty")
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].date.Before(result[j].date) })
+	return result, nil
+}
+
+func detectCpiDelimiter(data []byte) rune {
+	header, _, _ := bytes.Cut(data, []byte("\n"))
+	delimiter, maxCount := ',', 0
+	for _, candidate := range []rune{',', ';', '\t'} {
+		if count := bytes.Count(header, []byte(string(candidate))); count > maxCount {
+			delimiter, maxCount = candidate, count
+		}
+	}
+	return delimiter
+}
+
+func mapCpiColumns(header []string) map[string]int {
+	result := make(map[string]int)
+	for index, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		for column, aliases := range cpiColumns {
+			if _, ok := result[column]; ok {
+				continue
+			}
+			for _, alias := range aliases {
+				if name == alias {
+					result[column] = index
+				}
+			}
+		}
+	}
+	return result
+}
+
+func parseCpiDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range cpiDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("couldn't parse date %q", value)
+}
+
+func parseCpiValue(value string) (float64, error) {
+	value = strings.ReplaceAll(strings.NewReplacer(" ", "", " ", "").Replace(value), ",", ".")
+	result, err := strconv.ParseFloat(value, 64)
+	if err != nil || result <= 0 {
+		return 0, fmt.Errorf("couldn't parse value %q", value)
+	}
+	return result, nil
+}
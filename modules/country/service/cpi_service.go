@@ -0,0 +1,116 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonError "assets/common/custom_error"
+	commonUtil "assets/common/util"
+	"assets/modules/country/model"
+	"assets/modules/country/repository"
+	"context"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"mime/multipart"
+	"strings"
+)
+
+var cpiSrv CpiService
+
+type CpiService interface {
+	GetByCountryId(ctx context.Context, countryId uuid.UUID) model.CpiSeries
+	Import(ctx context.Context, countryId uuid.UUID, kind string, fileHeader *multipart.FileHeader) model.CpiSeries
+	DeleteByCountryId(ctx context.Context, countryId uuid.UUID)
+}
+
+type cpiService struct {
+	repository        repository.CpiIndexRepository
+	countryRepository repository.CountryRepository
+}
+
+func GetCpiService() CpiService {
+	if cpiSrv != nil {
+		return cpiSrv
+	}
+
+	cpiSrv = &cpiService{
+		repository:        repository.GetCpiIndexRepository(),
+		countryRepository: repository.GetCountryRepository(),
+	}
+
+	return cpiSrv
+}
+
+func (service *cpiService) GetByCountryId(ctx context.Context, countryId uuid.UUID) model.CpiSeries {
+	service.checkCountryExists(ctx, countryId)
+	return service.repository.FindByCountryId(ctx, countryId)
+}
+
+// Import stores the series from the CSV file replacing values at the same dates. The CHANGE kind
+// holds percents to the previous period (e.g. 100.74), they are chained into index values
+// starting from the stored index before the first imported date
+func (service *cpiService) Import(ctx context.Context, countryId uuid.UUID, kind string, fileHeader *multipart.FileHeader) model.CpiSeries {
+	kind = strings.ToUpper(kind)
+	if kind == "" {
+		kind = model.CpiKindIndex
+	}
+	if !commonUtil.ArrayContains(model.CpiKinds, kind) {
+		panic(commonError.IllegalArgumentError)
+	}
+	service.checkCountryExists(ctx, countryId)
+
+	records, err := parseCpi(commonUtil.GetData(fileHeader))
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Warnf("CpiService: couldn't parse cpi series %s", fileHeader.Filename)
+		panic(commonError.InvalidCpiFileError)
+	}
+
+	if kind == model.CpiKindChange {
+		value := 100.0
+		if series := service.repository.FindByCountryId(ctx, countryId); !series.IsEmpty() && series[0].Date.Before(records[0].date) {
+			value = series.ValueAt(records[0].date.AddDate(0, 0, -1))
+		}
+		for i := range records {
+			value *= records[i].value / 100
+			records[i].value = value
+		}
+	}
+
+	service.repository.Upsert(ctx, commonUtil.Map(records, func(record cpiRecord) model.CpiIndex {
+		return model.NewCpiIndex(countryId, record.date, record.value)
+	}))
+	log.WithContext(ctx).Infof("CpiService: %d cpi values imported", len(records))
+	return service.repository.FindByCountryId(ctx, countryId)
+}
+
+func (service *cpiService) DeleteByCountryId(ctx context.Context, countryId uuid.UUID) {
+	service.checkCountryExists(ctx, countryId)
+	service.repository.DeleteByCountryId(ctx, countryId)
+}
+
+func (service *cpiService) checkCountryExists(ctx context.Context, countryId uuid.UUID) {
+	if len(service.countryRepository.GetById(ctx, []uuid.UUID{countryId})) == 0 {
+		panic(commonError.NotFoundError)
+	}
+}
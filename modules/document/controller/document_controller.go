@@ -0,0 +1,379 @@
+package controller
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonController "assets/common/controller"
+	commonMiddleware "assets/common/middleware"
+	commonResolver "assets/common/resolver"
+	commonUtil "assets/common/util"
+	"assets/modules/document/model"
+	"assets/modules/document/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+var documentCntr commonController.HttpController
+
+type documentController struct {
+	service service.DocumentService
+}
+
+func GetDocumentController() commonController.HttpController {
+	if documentCntr != nil {
+		return documentCntr
+	}
+	documentCntr = &documentController{service: service.GetDocumentService()}
+	return documentCntr
+}
+
+func (controller *documentController) RegisterHttpController(router *gin.Engine) {
+	documentRouter := router.Group("/api/document/documents", commonMiddleware.SecurityHandler)
+
+	documentRouter.GET(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("READ_DOCUMENT"),
+		controller.getById,
+	)
+
+	documentRouter.GET(
+		"",
+		commonMiddleware.HasAnyAuthorities("READ_DOCUMENT"),
+		commonMiddleware.PaginationHandler,
+		commonMiddleware.FilterHandler[model.DocumentFilter],
+		controller.getAll,
+	)
+
+	documentRouter.POST(
+		"",
+		commonMiddleware.HasAnyAuthorities("EDIT_DOCUMENT"),
+		commonResolver.Resolver[model.Document],
+		controller.create,
+	)
+
+	documentRouter.POST(
+		"/upload",
+		commonMiddleware.HasAnyAuthorities("EDIT_DOCUMENT"),
+		controller.upload,
+	)
+
+	documentRouter.PUT(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_DOCUMENT"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.UpdateResolver[model.Document],
+		controller.update,
+	)
+
+	documentRouter.PATCH(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_DOCUMENT"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.PatchResolver(controller.service.GetById),
+		controller.update,
+	)
+
+	documentRouter.DELETE(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_DOCUMENT"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		controller.deleteById,
+	)
+
+	documentRouter.GET(
+		"/trash",
+		commonMiddleware.HasAnyAuthorities("READ_DOCUMENT"),
+		commonMiddleware.PaginationHandler,
+		controller.getTrash,
+	)
+
+	documentRouter.POST(
+		"/:id/restore",
+		commonMiddleware.HasAnyAuthorities("EDIT_DOCUMENT"),
+		controller.restore,
+	)
+
+	documentRouter.GET(
+		"/:id/download",
+		commonMiddleware.HasAnyAuthorities("READ_DOCUMENT"),
+		controller.download,
+	)
+
+	assetRouter := router.Group("/api/document/assets", commonMiddleware.SecurityHandler)
+
+	assetRouter.GET(
+		"/:assetId",
+		commonMiddleware.HasAnyAuthorities("READ_DOCUMENT"),
+		controller.getByAssetId,
+	)
+
+	assetRouter.GET(
+		"/:assetId/download",
+		commonMiddleware.HasAnyAuthorities("READ_DOCUMENT"),
+		controller.downloadArchive,
+	)
+}
+
+// documentController godoc
+// @Security BearerAuth
+// @Summary      getById
+// @Description  Get document by id
+// @Tags         Document controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Document.ID"
+// @Success      200	{object}  model.Document
+// @Failure      400
+// @Failure      500
+// @Router       /api/document/documents/{id} [GET]
+func (controller *documentController) getById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("DocumentController: GetById(id: %s): Start", id)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.GetById(ctx, id))
+	log.WithContext(ctx).Info("DocumentController: GetById(): End")
+}
+
+// documentController godoc
+// @Security BearerAuth
+// @Summary      getAll
+// @Description  Get all documents of available assets
+// @Tags         Document controller
+// @Accept       json
+// @Produce      json
+// @Param        assetId	query	string  false  "Document.AssetID"
+// @Param        cashFlowId	query	string  false  "Document.CashFlowID"
+// @Param        type		query	string  false  "Document.Type"
+// @Success      200	{array}  model.Document
+// @Failure      400
+// @Failure      500
+// @Router       /api/document/documents/ [GET]
+func (controller *documentController) getAll(ctx *gin.Context) {
+	page := commonUtil.MustGetPageable(ctx)
+	filter := commonUtil.MustGetFilterObject[model.DocumentFilter](ctx)
+	log.WithContext(ctx).Info("DocumentController: GetAll(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetAll(ctx, filter, page))
+	log.WithContext(ctx).Info("DocumentController: GetAll(): End")
+}
+
+// documentController godoc
+// @Security BearerAuth
+// @Summary      create
+// @Description  File uploaded attachment as document of asset or its cash flow
+// @Tags         Document controller
+// @Accept       json
+// @Produce      json
+// @Param        document	body	  model.Document  true  "Create Document"
+// @Success      201	{object}  model.Document
+// @Failure      400
+// @Failure      500
+// @Router       /api/document/documents/ [POST]
+func (controller *documentController) create(ctx *gin.Context) {
+	log.WithContext(ctx).Info("DocumentController: Create(): Start")
+	document := ctx.MustGet("RequestBody").(model.Document)
+	commonUtil.AbortWithEntity(ctx, http.StatusCreated, controller.service.Create(ctx, document))
+	log.WithContext(ctx).Info("DocumentController: Create(): End")
+}
+
+// documentController godoc
+// @Security BearerAuth
+// @Summary      upload
+// @Description  Upload file as document of asset or its cash flow
+// @Tags         Document controller
+// @Accept       mpfd
+// @Produce      json
+// @Param        assetId		query	string  false  "Document.AssetID, required without cashFlowId"
+// @Param        cashFlowId		query	string  false  "Document.CashFlowID"
+// @Param        type			query	string  true   "Document.Type"
+// @Param        title			query	string  false  "Document.Title, file name by default"
+// @Param        number			query	string  false  "Document.Number"
+// @Param        issueDate		query	string  false  "Document.IssueDate (2006-01-02 or RFC3339)"
+// @Param        expiryDate		query	string  false  "Document.ExpiryDate (2006-01-02 or RFC3339)"
+// @Param        description	query	string  false  "Document.Description"
+// @Param        file			formData	file  true  "Document file"
+// @Success      201	{object}  model.Document
+// @Failure      400
+// @Failure      500
+// @Router       /api/document/documents/upload [POST]
+func (controller *documentController) upload(ctx *gin.Context) {
+	log.WithContext(ctx).Info("DocumentController: Upload(): Start")
+	document := model.Document{
+		Type:        ctx.Query("type"),
+		Title:       ctx.Query("title"),
+		Number:      ctx.Query("number"),
+		IssueDate:   commonUtil.GetTimeQuery(ctx, "issueDate"),
+		ExpiryDate:  commonUtil.GetTimeQuery(ctx, "expiryDate"),
+		Description: ctx.Query("description"),
+	}
+	if assetId, ok := ctx.GetQuery("assetId"); ok {
+		document.AssetID = uuid.MustParse(assetId)
+	}
+	if cashFlowId, ok := ctx.GetQuery("cashFlowId"); ok {
+		id := uuid.MustParse(cashFlowId)
+		document.CashFlowID = &id
+	}
+	fileHeader := commonUtil.MustOne(ctx.FormFile("file"))
+	ctx.AbortWithStatusJSON(http.StatusCreated, controller.service.Upload(ctx, document, fileHeader))
+	log.WithContext(ctx).Info("DocumentController: Upload(): End")
+}
+
+// documentController godoc
+// @Security BearerAuth
+// @Summary      update
+// @Description  Update document
+// @Tags         Document controller
+// @Accept       json
+// @Produce      json
+// @Param        document	body	  model.Document  true  "Update Document"
+// @Success      200	{object}  model.Document
+// @Failure      400
+// @Failure      500
+// @Router       /api/document/documents/{id} [PUT]
+// @Router       /api/document/documents/{id} [PATCH]
+func (controller *documentController) update(ctx *gin.Context) {
+	log.WithContext(ctx).Info("DocumentController: Update(): Start")
+	document := ctx.MustGet("RequestBody").(model.Document)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Update(ctx, document))
+	log.WithContext(ctx).Info("DocumentController: Update(): End")
+}
+
+// documentController godoc
+// @Security BearerAuth
+// @Summary      deleteById
+// @Description  Move document to the trash
+// @Tags         Document controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Document.ID"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/document/documents/{id} [DELETE]
+func (controller *documentController) deleteById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("DocumentController: DeleteById(id: %s): Start", id)
+	controller.service.DeleteById(ctx, id)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("DocumentController: DeleteById(): End")
+}
+
+// documentController godoc
+// @Security BearerAuth
+// @Summary      getTrash
+// @Description  Get documents in the trash available to current user
+// @Tags         Document controller
+// @Accept       json
+// @Produce      json
+// @Success      200	{array}  model.Document
+// @Failure      400
+// @Failure      500
+// @Router       /api/document/documents/trash [GET]
+func (controller *documentController) getTrash(ctx *gin.Context) {
+	page := commonUtil.MustGetPageable(ctx)
+	log.WithContext(ctx).Info("DocumentController: GetTrash(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetTrash(ctx, page))
+	log.WithContext(ctx).Info("DocumentController: GetTrash(): End")
+}
+
+// documentController godoc
+// @Security BearerAuth
+// @Summary      restore
+// @Description  Restore document from the trash
+// @Tags         Document controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Document.ID"
+// @Success      200	{object}  model.Document
+// @Failure      400
+// @Failure      500
+// @Router       /api/document/documents/{id}/restore [POST]
+func (controller *documentController) restore(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("DocumentController: Restore(id: %s): Start", id)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Restore(ctx, id))
+	log.WithContext(ctx).Info("DocumentController: Restore(): End")
+}
+
+// documentController godoc
+// @Security BearerAuth
+// @Summary      download
+// @Description  Download file of document
+// @Tags         Document controller
+// @Produce      octet-stream
+// @Param        id		path     string  true  "Document.ID"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/document/documents/{id}/download [GET]
+func (controller *documentController) download(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("DocumentController: Download(id: %s): Start", id)
+	data, contentType, fileName := controller.service.Download(ctx, id)
+	ctx.Header("content-disposition", `attachment; filename=`+fileName)
+	ctx.Data(http.StatusOK, contentType, data)
+	ctx.Abort()
+	log.WithContext(ctx).Info("DocumentController: Download(): End")
+}
+
+// documentController godoc
+// @Security BearerAuth
+// @Summary      getByAssetId
+// @Description  Get all documents of asset including documents of its cash flows
+// @Tags         Document controller
+// @Accept       json
+// @Produce      json
+// @Param        assetId	path     string  true  "Asset.ID"
+// @Success      200	{array}  model.Document
+// @Failure      400
+// @Failure      500
+// @Router       /api/document/assets/{assetId} [GET]
+func (controller *documentController) getByAssetId(ctx *gin.Context) {
+	assetId := uuid.MustParse(ctx.Param("assetId"))
+	log.WithContext(ctx).Infof("DocumentController: GetByAssetId(assetId: %s): Start", assetId)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetByAssetId(ctx, assetId))
+	log.WithContext(ctx).Info("DocumentController: GetByAssetId(): End")
+}
+
+// documentController godoc
+// @Security BearerAuth
+// @Summary      downloadArchive
+// @Description  Download zip archive of all documents of asset
+// @Tags         Document controller
+// @Produce      application/zip
+// @Param        assetId	path     string  true  "Asset.ID"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/document/assets/{assetId}/download [GET]
+func (controller *documentController) downloadArchive(ctx *gin.Context) {
+	assetId := uuid.MustParse(ctx.Param("assetId"))
+	log.WithContext(ctx).Infof("DocumentController: DownloadArchive(assetId: %s): Start", assetId)
+	data, fileName := controller.service.DownloadArchive(ctx, assetId)
+	ctx.Header("content-disposition", `attachment; filename=`+fileName)
+	ctx.Data(http.StatusOK, "application/zip", data)
+	ctx.Abort()
+	log.WithContext(ctx).Info("DocumentController: DownloadArchive(): End")
+}
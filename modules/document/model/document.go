@@ -0,0 +1,127 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	attachmentModel "assets/modules/attachment/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const (
+	DeedDocument        = "DEED"
+	ContractDocument    = "CONTRACT"
+	ReceiptDocument     = "RECEIPT"
+	InvoiceDocument     = "INVOICE"
+	InsuranceDocument   = "INSURANCE"
+	CertificateDocument = "CERTIFICATE"
+	OtherDocument       = "OTHER"
+)
+
+var DocumentTypes = []string{
+	DeedDocument, ContractDocument, ReceiptDocument, InvoiceDocument, InsuranceDocument, CertificateDocument, OtherDocument,
+}
+
+// Document is an attachment filed to the asset, documents of the asset cash flows refer to the cash flow as well
+type Document struct {
+	ID           uuid.UUID                   `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	AssetID      uuid.UUID                   `json:"assetId,omitempty" gorm:"type:uuid;index"`
+	CashFlowID   *uuid.UUID                  `json:"cashFlowId,omitempty" gorm:"type:uuid;index"`
+	AttachmentID uuid.UUID                   `json:"attachmentId,omitempty" gorm:"type:uuid;index"`
+	Attachment   *attachmentModel.Attachment `json:"attachment,omitempty"`
+	Type         string                      `json:"type,omitempty"`
+	Title        string                      `json:"title,omitempty"`
+	Number       string                      `json:"number,omitempty"`
+	IssueDate    *time.Time                  `json:"issueDate,omitempty"`
+	ExpiryDate   *time.Time                  `json:"expiryDate,omitempty" gorm:"index"`
+	Description  string                      `json:"description,omitempty"`
+	CreateDate   *time.Time                  `json:"createDate,omitempty"`
+	CreatedBy    uuid.UUID                   `json:"createdBy,omitempty" gorm:"type:uuid"`
+	commonModel.Versioned
+	commonModel.SoftDeletable
+}
+
+func (document Document) GetID() uuid.UUID {
+	return document.ID
+}
+
+func (document *Document) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(document.ID) {
+		document.ID = uuid.New()
+	}
+	if document.CreateDate == nil {
+		now := time.Now()
+		document.CreateDate = &now
+	}
+	return nil
+}
+
+func (document Document) IsValid() bool {
+	if document.IssueDate != nil && document.ExpiryDate != nil && document.ExpiryDate.Before(*document.IssueDate) {
+		return false
+	}
+	return commonUtil.ArrayContains(DocumentTypes, document.Type) && document.AttachmentID != uuid.Nil
+}
+
+func (document Document) IsExpiredAt(date time.Time) bool {
+	return document.ExpiryDate != nil && document.ExpiryDate.Before(date)
+}
+
+type DocumentFilter struct {
+	AssetID    string   `form:"assetId"`
+	CashFlowID string   `form:"cashFlowId"`
+	Types      []string `form:"type"`
+}
+
+func NewDocument(assetId uuid.UUID, attachmentId uuid.UUID, documentType string, opts ...DocumentOption) Document {
+	document := Document{
+		AssetID:      assetId,
+		AttachmentID: attachmentId,
+		Type:         documentType,
+	}
+
+	for _, opt := range opts {
+		opt(&document)
+	}
+
+	return document
+}
+
+type DocumentOption func(*Document)
+
+func DocumentWithCashFlow(cashFlowId uuid.UUID) DocumentOption {
+	return func(document *Document) {
+		document.CashFlowID = &cashFlowId
+	}
+}
+
+func DocumentWithExpiryDate(expiryDate time.Time) DocumentOption {
+	return func(document *Document) {
+		document.ExpiryDate = &expiryDate
+	}
+}
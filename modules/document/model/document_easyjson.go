@@ -0,0 +1,449 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	model "assets/modules/attachment/model"
+	json "encoding/json"
+	uuid "github.com/google/uuid"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson18605acbDecodeAssetsModulesDocumentModel(in *jlexer.Lexer, out *DocumentFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "AssetID":
+			out.AssetID = string(in.String())
+		case "CashFlowID":
+			out.CashFlowID = string(in.String())
+		case "Types":
+			if in.IsNull() {
+				in.Skip()
+				out.Types = nil
+			} else {
+				in.Delim('[')
+				if out.Types == nil {
+					if !in.IsDelim(']') {
+						out.Types = make([]string, 0, 4)
+					} else {
+						out.Types = []string{}
+					}
+				} else {
+					out.Types = (out.Types)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Types = append(out.Types, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson18605acbEncodeAssetsModulesDocumentModel(out *jwriter.Writer, in DocumentFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"AssetID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AssetID))
+	}
+	{
+		const prefix string = ",\"CashFlowID\":"
+		out.RawString(prefix)
+		out.String(string(in.CashFlowID))
+	}
+	{
+		const prefix string = ",\"Types\":"
+		out.RawString(prefix)
+		if in.Types == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Types {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DocumentFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson18605acbEncodeAssetsModulesDocumentModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DocumentFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson18605acbEncodeAssetsModulesDocumentModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DocumentFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson18605acbDecodeAssetsModulesDocumentModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DocumentFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson18605acbDecodeAssetsModulesDocumentModel(l, v)
+}
+func easyjson18605acbDecodeAssetsModulesDocumentModel1(in *jlexer.Lexer, out *Document) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "assetId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AssetID).UnmarshalText(data))
+			}
+		case "cashFlowId":
+			if in.IsNull() {
+				in.Skip()
+				out.CashFlowID = nil
+			} else {
+				if out.CashFlowID == nil {
+					out.CashFlowID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.CashFlowID).UnmarshalText(data))
+				}
+			}
+		case "attachmentId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AttachmentID).UnmarshalText(data))
+			}
+		case "attachment":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachment = nil
+			} else {
+				if out.Attachment == nil {
+					out.Attachment = new(model.Attachment)
+				}
+				(*out.Attachment).UnmarshalEasyJSON(in)
+			}
+		case "type":
+			out.Type = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "number":
+			out.Number = string(in.String())
+		case "issueDate":
+			if in.IsNull() {
+				in.Skip()
+				out.IssueDate = nil
+			} else {
+				if out.IssueDate == nil {
+					out.IssueDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.IssueDate).UnmarshalJSON(data))
+				}
+			}
+		case "expiryDate":
+			if in.IsNull() {
+				in.Skip()
+				out.ExpiryDate = nil
+			} else {
+				if out.ExpiryDate == nil {
+					out.ExpiryDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ExpiryDate).UnmarshalJSON(data))
+				}
+			}
+		case "description":
+			out.Description = string(in.String())
+		case "createDate":
+			if in.IsNull() {
+				in.Skip()
+				out.CreateDate = nil
+			} else {
+				if out.CreateDate == nil {
+					out.CreateDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreateDate).UnmarshalJSON(data))
+				}
+			}
+		case "createdBy":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.CreatedBy).UnmarshalText(data))
+			}
+		case "deletedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DeletedAt).UnmarshalJSON(data))
+			}
+		case "deletedBy":
+			if in.IsNull() {
+				in.Skip()
+				out.DeletedBy = nil
+			} else {
+				if out.DeletedBy == nil {
+					out.DeletedBy = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.DeletedBy).UnmarshalText(data))
+				}
+			}
+		case "version":
+			out.Version = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson18605acbEncodeAssetsModulesDocumentModel1(out *jwriter.Writer, in Document) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"assetId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.AssetID).MarshalText())
+	}
+	if in.CashFlowID != nil {
+		const prefix string = ",\"cashFlowId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((*in.CashFlowID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"attachmentId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.AttachmentID).MarshalText())
+	}
+	if in.Attachment != nil {
+		const prefix string = ",\"attachment\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Attachment).MarshalEasyJSON(out)
+	}
+	if in.Type != "" {
+		const prefix string = ",\"type\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Type))
+	}
+	if in.Title != "" {
+		const prefix string = ",\"title\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Title))
+	}
+	if in.Number != "" {
+		const prefix string = ",\"number\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Number))
+	}
+	if in.IssueDate != nil {
+		const prefix string = ",\"issueDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.IssueDate).MarshalJSON())
+	}
+	if in.ExpiryDate != nil {
+		const prefix string = ",\"expiryDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.ExpiryDate).MarshalJSON())
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Description))
+	}
+	if in.CreateDate != nil {
+		const prefix string = ",\"createDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.CreateDate).MarshalJSON())
+	}
+	if true {
+		const prefix string = ",\"createdBy\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.CreatedBy).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"deletedAt\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.DeletedAt).MarshalJSON())
+	}
+	if in.DeletedBy != nil {
+		const prefix string = ",\"deletedBy\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((*in.DeletedBy).MarshalText())
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Document) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson18605acbEncodeAssetsModulesDocumentModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Document) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson18605acbEncodeAssetsModulesDocumentModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Document) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson18605acbDecodeAssetsModulesDocumentModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Document) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson18605acbDecodeAssetsModulesDocumentModel1(l, v)
+}
@@ -0,0 +1,134 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonDB "assets/common/db"
+	commonModel "assets/common/model"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	assetRepository "assets/modules/asset/repository"
+	"assets/modules/document/model"
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+var documentRepo DocumentRepository
+
+type DocumentRepository interface {
+	commonRepository.SoftDeleteRepository[model.Document]
+	FindAllWithPage(ctx context.Context, filter model.DocumentFilter, page commonModel.Pageable) commonModel.Page[model.Document]
+	FindByAssetId(ctx context.Context, assetId uuid.UUID) []model.Document
+	FindExpiring(ctx context.Context, from time.Time, to time.Time) []model.Document
+	CountByAttachmentId(ctx context.Context, attachmentId uuid.UUID) int64
+	DeleteByAssetId(ctx context.Context, assetId uuid.UUID) []uuid.UUID
+	DeleteByCashFlowId(ctx context.Context, cashFlowId uuid.UUID) []uuid.UUID
+}
+
+type documentRepository struct {
+	commonRepository.SoftDeleteRepository[model.Document]
+	*commonDB.DataSource
+}
+
+func GetDocumentRepository() DocumentRepository {
+	if documentRepo != nil {
+		return documentRepo
+	}
+	documentRepo = &documentRepository{
+		commonRepository.NewSoftDeleteRepository[model.Document](
+			commonDB.GetDataSource(),
+			commonRepository.WithReadScope[model.Document](assetRepository.AssetIdScope("asset_id", false)),
+			commonRepository.WithWriteScope[model.Document](assetRepository.AssetIdScope("asset_id", true)),
+		),
+		commonDB.GetDataSource(),
+	}
+	return documentRepo
+}
+
+func (repo *documentRepository) FindAllWithPage(ctx context.Context, filter model.DocumentFilter, page commonModel.Pageable) commonModel.Page[model.Document] {
+	query := assetRepository.AssetIdScope("asset_id", false)(ctx, repo.DataSource.Model(&model.Document{}))
+	if filter.AssetID != "" {
+		query = query.Where("asset_id = ?", uuid.MustParse(filter.AssetID))
+	}
+	if filter.CashFlowID != "" {
+		query = query.Where("cash_flow_id = ?", uuid.MustParse(filter.CashFlowID))
+	}
+	if len(filter.Types) != 0 {
+		query = query.Where("type in ?", filter.Types)
+	}
+
+	var total int64
+	commonUtil.Must(query.Count(&total).Error)
+
+	var result []model.Document
+	query = query.Preload(clause.Associations).Limit(page.Size).Offset(page.Page * page.Size)
+	query = commonRepository.OrderBy[model.Document](query, page.Sort, "create_date desc")
+	commonUtil.Must(query.Find(&result).Error)
+	return commonModel.NewPage[model.Document](result, page).WithTotal(int(total))
+}
+
+func (repo *documentRepository) FindByAssetId(ctx context.Context, assetId uuid.UUID) []model.Document {
+	var result []model.Document
+	commonUtil.Must(assetRepository.AssetIdScope("asset_id", false)(ctx, repo.DataSource.DB).
+		Preload(clause.Associations).
+		Where("asset_id = ?", assetId).
+		Order("create_date").
+		Find(&result).Error)
+	return result
+}
+
+// FindExpiring returns documents of the available assets expiring within the period
+func (repo *documentRepository) FindExpiring(ctx context.Context, from time.Time, to time.Time) []model.Document {
+	var result []model.Document
+	commonUtil.Must(assetRepository.AssetIdScope("asset_id", false)(ctx, repo.DataSource.DB).
+		Where("expiry_date >= ? and expiry_date <= ?", from, to).
+		Order("expiry_date").
+		Find(&result).Error)
+	return result
+}
+
+func (repo *documentRepository) CountByAttachmentId(ctx context.Context, attachmentId uuid.UUID) int64 {
+	var count int64
+	commonUtil.Must(repo.DataSource.Unscoped().Model(&model.Document{}).Where("attachment_id = ?", attachmentId).Count(&count).Error)
+	return count
+}
+
+// DeleteByAssetId deletes all documents of the asset including the trashed ones and returns their attachments ids
+func (repo *documentRepository) DeleteByAssetId(ctx context.Context, assetId uuid.UUID) []uuid.UUID {
+	var attachmentIds []uuid.UUID
+	commonUtil.Must(repo.DataSource.Unscoped().Model(&model.Document{}).Where("asset_id = ?", assetId).Pluck("attachment_id", &attachmentIds).Error)
+	commonUtil.Must(repo.DataSource.Unscoped().Where("asset_id = ?", assetId).Delete(&model.Document{}).Error)
+	return attachmentIds
+}
+
+// DeleteByCashFlowId deletes all documents of the cash flow including the trashed ones and returns their attachments ids
+func (repo *documentRepository) DeleteByCashFlowId(ctx context.Context, cashFlowId uuid.UUID) []uuid.UUID {
+	var attachmentIds []uuid.UUID
+	commonUtil.Must(repo.DataSource.Unscoped().Model(&model.Document{}).Where("cash_flow_id = ?", cashFlowId).Pluck("attachment_id", &attachmentIds).Error)
+	commonUtil.Must(repo.DataSource.Unscoped().Where("cash_flow_id = ?", cashFlowId).Delete(&model.Document{}).Error)
+	return attachmentIds
+}
@@ -0,0 +1,290 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"archive/zip"
+	commonCache "assets/common/cache"
+	"assets/common/config"
+	commonError "assets/common/custom_error"
+	commonModel "assets/common/model"
+	"assets/common/scheduler"
+	commonUtil "assets/common/util"
+	assetModel "assets/modules/asset/model"
+	assetRepository "assets/modules/asset/repository"
+	assetService "assets/modules/asset/service"
+	attachmentService "assets/modules/attachment/service"
+	"assets/modules/document/model"
+	"assets/modules/document/repository"
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"mime/multipart"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+var documentSrv DocumentService
+
+type DocumentService interface {
+	GetById(ctx context.Context, id uuid.UUID) model.Document
+	GetAll(ctx context.Context, filter model.DocumentFilter, page commonModel.Pageable) commonModel.Page[model.Document]
+	Create(ctx context.Context, document model.Document) model.Document
+	Update(ctx context.Context, document model.Document) model.Document
+	DeleteById(ctx context.Context, id uuid.UUID)
+	GetTrash(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.Document]
+	Restore(ctx context.Context, id uuid.UUID) model.Document
+	PurgeTrash(ctx context.Context)
+
+	Upload(ctx context.Context, document model.Document, fileHeader *multipart.FileHeader) model.Document
+	Download(ctx context.Context, id uuid.UUID) (data []byte, contentType string, fileName string)
+	GetByAssetId(ctx context.Context, assetId uuid.UUID) []model.Document
+	DownloadArchive(ctx context.Context, assetId uuid.UUID) (data []byte, fileName string)
+	FindExpiring(ctx context.Context, from time.Time, to time.Time) []model.Document
+}
+
+type documentService struct {
+	repository        repository.DocumentRepository
+	assetService      assetService.AssetService
+	assetRepository   assetRepository.AssetRepository
+	attachmentService attachmentService.AttachmentService
+	cache             *commonCache.Cache[commonModel.Page[model.Document]]
+}
+
+func GetDocumentService() DocumentService {
+	if documentSrv != nil {
+		return documentSrv
+	}
+
+	service := &documentService{
+		repository:        repository.GetDocumentRepository(),
+		assetService:      assetService.GetAssetService(),
+		assetRepository:   assetRepository.GetAssetRepository(),
+		attachmentService: attachmentService.GetAttachmentService(),
+		cache:             commonCache.NewCache[commonModel.Page[model.Document]]("documents", 24*time.Hour),
+	}
+	service.assetService.AddDeleteListener(service.onAssetDeleted)
+	service.attachmentService.AddReferenceCounter(service.repository.CountByAttachmentId)
+	assetService.GetCashFlowService().AddDeleteListener(service.onCashFlowDeleted)
+	assetService.GetTimelineService().RegisterSource(&documentTimelineSource{repository: service.repository})
+	scheduler.Schedule("documentTrash", config.CoreConfig.Database.GetTrashPurgeInterval(), service.PurgeTrash)
+	documentSrv = service
+
+	return documentSrv
+}
+
+func (service *documentService) GetById(ctx context.Context, id uuid.UUID) model.Document {
+	result := service.repository.GetById(ctx, []uuid.UUID{id})
+	if len(result) == 0 {
+		panic(commonError.NotFoundError)
+	}
+	return result[0]
+}
+
+func (service *documentService) GetAll(ctx context.Context, filter model.DocumentFilter, page commonModel.Pageable) commonModel.Page[model.Document] {
+	result, err := service.cache.Get(ctx)
+	if err == nil {
+		return result
+	}
+	return service.cache.Set(ctx, service.repository.FindAllWithPage(ctx, filter, page))
+}
+
+// Create files the attachment uploaded by the current user to the asset. Documents of a cash flow are filed
+// to the asset the cash flow belongs to
+func (service *documentService) Create(ctx context.Context, document model.Document) model.Document {
+	document.Type = strings.ToUpper(document.Type)
+	if !document.IsValid() {
+		panic(commonError.IllegalArgumentError)
+	}
+	service.attachmentService.GetLinkable(ctx, []uuid.UUID{document.AttachmentID})
+	if document.CashFlowID != nil {
+		document.AssetID = service.getCashFlowAssetId(ctx, *document.CashFlowID, document.AssetID)
+	}
+	service.assetService.CheckWritable(ctx, document.AssetID)
+
+	document.ID, document.Attachment, document.CreateDate = uuid.Nil, nil, nil
+	if tokenInfo, ok := commonUtil.GetCurrentTokenInfo(ctx); ok {
+		document.CreatedBy = tokenInfo.UserId
+	}
+	defer service.cache.Evict(ctx)
+	document = service.repository.Create(ctx, []model.Document{document})[0]
+	return service.GetById(ctx, document.ID)
+}
+
+// Update changes the description of the document, the asset, the cash flow and the file are kept
+func (service *documentService) Update(ctx context.Context, document model.Document) model.Document {
+	stored := service.GetById(ctx, document.ID)
+	document.AssetID, document.CashFlowID, document.AttachmentID = stored.AssetID, stored.CashFlowID, stored.AttachmentID
+	document.CreateDate, document.CreatedBy, document.Attachment = stored.CreateDate, stored.CreatedBy, nil
+	document.Type = strings.ToUpper(document.Type)
+	if !document.IsValid() {
+		panic(commonError.IllegalArgumentError)
+	}
+
+	defer service.cache.Evict(ctx)
+	service.repository.Update(ctx, []model.Document{document})
+	return service.GetById(ctx, document.ID)
+}
+
+// DeleteById moves the document to the trash, its file is kept until the document is purged
+func (service *documentService) DeleteById(ctx context.Context, id uuid.UUID) {
+	service.GetById(ctx, id)
+	defer service.cache.Evict(ctx)
+	service.repository.DeleteById(ctx, id)
+}
+
+func (service *documentService) GetTrash(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.Document] {
+	return service.repository.FindDeleted(ctx, page)
+}
+
+func (service *documentService) Restore(ctx context.Context, id uuid.UUID) model.Document {
+	defer service.cache.Evict(ctx)
+	service.repository.Restore(ctx, id)
+	return service.GetById(ctx, id)
+}
+
+// PurgeTrash deletes for good the documents which are in the trash longer than the retention with their files
+func (service *documentService) PurgeTrash(ctx context.Context) {
+	documents := service.repository.FindDeletedBefore(ctx, time.Now().Add(-config.CoreConfig.Database.GetTrashRetention()))
+	if len(documents) == 0 {
+		return
+	}
+	service.repository.Purge(ctx, commonUtil.Map(documents, model.Document.GetID)...)
+	service.deleteAttachments(ctx, commonUtil.Map(documents, func(it model.Document) uuid.UUID { return it.AttachmentID }))
+	log.WithContext(ctx).Infof("DocumentService: %d documents purged from the trash", len(documents))
+}
+
+// Upload stores the file as a new attachment and files it as the document
+func (service *documentService) Upload(ctx context.Context, document model.Document, fileHeader *multipart.FileHeader) model.Document {
+	document.Type = strings.ToUpper(document.Type)
+	if !commonUtil.ArrayContains(model.DocumentTypes, document.Type) {
+		panic(commonError.IllegalArgumentError)
+	}
+	if document.CashFlowID != nil {
+		document.AssetID = service.getCashFlowAssetId(ctx, *document.CashFlowID, document.AssetID)
+	}
+	service.assetService.CheckWritable(ctx, document.AssetID)
+
+	attachment := service.attachmentService.Create(ctx, fileHeader)
+	defer func() {
+		if err := recover(); err != nil {
+			service.deleteAttachments(ctx, []uuid.UUID{attachment.ID})
+			panic(err)
+		}
+	}()
+	document.AttachmentID = attachment.ID
+	if document.Title == "" {
+		document.Title = strings.TrimSuffix(attachment.FileName, filepath.Ext(attachment.FileName))
+	}
+	return service.Create(ctx, document)
+}
+
+func (service *documentService) Download(ctx context.Context, id uuid.UUID) (data []byte, contentType string, fileName string) {
+	document := service.GetById(ctx, id)
+	data, contentType, fileName, _ = service.attachmentService.GetById(ctx, document.AttachmentID)
+	return data, contentType, fileName
+}
+
+func (service *documentService) GetByAssetId(ctx context.Context, assetId uuid.UUID) []model.Document {
+	service.assetService.GetById(ctx, assetId)
+	return service.repository.FindByAssetId(ctx, assetId)
+}
+
+// DownloadArchive packs files of all documents of the asset into a zip archive with a folder per document type
+func (service *documentService) DownloadArchive(ctx context.Context, assetId uuid.UUID) (data []byte, fileName string) {
+	asset := service.assetService.GetById(ctx, assetId)
+
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	names := make(map[string]int)
+	for _, document := range service.repository.FindByAssetId(ctx, assetId) {
+		fileData, _, name, _ := service.attachmentService.GetById(ctx, document.AttachmentID)
+		name = fmt.Sprintf("%s/%s", strings.ToLower(document.Type), name)
+		if count := names[name]; count != 0 {
+			extension := filepath.Ext(name)
+			names[name]++
+			name = fmt.Sprintf("%s (%d)%s", strings.TrimSuffix(name, extension), count, extension)
+		} else {
+			names[name] = 1
+		}
+
+		file := commonUtil.MustOne(writer.Create(name))
+		commonUtil.MustOne(file.Write(fileData))
+	}
+	commonUtil.Must(writer.Close())
+
+	return buffer.Bytes(), fmt.Sprintf("%s.zip", getArchiveName(asset))
+}
+
+func (service *documentService) FindExpiring(ctx context.Context, from time.Time, to time.Time) []model.Document {
+	return service.repository.FindExpiring(ctx, from, to)
+}
+
+func (service *documentService) onAssetDeleted(ctx context.Context, asset assetModel.Asset) {
+	defer service.cache.Evict(ctx)
+	service.deleteAttachments(ctx, service.repository.DeleteByAssetId(ctx, asset.ID))
+}
+
+func (service *documentService) onCashFlowDeleted(ctx context.Context, cashFlow assetModel.CashFlow) {
+	defer service.cache.Evict(ctx)
+	service.deleteAttachments(ctx, service.repository.DeleteByCashFlowId(ctx, cashFlow.ID))
+}
+
+// deleteAttachments deletes the attachments which are neither filed to other documents nor referred by
+// other entities, e.g. work orders
+func (service *documentService) deleteAttachments(ctx context.Context, attachmentIds []uuid.UUID) {
+	service.attachmentService.DeleteUnreferenced(ctx, attachmentIds)
+}
+
+// getCashFlowAssetId returns the asset of the cash flow, the given asset must be the one if it is set
+func (service *documentService) getCashFlowAssetId(ctx context.Context, cashFlowId uuid.UUID, assetId uuid.UUID) uuid.UUID {
+	assets := service.assetRepository.FindByCashFlowId(ctx, cashFlowId)
+	if len(assets) == 0 {
+		panic(commonError.NotFoundError)
+	}
+	if assetId == uuid.Nil {
+		return assets[0].ID
+	}
+	if exists, _ := commonUtil.ArrayFindFirst(assets, func(it assetModel.Asset) bool { return it.ID == assetId }); !exists {
+		panic(commonError.IllegalArgumentError)
+	}
+	return assetId
+}
+
+func getArchiveName(asset assetModel.Asset) string {
+	name := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`/\:*?"<>|`, r) {
+			return '_'
+		}
+		return r
+	}, asset.Name)
+	if name == "" {
+		return asset.ID.String()
+	}
+	return name
+}
@@ -0,0 +1,54 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	assetModel "assets/modules/asset/model"
+	"assets/modules/document/repository"
+	"context"
+	"fmt"
+)
+
+// documentTimelineSource puts the documents filed to the asset on the asset timeline
+type documentTimelineSource struct {
+	repository repository.DocumentRepository
+}
+
+func (source *documentTimelineSource) Type() string {
+	return assetModel.DocumentTimelineEvent
+}
+
+func (source *documentTimelineSource) Collect(ctx context.Context, asset assetModel.Asset) []assetModel.TimelineEvent {
+	var result []assetModel.TimelineEvent
+	for _, document := range source.repository.FindByAssetId(ctx, asset.ID) {
+		if document.CreateDate == nil {
+			continue
+		}
+		summary := fmt.Sprintf("Document uploaded: %s", document.Title)
+		event := assetModel.NewTimelineEvent(assetModel.DocumentTimelineEvent, *document.CreateDate, document.ID, summary, document)
+		result = append(result, event.WithUser(document.CreatedBy))
+	}
+	return result
+}
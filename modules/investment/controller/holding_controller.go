@@ -0,0 +1,315 @@
+package controller
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonController "assets/common/controller"
+	commonMiddleware "assets/common/middleware"
+	commonResolver "assets/common/resolver"
+	commonUtil "assets/common/util"
+	"assets/modules/investment/model"
+	"assets/modules/investment/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+	"time"
+)
+
+var holdingCntr commonController.HttpController
+
+type holdingController struct {
+	service service.HoldingService
+}
+
+func GetHoldingController() commonController.HttpController {
+	if holdingCntr != nil {
+		return holdingCntr
+	}
+	holdingCntr = &holdingController{service: service.GetHoldingService()}
+	return holdingCntr
+}
+
+func (controller *holdingController) RegisterHttpController(router *gin.Engine) {
+	holdingRouter := router.Group("/api/investment/holdings", commonMiddleware.SecurityHandler)
+
+	holdingRouter.GET(
+		"/positions",
+		commonMiddleware.HasAnyAuthorities("READ_INVESTMENT"),
+		commonMiddleware.FilterHandler[model.HoldingFilter],
+		controller.getPositions,
+	)
+
+	holdingRouter.GET(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("READ_INVESTMENT"),
+		controller.getById,
+	)
+
+	holdingRouter.GET(
+		"",
+		commonMiddleware.HasAnyAuthorities("READ_INVESTMENT"),
+		commonMiddleware.PaginationHandler,
+		commonMiddleware.FilterHandler[model.HoldingFilter],
+		controller.getAll,
+	)
+
+	holdingRouter.POST(
+		"",
+		commonMiddleware.HasAnyAuthorities("EDIT_INVESTMENT"),
+		commonResolver.Resolver[model.Holding],
+		controller.create,
+	)
+
+	holdingRouter.PUT(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_INVESTMENT"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.UpdateResolver[model.Holding],
+		controller.update,
+	)
+
+	holdingRouter.PATCH(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_INVESTMENT"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.PatchResolver(controller.service.GetById),
+		controller.update,
+	)
+
+	holdingRouter.DELETE(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_INVESTMENT"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		controller.deleteById,
+	)
+
+	holdingRouter.GET(
+		"/:id/position",
+		commonMiddleware.HasAnyAuthorities("READ_INVESTMENT"),
+		controller.getPosition,
+	)
+
+	holdingRouter.POST(
+		"/:id/transactions",
+		commonMiddleware.HasAnyAuthorities("EDIT_INVESTMENT"),
+		commonResolver.Resolver[model.HoldingTransaction],
+		controller.addTransaction,
+	)
+
+	holdingRouter.DELETE(
+		"/:id/transactions/:transactionId",
+		commonMiddleware.HasAnyAuthorities("EDIT_INVESTMENT"),
+		controller.deleteTransaction,
+	)
+}
+
+// holdingController godoc
+// @Security BearerAuth
+// @Summary      getById
+// @Description  Get holding with its transactions by id
+// @Tags         Holding controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Holding.ID"
+// @Success      200	{object}  model.Holding
+// @Failure      400
+// @Failure      500
+// @Router       /api/investment/holdings/{id} [GET]
+func (controller *holdingController) getById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("HoldingController: GetById(id: %s): Start", id)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.GetById(ctx, id))
+	log.WithContext(ctx).Info("HoldingController: GetById(): End")
+}
+
+// holdingController godoc
+// @Security BearerAuth
+// @Summary      getAll
+// @Description  Get all holdings of available assets
+// @Tags         Holding controller
+// @Accept       json
+// @Produce      json
+// @Param        assetId		query	string  false  "Holding.AssetID"
+// @Param        instrumentId	query	string  false  "Holding.InstrumentID"
+// @Success      200	{array}  model.Holding
+// @Failure      400
+// @Failure      500
+// @Router       /api/investment/holdings/ [GET]
+func (controller *holdingController) getAll(ctx *gin.Context) {
+	page := commonUtil.MustGetPageable(ctx)
+	filter := commonUtil.MustGetFilterObject[model.HoldingFilter](ctx)
+	log.WithContext(ctx).Info("HoldingController: GetAll(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetAll(ctx, filter, page))
+	log.WithContext(ctx).Info("HoldingController: GetAll(): End")
+}
+
+// holdingController godoc
+// @Security BearerAuth
+// @Summary      create
+// @Description  Create empty holding of instrument within asset, FIFO cost method by default
+// @Tags         Holding controller
+// @Accept       json
+// @Produce      json
+// @Param        holding	body	  model.Holding  true  "Create Holding"
+// @Success      201	{object}  model.Holding
+// @Failure      400
+// @Failure      500
+// @Router       /api/investment/holdings/ [POST]
+func (controller *holdingController) create(ctx *gin.Context) {
+	log.WithContext(ctx).Info("HoldingController: Create(): Start")
+	holding := ctx.MustGet("RequestBody").(model.Holding)
+	commonUtil.AbortWithEntity(ctx, http.StatusCreated, controller.service.Create(ctx, holding))
+	log.WithContext(ctx).Info("HoldingController: Create(): End")
+}
+
+// holdingController godoc
+// @Security BearerAuth
+// @Summary      update
+// @Description  Change cost method of holding
+// @Tags         Holding controller
+// @Accept       json
+// @Produce      json
+// @Param        holding	body	  model.Holding  true  "Update Holding"
+// @Success      200	{object}  model.Holding
+// @Failure      400
+// @Failure      500
+// @Router       /api/investment/holdings/{id} [PUT]
+// @Router       /api/investment/holdings/{id} [PATCH]
+func (controller *holdingController) update(ctx *gin.Context) {
+	log.WithContext(ctx).Info("HoldingController: Update(): Start")
+	holding := ctx.MustGet("RequestBody").(model.Holding)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Update(ctx, holding))
+	log.WithContext(ctx).Info("HoldingController: Update(): End")
+}
+
+// holdingController godoc
+// @Security BearerAuth
+// @Summary      deleteById
+// @Description  Delete holding with its transactions and incomes
+// @Tags         Holding controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Holding.ID"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/investment/holdings/{id} [DELETE]
+func (controller *holdingController) deleteById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("HoldingController: DeleteById(id: %s): Start", id)
+	controller.service.DeleteById(ctx, id)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("HoldingController: DeleteById(): End")
+}
+
+// holdingController godoc
+// @Security BearerAuth
+// @Summary      getPosition
+// @Description  Get quantity, cost basis, gains and market value of holding at date
+// @Tags         Holding controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Holding.ID"
+// @Param        date	query	string  false  "Valuation date, now by default (2006-01-02 or RFC3339)"
+// @Success      200	{object}  model.Position
+// @Failure      400
+// @Failure      500
+// @Router       /api/investment/holdings/{id}/position [GET]
+func (controller *holdingController) getPosition(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("HoldingController: GetPosition(id: %s): Start", id)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetPosition(ctx, id, getValuationDate(ctx)))
+	log.WithContext(ctx).Info("HoldingController: GetPosition(): End")
+}
+
+// holdingController godoc
+// @Security BearerAuth
+// @Summary      getPositions
+// @Description  Get positions of all holdings of available assets at date
+// @Tags         Holding controller
+// @Accept       json
+// @Produce      json
+// @Param        assetId		query	string  false  "Holding.AssetID"
+// @Param        instrumentId	query	string  false  "Holding.InstrumentID"
+// @Param        date			query	string  false  "Valuation date, now by default (2006-01-02 or RFC3339)"
+// @Success      200	{array}  model.Position
+// @Failure      400
+// @Failure      500
+// @Router       /api/investment/holdings/positions [GET]
+func (controller *holdingController) getPositions(ctx *gin.Context) {
+	filter := commonUtil.MustGetFilterObject[model.HoldingFilter](ctx)
+	log.WithContext(ctx).Info("HoldingController: GetPositions(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetPositions(ctx, filter, getValuationDate(ctx)))
+	log.WithContext(ctx).Info("HoldingController: GetPositions(): End")
+}
+
+// holdingController godoc
+// @Security BearerAuth
+// @Summary      addTransaction
+// @Description  Add buy, sell, dividend or coupon transaction to holding, incomes are registered as asset incomes
+// @Tags         Holding controller
+// @Accept       json
+// @Produce      json
+// @Param        id				path	string  true  "Holding.ID"
+// @Param        transaction	body	model.HoldingTransaction  true  "Create HoldingTransaction"
+// @Success      201	{object}  model.Holding
+// @Failure      400
+// @Failure      500
+// @Router       /api/investment/holdings/{id}/transactions [POST]
+func (controller *holdingController) addTransaction(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("HoldingController: AddTransaction(id: %s): Start", id)
+	transaction := ctx.MustGet("RequestBody").(model.HoldingTransaction)
+	ctx.AbortWithStatusJSON(http.StatusCreated, controller.service.AddTransaction(ctx, id, transaction))
+	log.WithContext(ctx).Info("HoldingController: AddTransaction(): End")
+}
+
+// holdingController godoc
+// @Security BearerAuth
+// @Summary      deleteTransaction
+// @Description  Delete transaction of holding with the income registered by it
+// @Tags         Holding controller
+// @Accept       json
+// @Produce      json
+// @Param        id				path	string  true  "Holding.ID"
+// @Param        transactionId	path	string  true  "HoldingTransaction.ID"
+// @Success      200	{object}  model.Holding
+// @Failure      400
+// @Failure      500
+// @Router       /api/investment/holdings/{id}/transactions/{transactionId} [DELETE]
+func (controller *holdingController) deleteTransaction(ctx *gin.Context) {
+	id, transactionId := uuid.MustParse(ctx.Param("id")), uuid.MustParse(ctx.Param("transactionId"))
+	log.WithContext(ctx).Infof("HoldingController: DeleteTransaction(id: %s, transactionId: %s): Start", id, transactionId)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.DeleteTransaction(ctx, id, transactionId))
+	log.WithContext(ctx).Info("HoldingController: DeleteTransaction(): End")
+}
+
+func getValuationDate(ctx *gin.Context) time.Time {
+	if date := commonUtil.GetTimeQuery(ctx, "date"); date != nil {
+		return *date
+	}
+	return time.Now()
+}
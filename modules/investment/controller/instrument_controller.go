@@ -0,0 +1,280 @@
+package controller
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonController "assets/common/controller"
+	commonMiddleware "assets/common/middleware"
+	commonResolver "assets/common/resolver"
+	commonUtil "assets/common/util"
+	"assets/modules/investment/model"
+	"assets/modules/investment/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+var instrumentCntr commonController.HttpController
+
+type instrumentController struct {
+	service service.InstrumentService
+}
+
+func GetInstrumentController() commonController.HttpController {
+	if instrumentCntr != nil {
+		return instrumentCntr
+	}
+	instrumentCntr = &instrumentController{service: service.GetInstrumentService()}
+	return instrumentCntr
+}
+
+func (controller *instrumentController) RegisterHttpController(router *gin.Engine) {
+	instrumentRouter := router.Group("/api/investment/instruments", commonMiddleware.SecurityHandler)
+
+	instrumentRouter.GET(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("READ_INVESTMENT"),
+		controller.getById,
+	)
+
+	instrumentRouter.GET(
+		"",
+		commonMiddleware.HasAnyAuthorities("READ_INVESTMENT"),
+		commonMiddleware.PaginationHandler,
+		commonMiddleware.FilterHandler[model.InstrumentFilter],
+		controller.getAll,
+	)
+
+	instrumentRouter.POST(
+		"",
+		commonMiddleware.HasAnyAuthorities("EDIT_INVESTMENT"),
+		commonResolver.Resolver[model.Instrument],
+		controller.create,
+	)
+
+	instrumentRouter.PUT(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_INVESTMENT"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.UpdateResolver[model.Instrument],
+		controller.update,
+	)
+
+	instrumentRouter.PATCH(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_INVESTMENT"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.PatchResolver(controller.service.GetById),
+		controller.update,
+	)
+
+	instrumentRouter.DELETE(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_INVESTMENT"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		controller.deleteById,
+	)
+
+	instrumentRouter.GET(
+		"/:id/prices",
+		commonMiddleware.HasAnyAuthorities("READ_INVESTMENT"),
+		controller.getPrices,
+	)
+
+	instrumentRouter.POST(
+		"/:id/prices",
+		commonMiddleware.HasAnyAuthorities("EDIT_INVESTMENT"),
+		controller.importPrices,
+	)
+
+	instrumentRouter.DELETE(
+		"/:id/prices",
+		commonMiddleware.HasAnyAuthorities("EDIT_INVESTMENT"),
+		controller.deletePrices,
+	)
+}
+
+// instrumentController godoc
+// @Security BearerAuth
+// @Summary      getById
+// @Description  Get instrument by id
+// @Tags         Instrument controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Instrument.ID"
+// @Success      200	{object}  model.Instrument
+// @Failure      400
+// @Failure      500
+// @Router       /api/investment/instruments/{id} [GET]
+func (controller *instrumentController) getById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("InstrumentController: GetById(id: %s): Start", id)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.GetById(ctx, id))
+	log.WithContext(ctx).Info("InstrumentController: GetById(): End")
+}
+
+// instrumentController godoc
+// @Security BearerAuth
+// @Summary      getAll
+// @Description  Get all instruments
+// @Tags         Instrument controller
+// @Accept       json
+// @Produce      json
+// @Param        type	query	string  false  "Instrument.Type"
+// @Param        query	query	string  false  "Part of ticker, ISIN or name"
+// @Success      200	{array}  model.Instrument
+// @Failure      400
+// @Failure      500
+// @Router       /api/investment/instruments/ [GET]
+func (controller *instrumentController) getAll(ctx *gin.Context) {
+	page := commonUtil.MustGetPageable(ctx)
+	filter := commonUtil.MustGetFilterObject[model.InstrumentFilter](ctx)
+	log.WithContext(ctx).Info("InstrumentController: GetAll(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetAll(ctx, filter, page))
+	log.WithContext(ctx).Info("InstrumentController: GetAll(): End")
+}
+
+// instrumentController godoc
+// @Security BearerAuth
+// @Summary      create
+// @Description  Create instrument
+// @Tags         Instrument controller
+// @Accept       json
+// @Produce      json
+// @Param        instrument	body	  model.Instrument  true  "Create Instrument"
+// @Success      201	{object}  model.Instrument
+// @Failure      400
+// @Failure      500
+// @Router       /api/investment/instruments/ [POST]
+func (controller *instrumentController) create(ctx *gin.Context) {
+	log.WithContext(ctx).Info("InstrumentController: Create(): Start")
+	instrument := ctx.MustGet("RequestBody").(model.Instrument)
+	commonUtil.AbortWithEntity(ctx, http.StatusCreated, controller.service.Create(ctx, instrument))
+	log.WithContext(ctx).Info("InstrumentController: Create(): End")
+}
+
+// instrumentController godoc
+// @Security BearerAuth
+// @Summary      update
+// @Description  Update instrument
+// @Tags         Instrument controller
+// @Accept       json
+// @Produce      json
+// @Param        instrument	body	  model.Instrument  true  "Update Instrument"
+// @Success      200	{object}  model.Instrument
+// @Failure      400
+// @Failure      500
+// @Router       /api/investment/instruments/{id} [PUT]
+// @Router       /api/investment/instruments/{id} [PATCH]
+func (controller *instrumentController) update(ctx *gin.Context) {
+	log.WithContext(ctx).Info("InstrumentController: Update(): Start")
+	instrument := ctx.MustGet("RequestBody").(model.Instrument)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Update(ctx, instrument))
+	log.WithContext(ctx).Info("InstrumentController: Update(): End")
+}
+
+// instrumentController godoc
+// @Security BearerAuth
+// @Summary      deleteById
+// @Description  Delete instrument not used by holdings with its price history
+// @Tags         Instrument controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Instrument.ID"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/investment/instruments/{id} [DELETE]
+func (controller *instrumentController) deleteById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("InstrumentController: DeleteById(id: %s): Start", id)
+	controller.service.DeleteById(ctx, id)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("InstrumentController: DeleteById(): End")
+}
+
+// instrumentController godoc
+// @Security BearerAuth
+// @Summary      getPrices
+// @Description  Get price history of instrument ordered by date
+// @Tags         Instrument controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Instrument.ID"
+// @Param        from	query	string  false  "Period start (2006-01-02 or RFC3339)"
+// @Param        to		query	string  false  "Period end (2006-01-02 or RFC3339)"
+// @Success      200	{array}  model.InstrumentPrice
+// @Failure      400
+// @Failure      500
+// @Router       /api/investment/instruments/{id}/prices [GET]
+func (controller *instrumentController) getPrices(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	from, to := commonUtil.GetTimeQuery(ctx, "from"), commonUtil.GetTimeQuery(ctx, "to")
+	log.WithContext(ctx).Infof("InstrumentController: GetPrices(id: %s): Start", id)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetPrices(ctx, id, from, to))
+	log.WithContext(ctx).Info("InstrumentController: GetPrices(): End")
+}
+
+// instrumentController godoc
+// @Security BearerAuth
+// @Summary      importPrices
+// @Description  Import CSV price history of instrument, prices at the existing dates are replaced
+// @Tags         Instrument controller
+// @Accept       mpfd
+// @Produce      json
+// @Param        id		path     string  true  "Instrument.ID"
+// @Param        file	formData	file  true  "CSV price history with date and price (close) columns"
+// @Success      201	{array}  model.InstrumentPrice
+// @Failure      400
+// @Failure      500
+// @Router       /api/investment/instruments/{id}/prices [POST]
+func (controller *instrumentController) importPrices(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("InstrumentController: ImportPrices(id: %s): Start", id)
+	fileHeader := commonUtil.MustOne(ctx.FormFile("file"))
+	ctx.AbortWithStatusJSON(http.StatusCreated, controller.service.ImportPrices(ctx, id, fileHeader))
+	log.WithContext(ctx).Info("InstrumentController: ImportPrices(): End")
+}
+
+// instrumentController godoc
+// @Security BearerAuth
+// @Summary      deletePrices
+// @Description  Delete price history of instrument
+// @Tags         Instrument controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "Instrument.ID"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/investment/instruments/{id}/prices [DELETE]
+func (controller *instrumentController) deletePrices(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("InstrumentController: DeletePrices(id: %s): Start", id)
+	controller.service.DeletePrices(ctx, id)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("InstrumentController: DeletePrices(): End")
+}
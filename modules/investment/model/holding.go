@@ -0,0 +1,130 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"sort"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const (
+	FifoCostMethod    = "FIFO"
+	AverageCostMethod = "AVERAGE"
+)
+
+var CostMethods = []string{FifoCostMethod, AverageCostMethod}
+
+var ErrInsufficientQuantity = errors.New("sold quantity exceeds the held one")
+
+// Holding is a position in the instrument kept within the asset, e.g. a brokerage account
+type Holding struct {
+	ID           uuid.UUID             `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	AssetID      uuid.UUID             `json:"assetId,omitempty" gorm:"type:uuid;index"`
+	InstrumentID uuid.UUID             `json:"instrumentId,omitempty" gorm:"type:uuid;index"`
+	Instrument   *Instrument           `json:"instrument,omitempty"`
+	CostMethod   string                `json:"costMethod,omitempty"`
+	Transactions []*HoldingTransaction `json:"transactions,omitempty" gorm:"foreignKey:HoldingID"`
+	commonModel.Versioned
+}
+
+func (holding Holding) GetID() uuid.UUID {
+	return holding.ID
+}
+
+func (holding *Holding) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(holding.ID) {
+		holding.ID = uuid.New()
+	}
+	if holding.CostMethod == "" {
+		holding.CostMethod = FifoCostMethod
+	}
+	return nil
+}
+
+// PositionAt replays the transactions made up to the date. Sold units are taken from the earliest
+// lots with the FIFO method or at the average cost of all held units with the AVERAGE one
+func (holding Holding) PositionAt(date time.Time) (Position, error) {
+	position := Position{
+		HoldingID:  holding.ID,
+		AssetID:    holding.AssetID,
+		Instrument: holding.Instrument,
+		CostMethod: holding.CostMethod,
+		Date:       &date,
+	}
+
+	transactions := commonUtil.ArrayFilter(holding.Transactions, func(it *HoldingTransaction) bool {
+		return it.Date != nil && !it.Date.After(date)
+	})
+	sort.SliceStable(transactions, func(i, j int) bool { return transactions[i].Date.Before(*transactions[j].Date) })
+
+	for _, transaction := range transactions {
+		switch transaction.Type {
+		case BuyTransaction:
+			cost := transaction.Quantity*transaction.Price + transaction.Fee
+			position.Lots = append(position.Lots, &Lot{
+				TransactionID: transaction.ID,
+				Date:          transaction.Date,
+				Quantity:      transaction.Quantity,
+				UnitCost:      cost / transaction.Quantity,
+			})
+			position.Quantity += transaction.Quantity
+			position.CostBasis += cost
+		case SellTransaction:
+			if transaction.Quantity > position.Quantity+quantityPrecision {
+				return position, ErrInsufficientQuantity
+			}
+			soldCost := position.sell(transaction.Quantity, holding.CostMethod == AverageCostMethod)
+			position.RealizedGain += transaction.Quantity*transaction.Price - transaction.Fee - soldCost
+		case DividendTransaction, CouponTransaction:
+			position.Income += transaction.Amount
+		}
+	}
+
+	if position.Quantity > quantityPrecision {
+		position.AverageCost = position.CostBasis / position.Quantity
+	}
+	if holding.CostMethod == AverageCostMethod {
+		position.Lots = nil
+	}
+	return position, nil
+}
+
+type HoldingFilter struct {
+	AssetID      string `form:"assetId"`
+	InstrumentID string `form:"instrumentId"`
+}
+
+func NewHolding(assetId uuid.UUID, instrumentId uuid.UUID, costMethod string) Holding {
+	return Holding{
+		AssetID:      assetId,
+		InstrumentID: instrumentId,
+		CostMethod:   costMethod,
+	}
+}
@@ -0,0 +1,287 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson7032ceabDecodeAssetsModulesInvestmentModel(in *jlexer.Lexer, out *HoldingFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "AssetID":
+			out.AssetID = string(in.String())
+		case "InstrumentID":
+			out.InstrumentID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7032ceabEncodeAssetsModulesInvestmentModel(out *jwriter.Writer, in HoldingFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"AssetID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AssetID))
+	}
+	{
+		const prefix string = ",\"InstrumentID\":"
+		out.RawString(prefix)
+		out.String(string(in.InstrumentID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HoldingFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7032ceabEncodeAssetsModulesInvestmentModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HoldingFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7032ceabEncodeAssetsModulesInvestmentModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HoldingFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7032ceabDecodeAssetsModulesInvestmentModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HoldingFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7032ceabDecodeAssetsModulesInvestmentModel(l, v)
+}
+func easyjson7032ceabDecodeAssetsModulesInvestmentModel1(in *jlexer.Lexer, out *Holding) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "assetId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AssetID).UnmarshalText(data))
+			}
+		case "instrumentId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.InstrumentID).UnmarshalText(data))
+			}
+		case "instrument":
+			if in.IsNull() {
+				in.Skip()
+				out.Instrument = nil
+			} else {
+				if out.Instrument == nil {
+					out.Instrument = new(Instrument)
+				}
+				(*out.Instrument).UnmarshalEasyJSON(in)
+			}
+		case "costMethod":
+			out.CostMethod = string(in.String())
+		case "transactions":
+			if in.IsNull() {
+				in.Skip()
+				out.Transactions = nil
+			} else {
+				in.Delim('[')
+				if out.Transactions == nil {
+					if !in.IsDelim(']') {
+						out.Transactions = make([]*HoldingTransaction, 0, 8)
+					} else {
+						out.Transactions = []*HoldingTransaction{}
+					}
+				} else {
+					out.Transactions = (out.Transactions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 *HoldingTransaction
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(HoldingTransaction)
+						}
+						(*v1).UnmarshalEasyJSON(in)
+					}
+					out.Transactions = append(out.Transactions, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "version":
+			out.Version = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7032ceabEncodeAssetsModulesInvestmentModel1(out *jwriter.Writer, in Holding) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"assetId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.AssetID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"instrumentId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.InstrumentID).MarshalText())
+	}
+	if in.Instrument != nil {
+		const prefix string = ",\"instrument\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Instrument).MarshalEasyJSON(out)
+	}
+	if in.CostMethod != "" {
+		const prefix string = ",\"costMethod\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.CostMethod))
+	}
+	if len(in.Transactions) != 0 {
+		const prefix string = ",\"transactions\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Transactions {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				if v3 == nil {
+					out.RawString("null")
+				} else {
+					(*v3).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Holding) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7032ceabEncodeAssetsModulesInvestmentModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Holding) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7032ceabEncodeAssetsModulesInvestmentModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Holding) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7032ceabDecodeAssetsModulesInvestmentModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Holding) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7032ceabDecodeAssetsModulesInvestmentModel1(l, v)
+}
@@ -0,0 +1,110 @@
+package model
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestHoldingPositionAt(t *testing.T) {
+	day := func(day int) *time.Time {
+		result := time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC)
+		return &result
+	}
+	transaction := func(transactionType string, date *time.Time, quantity float64, price float64, fee float64) *HoldingTransaction {
+		return &HoldingTransaction{ID: uuid.New(), Type: transactionType, Date: date, Quantity: quantity, Price: price, Fee: fee}
+	}
+	dividend := &HoldingTransaction{ID: uuid.New(), Type: DividendTransaction, Date: day(4), Amount: 5}
+	// the transactions are listed out of order on purpose, they are replayed by date
+	trades := []*HoldingTransaction{
+		transaction(SellTransaction, day(3), 15, 30, 0),
+		transaction(BuyTransaction, day(1), 10, 10, 0),
+		transaction(BuyTransaction, day(2), 10, 20, 0),
+		dividend,
+		transaction(SellTransaction, day(10), 5, 40, 0),
+	}
+
+	tests := []struct {
+		name         string
+		costMethod   string
+		transactions []*HoldingTransaction
+		date         *time.Time
+		wantErr      error
+		quantity     float64
+		costBasis    float64
+		averageCost  float64
+		realizedGain float64
+		income       float64
+		lots         []float64
+	}{
+		{"fifo sells the earliest lots", FifoCostMethod, trades, day(5), nil, 5, 100, 20, 250, 5, []float64{5}},
+		{"average sells at the average cost", AverageCostMethod, trades, day(5), nil, 5, 75, 15, 225, 5, nil},
+		{"transactions after the date are skipped", FifoCostMethod, trades, day(2), nil, 20, 300, 15, 0, 0, []float64{10, 10}},
+		{"later sale closes the position", FifoCostMethod, trades, day(10), nil, 0, 0, 0, 350, 5, nil},
+		{"fees are part of the cost and the gain", FifoCostMethod, []*HoldingTransaction{
+			transaction(BuyTransaction, day(1), 10, 10, 10),
+			transaction(SellTransaction, day(2), 4, 12, 2),
+		}, day(5), nil, 6, 66, 11, 2, 0, []float64{6}},
+		{"sale over the held quantity", FifoCostMethod, []*HoldingTransaction{
+			transaction(BuyTransaction, day(1), 5, 10, 0),
+			transaction(SellTransaction, day(2), 6, 12, 0),
+		}, day(5), ErrInsufficientQuantity, 0, 0, 0, 0, 0, nil},
+		{"transactions without date are skipped", FifoCostMethod, []*HoldingTransaction{
+			transaction(BuyTransaction, nil, 5, 10, 0),
+		}, day(5), nil, 0, 0, 0, 0, 0, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			holding := Holding{ID: uuid.New(), CostMethod: test.costMethod, Transactions: test.transactions}
+			position, err := holding.PositionAt(*test.date)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("PositionAt() error = %v, want %v", err, test.wantErr)
+			}
+			if test.wantErr != nil {
+				return
+			}
+			check := func(field string, got float64, want float64) {
+				if math.Abs(got-want) > 1e-9 {
+					t.Errorf("%s = %v, want %v", field, got, want)
+				}
+			}
+			check("Quantity", position.Quantity, test.quantity)
+			check("CostBasis", position.CostBasis, test.costBasis)
+			check("AverageCost", position.AverageCost, test.averageCost)
+			check("RealizedGain", position.RealizedGain, test.realizedGain)
+			check("Income", position.Income, test.income)
+			if len(position.Lots) != len(test.lots) {
+				t.Fatalf("len(Lots) = %d, want %d", len(position.Lots), len(test.lots))
+			}
+			for i, lot := range position.Lots {
+				check("Lot quantity", lot.Quantity, test.lots[i])
+			}
+		})
+	}
+}
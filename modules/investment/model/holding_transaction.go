@@ -0,0 +1,95 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const (
+	BuyTransaction      = "BUY"
+	SellTransaction     = "SELL"
+	DividendTransaction = "DIVIDEND"
+	CouponTransaction   = "COUPON"
+)
+
+var TransactionTypes = []string{BuyTransaction, SellTransaction, DividendTransaction, CouponTransaction}
+
+// HoldingTransaction is a trade of the instrument units or an income paid on them. Trades hold
+// the quantity and the unit price, incomes hold the amount which is registered as an asset income
+type HoldingTransaction struct {
+	ID          uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	HoldingID   uuid.UUID  `json:"holdingId,omitempty" gorm:"type:uuid;index"`
+	Type        string     `json:"type,omitempty"`
+	Date        *time.Time `json:"date,omitempty"`
+	Quantity    float64    `json:"quantity,omitempty"`
+	Price       float64    `json:"price,omitempty"`
+	Fee         float64    `json:"fee,omitempty"`
+	Amount      float64    `json:"amount,omitempty"`
+	Description string     `json:"description,omitempty"`
+	CashFlowID  *uuid.UUID `json:"cashFlowId,omitempty" gorm:"type:uuid"`
+	commonModel.Versioned
+}
+
+func (transaction HoldingTransaction) GetID() uuid.UUID {
+	return transaction.ID
+}
+
+func (transaction *HoldingTransaction) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(transaction.ID) {
+		transaction.ID = uuid.New()
+	}
+	return nil
+}
+
+func (transaction HoldingTransaction) IsTrade() bool {
+	return transaction.Type == BuyTransaction || transaction.Type == SellTransaction
+}
+
+func (transaction HoldingTransaction) IsIncome() bool {
+	return transaction.Type == DividendTransaction || transaction.Type == CouponTransaction
+}
+
+func (transaction HoldingTransaction) IsValid() bool {
+	if transaction.Date == nil || transaction.Fee < 0 {
+		return false
+	}
+	if transaction.IsTrade() {
+		return transaction.Quantity > 0 && transaction.Price >= 0
+	}
+	return transaction.IsIncome() && transaction.Amount > 0
+}
+
+func NewHoldingTransaction(holdingId uuid.UUID, transactionType string, date time.Time) HoldingTransaction {
+	return HoldingTransaction{
+		HoldingID: holdingId,
+		Type:      transactionType,
+		Date:      &date,
+	}
+}
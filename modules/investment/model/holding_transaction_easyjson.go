@@ -0,0 +1,232 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	uuid "github.com/google/uuid"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson8391c542DecodeAssetsModulesInvestmentModel(in *jlexer.Lexer, out *HoldingTransaction) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "holdingId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.HoldingID).UnmarshalText(data))
+			}
+		case "type":
+			out.Type = string(in.String())
+		case "date":
+			if in.IsNull() {
+				in.Skip()
+				out.Date = nil
+			} else {
+				if out.Date == nil {
+					out.Date = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.Date).UnmarshalJSON(data))
+				}
+			}
+		case "quantity":
+			out.Quantity = float64(in.Float64())
+		case "price":
+			out.Price = float64(in.Float64())
+		case "fee":
+			out.Fee = float64(in.Float64())
+		case "amount":
+			out.Amount = float64(in.Float64())
+		case "description":
+			out.Description = string(in.String())
+		case "cashFlowId":
+			if in.IsNull() {
+				in.Skip()
+				out.CashFlowID = nil
+			} else {
+				if out.CashFlowID == nil {
+					out.CashFlowID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.CashFlowID).UnmarshalText(data))
+				}
+			}
+		case "version":
+			out.Version = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8391c542EncodeAssetsModulesInvestmentModel(out *jwriter.Writer, in HoldingTransaction) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"holdingId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.HoldingID).MarshalText())
+	}
+	if in.Type != "" {
+		const prefix string = ",\"type\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Type))
+	}
+	if in.Date != nil {
+		const prefix string = ",\"date\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.Date).MarshalJSON())
+	}
+	if in.Quantity != 0 {
+		const prefix string = ",\"quantity\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.Quantity))
+	}
+	if in.Price != 0 {
+		const prefix string = ",\"price\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.Price))
+	}
+	if in.Fee != 0 {
+		const prefix string = ",\"fee\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.Fee))
+	}
+	if in.Amount != 0 {
+		const prefix string = ",\"amount\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.Amount))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Description))
+	}
+	if in.CashFlowID != nil {
+		const prefix string = ",\"cashFlowId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((*in.CashFlowID).MarshalText())
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HoldingTransaction) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson8391c542EncodeAssetsModulesInvestmentModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HoldingTransaction) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson8391c542EncodeAssetsModulesInvestmentModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HoldingTransaction) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson8391c542DecodeAssetsModulesInvestmentModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HoldingTransaction) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson8391c542DecodeAssetsModulesInvestmentModel(l, v)
+}
@@ -0,0 +1,99 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"math"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const (
+	StockInstrument   = "STOCK"
+	BondInstrument    = "BOND"
+	FundInstrument    = "FUND"
+	DepositInstrument = "DEPOSIT"
+)
+
+var InstrumentTypes = []string{StockInstrument, BondInstrument, FundInstrument, DepositInstrument}
+
+type Instrument struct {
+	ID       uuid.UUID `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	Ticker   string    `json:"ticker,omitempty" gorm:"index"`
+	Isin     string    `json:"isin,omitempty" gorm:"index"`
+	Name     string    `json:"name,omitempty"`
+	Type     string    `json:"type,omitempty"`
+	Currency string    `json:"currency,omitempty"`
+	LotSize  float64   `json:"lotSize,omitempty"`
+	commonModel.Versioned
+}
+
+func (instrument Instrument) GetID() uuid.UUID {
+	return instrument.ID
+}
+
+func (instrument *Instrument) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(instrument.ID) {
+		instrument.ID = uuid.New()
+	}
+	if instrument.LotSize == 0 {
+		instrument.LotSize = 1
+	}
+	return nil
+}
+
+func (instrument Instrument) IsValid() bool {
+	return (instrument.Ticker != "" || instrument.Isin != "") && instrument.Currency != "" &&
+		commonUtil.ArrayContains(InstrumentTypes, instrument.Type) && instrument.LotSize >= 0
+}
+
+// IsWholeLots checks the quantity is a positive number of whole lots of the instrument
+func (instrument Instrument) IsWholeLots(quantity float64) bool {
+	if quantity <= 0 {
+		return false
+	}
+	if instrument.LotSize <= 0 {
+		return true
+	}
+	lots := quantity / instrument.LotSize
+	return math.Abs(lots-math.Round(lots)) < 1e-9
+}
+
+type InstrumentFilter struct {
+	Type  string `form:"type"`
+	Query string `form:"query"`
+}
+
+func NewInstrument(ticker string, name string, instrumentType string, currency string) Instrument {
+	return Instrument{
+		Ticker:   ticker,
+		Name:     name,
+		Type:     instrumentType,
+		Currency: currency,
+		LotSize:  1,
+	}
+}
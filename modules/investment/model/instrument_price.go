@@ -0,0 +1,61 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+// InstrumentPrice is a closing price of one unit of the instrument in its currency
+type InstrumentPrice struct {
+	ID           uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	InstrumentID uuid.UUID  `json:"instrumentId,omitempty" gorm:"type:uuid;uniqueIndex:idx_instrument_price_date"`
+	Date         *time.Time `json:"date,omitempty" gorm:"uniqueIndex:idx_instrument_price_date"`
+	Price        float64    `json:"price"`
+	commonModel.Versioned
+}
+
+func (price InstrumentPrice) GetID() uuid.UUID {
+	return price.ID
+}
+
+func (price *InstrumentPrice) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(price.ID) {
+		price.ID = uuid.New()
+	}
+	return nil
+}
+
+func NewInstrumentPrice(instrumentId uuid.UUID, date time.Time, price float64) InstrumentPrice {
+	return InstrumentPrice{
+		InstrumentID: instrumentId,
+		Date:         &date,
+		Price:        price,
+	}
+}
@@ -0,0 +1,144 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonFc6f221DecodeAssetsModulesInvestmentModel(in *jlexer.Lexer, out *InstrumentPrice) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "instrumentId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.InstrumentID).UnmarshalText(data))
+			}
+		case "date":
+			if in.IsNull() {
+				in.Skip()
+				out.Date = nil
+			} else {
+				if out.Date == nil {
+					out.Date = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.Date).UnmarshalJSON(data))
+				}
+			}
+		case "price":
+			out.Price = float64(in.Float64())
+		case "version":
+			out.Version = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonFc6f221EncodeAssetsModulesInvestmentModel(out *jwriter.Writer, in InstrumentPrice) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"instrumentId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.InstrumentID).MarshalText())
+	}
+	if in.Date != nil {
+		const prefix string = ",\"date\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.Date).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"price\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.Price))
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v InstrumentPrice) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonFc6f221EncodeAssetsModulesInvestmentModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v InstrumentPrice) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonFc6f221EncodeAssetsModulesInvestmentModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *InstrumentPrice) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonFc6f221DecodeAssetsModulesInvestmentModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *InstrumentPrice) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonFc6f221DecodeAssetsModulesInvestmentModel(l, v)
+}
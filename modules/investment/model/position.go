@@ -0,0 +1,96 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"math"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const quantityPrecision = 1e-9
+
+// Lot is the rest of the bought units which are not sold yet
+type Lot struct {
+	TransactionID uuid.UUID  `json:"transactionId,omitempty"`
+	Date          *time.Time `json:"date,omitempty"`
+	Quantity      float64    `json:"quantity"`
+	UnitCost      float64    `json:"unitCost"`
+}
+
+type Position struct {
+	HoldingID      uuid.UUID   `json:"holdingId,omitempty"`
+	AssetID        uuid.UUID   `json:"assetId,omitempty"`
+	Instrument     *Instrument `json:"instrument,omitempty"`
+	CostMethod     string      `json:"costMethod,omitempty"`
+	Date           *time.Time  `json:"date,omitempty"`
+	Quantity       float64     `json:"quantity"`
+	CostBasis      float64     `json:"costBasis"`
+	AverageCost    float64     `json:"averageCost"`
+	RealizedGain   float64     `json:"realizedGain"`
+	Income         float64     `json:"income"`
+	Price          *float64    `json:"price,omitempty"`
+	PriceDate      *time.Time  `json:"priceDate,omitempty"`
+	MarketValue    *float64    `json:"marketValue,omitempty"`
+	UnrealizedGain *float64    `json:"unrealizedGain,omitempty"`
+	Lots           []*Lot      `json:"lots,omitempty"`
+}
+
+// ApplyPrice values the held units at the unit price known at the price date
+func (position *Position) ApplyPrice(price float64, priceDate *time.Time) {
+	marketValue := position.Quantity * price
+	unrealizedGain := marketValue - position.CostBasis
+	position.Price, position.PriceDate = &price, priceDate
+	position.MarketValue, position.UnrealizedGain = &marketValue, &unrealizedGain
+}
+
+// sell removes the quantity from the position and returns the cost of the sold units
+func (position *Position) sell(quantity float64, average bool) float64 {
+	var soldCost float64
+	if average {
+		soldCost = position.CostBasis * quantity / position.Quantity
+	}
+
+	rest := quantity
+	for len(position.Lots) != 0 && rest > quantityPrecision {
+		lot := position.Lots[0]
+		taken := math.Min(lot.Quantity, rest)
+		if !average {
+			soldCost += taken * lot.UnitCost
+		}
+		lot.Quantity -= taken
+		rest -= taken
+		if lot.Quantity <= quantityPrecision {
+			position.Lots = position.Lots[1:]
+		}
+	}
+
+	position.Quantity -= quantity
+	position.CostBasis -= soldCost
+	if position.Quantity <= quantityPrecision {
+		position.Quantity, position.CostBasis = 0, 0
+	}
+	return soldCost
+}
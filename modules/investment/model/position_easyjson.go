@@ -0,0 +1,418 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonE0c3566dDecodeAssetsModulesInvestmentModel(in *jlexer.Lexer, out *Position) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "holdingId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.HoldingID).UnmarshalText(data))
+			}
+		case "assetId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AssetID).UnmarshalText(data))
+			}
+		case "instrument":
+			if in.IsNull() {
+				in.Skip()
+				out.Instrument = nil
+			} else {
+				if out.Instrument == nil {
+					out.Instrument = new(Instrument)
+				}
+				(*out.Instrument).UnmarshalEasyJSON(in)
+			}
+		case "costMethod":
+			out.CostMethod = string(in.String())
+		case "date":
+			if in.IsNull() {
+				in.Skip()
+				out.Date = nil
+			} else {
+				if out.Date == nil {
+					out.Date = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.Date).UnmarshalJSON(data))
+				}
+			}
+		case "quantity":
+			out.Quantity = float64(in.Float64())
+		case "costBasis":
+			out.CostBasis = float64(in.Float64())
+		case "averageCost":
+			out.AverageCost = float64(in.Float64())
+		case "realizedGain":
+			out.RealizedGain = float64(in.Float64())
+		case "income":
+			out.Income = float64(in.Float64())
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+				out.Price = nil
+			} else {
+				if out.Price == nil {
+					out.Price = new(float64)
+				}
+				*out.Price = float64(in.Float64())
+			}
+		case "priceDate":
+			if in.IsNull() {
+				in.Skip()
+				out.PriceDate = nil
+			} else {
+				if out.PriceDate == nil {
+					out.PriceDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PriceDate).UnmarshalJSON(data))
+				}
+			}
+		case "marketValue":
+			if in.IsNull() {
+				in.Skip()
+				out.MarketValue = nil
+			} else {
+				if out.MarketValue == nil {
+					out.MarketValue = new(float64)
+				}
+				*out.MarketValue = float64(in.Float64())
+			}
+		case "unrealizedGain":
+			if in.IsNull() {
+				in.Skip()
+				out.UnrealizedGain = nil
+			} else {
+				if out.UnrealizedGain == nil {
+					out.UnrealizedGain = new(float64)
+				}
+				*out.UnrealizedGain = float64(in.Float64())
+			}
+		case "lots":
+			if in.IsNull() {
+				in.Skip()
+				out.Lots = nil
+			} else {
+				in.Delim('[')
+				if out.Lots == nil {
+					if !in.IsDelim(']') {
+						out.Lots = make([]*Lot, 0, 8)
+					} else {
+						out.Lots = []*Lot{}
+					}
+				} else {
+					out.Lots = (out.Lots)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 *Lot
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(Lot)
+						}
+						(*v1).UnmarshalEasyJSON(in)
+					}
+					out.Lots = append(out.Lots, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE0c3566dEncodeAssetsModulesInvestmentModel(out *jwriter.Writer, in Position) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"holdingId\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.HoldingID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"assetId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.AssetID).MarshalText())
+	}
+	if in.Instrument != nil {
+		const prefix string = ",\"instrument\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Instrument).MarshalEasyJSON(out)
+	}
+	if in.CostMethod != "" {
+		const prefix string = ",\"costMethod\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.CostMethod))
+	}
+	if in.Date != nil {
+		const prefix string = ",\"date\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.Date).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"quantity\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.Quantity))
+	}
+	{
+		const prefix string = ",\"costBasis\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.CostBasis))
+	}
+	{
+		const prefix string = ",\"averageCost\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.AverageCost))
+	}
+	{
+		const prefix string = ",\"realizedGain\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.RealizedGain))
+	}
+	{
+		const prefix string = ",\"income\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Income))
+	}
+	if in.Price != nil {
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Price))
+	}
+	if in.PriceDate != nil {
+		const prefix string = ",\"priceDate\":"
+		out.RawString(prefix)
+		out.Raw((*in.PriceDate).MarshalJSON())
+	}
+	if in.MarketValue != nil {
+		const prefix string = ",\"marketValue\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.MarketValue))
+	}
+	if in.UnrealizedGain != nil {
+		const prefix string = ",\"unrealizedGain\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.UnrealizedGain))
+	}
+	if len(in.Lots) != 0 {
+		const prefix string = ",\"lots\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Lots {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				if v3 == nil {
+					out.RawString("null")
+				} else {
+					(*v3).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Position) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE0c3566dEncodeAssetsModulesInvestmentModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Position) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE0c3566dEncodeAssetsModulesInvestmentModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Position) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE0c3566dDecodeAssetsModulesInvestmentModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Position) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE0c3566dDecodeAssetsModulesInvestmentModel(l, v)
+}
+func easyjsonE0c3566dDecodeAssetsModulesInvestmentModel1(in *jlexer.Lexer, out *Lot) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "transactionId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.TransactionID).UnmarshalText(data))
+			}
+		case "date":
+			if in.IsNull() {
+				in.Skip()
+				out.Date = nil
+			} else {
+				if out.Date == nil {
+					out.Date = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.Date).UnmarshalJSON(data))
+				}
+			}
+		case "quantity":
+			out.Quantity = float64(in.Float64())
+		case "unitCost":
+			out.UnitCost = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE0c3566dEncodeAssetsModulesInvestmentModel1(out *jwriter.Writer, in Lot) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"transactionId\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.TransactionID).MarshalText())
+	}
+	if in.Date != nil {
+		const prefix string = ",\"date\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.Date).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"quantity\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.Quantity))
+	}
+	{
+		const prefix string = ",\"unitCost\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.UnitCost))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Lot) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE0c3566dEncodeAssetsModulesInvestmentModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Lot) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE0c3566dEncodeAssetsModulesInvestmentModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Lot) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE0c3566dDecodeAssetsModulesInvestmentModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Lot) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE0c3566dDecodeAssetsModulesInvestmentModel1(l, v)
+}
@@ -0,0 +1,112 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonDB "assets/common/db"
+	commonModel "assets/common/model"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	assetRepository "assets/modules/asset/repository"
+	"assets/modules/investment/model"
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var holdingRepo HoldingRepository
+
+type HoldingRepository interface {
+	commonRepository.Repository[model.Holding]
+	FindAllWithPage(ctx context.Context, filter model.HoldingFilter, page commonModel.Pageable) commonModel.Page[model.Holding]
+	FindAll(ctx context.Context, filter model.HoldingFilter) []model.Holding
+	FindByAssetIds(ctx context.Context, assetIds []uuid.UUID) []model.Holding
+	DeleteWithTransactions(ctx context.Context, id uuid.UUID)
+}
+
+type holdingRepository struct {
+	commonRepository.Repository[model.Holding]
+	*commonDB.DataSource
+}
+
+func GetHoldingRepository() HoldingRepository {
+	if holdingRepo != nil {
+		return holdingRepo
+	}
+	holdingRepo = &holdingRepository{
+		commonRepository.NewBaseRepository[model.Holding](
+			commonDB.GetDataSource(),
+			commonRepository.WithReadScope[model.Holding](assetRepository.AssetIdScope("asset_id", false)),
+			commonRepository.WithWriteScope[model.Holding](assetRepository.AssetIdScope("asset_id", true)),
+		),
+		commonDB.GetDataSource(),
+	}
+	return holdingRepo
+}
+
+func (repo *holdingRepository) FindAllWithPage(ctx context.Context, filter model.HoldingFilter, page commonModel.Pageable) commonModel.Page[model.Holding] {
+	query := repo.filter(ctx, filter)
+
+	var total int64
+	commonUtil.Must(query.Count(&total).Error)
+
+	var result []model.Holding
+	query = query.Preload(clause.Associations).Limit(page.Size).Offset(page.Page * page.Size)
+	query = commonRepository.OrderBy[model.Holding](query, page.Sort, "")
+	commonUtil.Must(query.Find(&result).Error)
+	return commonModel.NewPage[model.Holding](result, page).WithTotal(int(total))
+}
+
+func (repo *holdingRepository) FindAll(ctx context.Context, filter model.HoldingFilter) []model.Holding {
+	var result []model.Holding
+	commonUtil.Must(repo.filter(ctx, filter).Preload(clause.Associations).Find(&result).Error)
+	return result
+}
+
+func (repo *holdingRepository) FindByAssetIds(ctx context.Context, assetIds []uuid.UUID) []model.Holding {
+	var result []model.Holding
+	if len(assetIds) == 0 {
+		return result
+	}
+	commonUtil.Must(repo.DataSource.Preload(clause.Associations).Where("asset_id in ?", assetIds).Find(&result).Error)
+	return result
+}
+
+func (repo *holdingRepository) DeleteWithTransactions(ctx context.Context, id uuid.UUID) {
+	repo.Repository.DeleteById(ctx, id)
+	commonUtil.Must(repo.DataSource.Where("holding_id = ?", id).Delete(&model.HoldingTransaction{}).Error)
+}
+
+func (repo *holdingRepository) filter(ctx context.Context, filter model.HoldingFilter) *gorm.DB {
+	query := assetRepository.AssetIdScope("asset_id", false)(ctx, repo.DataSource.Model(&model.Holding{}))
+	if filter.AssetID != "" {
+		query = query.Where("asset_id = ?", uuid.MustParse(filter.AssetID))
+	}
+	if filter.InstrumentID != "" {
+		query = query.Where("instrument_id = ?", uuid.MustParse(filter.InstrumentID))
+	}
+	return query
+}
@@ -0,0 +1,71 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonDB "assets/common/db"
+	commonRepository "assets/common/repository"
+	assetRepository "assets/modules/asset/repository"
+	"assets/modules/investment/model"
+	"context"
+	"gorm.io/gorm"
+)
+
+var holdingTransactionRepo HoldingTransactionRepository
+
+type HoldingTransactionRepository interface {
+	commonRepository.Repository[model.HoldingTransaction]
+}
+
+type holdingTransactionRepository struct {
+	commonRepository.Repository[model.HoldingTransaction]
+	*commonDB.DataSource
+}
+
+func GetHoldingTransactionRepository() HoldingTransactionRepository {
+	if holdingTransactionRepo != nil {
+		return holdingTransactionRepo
+	}
+	holdingTransactionRepo = &holdingTransactionRepository{
+		commonRepository.NewBaseRepository[model.HoldingTransaction](
+			commonDB.GetDataSource(),
+			commonRepository.WithReadScope[model.HoldingTransaction](holdingScope(false)),
+			commonRepository.WithWriteScope[model.HoldingTransaction](holdingScope(true)),
+		),
+		commonDB.GetDataSource(),
+	}
+	return holdingTransactionRepo
+}
+
+// holdingScope restricts transactions to holdings of the assets available to the current user
+func holdingScope(write bool) commonRepository.Scope {
+	return func(ctx context.Context, db *gorm.DB) *gorm.DB {
+		if _, restricted := assetRepository.GetRestrictedUserId(ctx); !restricted {
+			return db
+		}
+		holdingIds := db.Session(&gorm.Session{NewDB: true}).Model(&model.Holding{}).Select("id")
+		return db.Where("holding_id in (?)", assetRepository.AssetIdScope("asset_id", write)(ctx, holdingIds))
+	}
+}
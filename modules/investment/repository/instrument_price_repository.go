@@ -0,0 +1,109 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonDB "assets/common/db"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/investment/model"
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+var instrumentPriceRepo InstrumentPriceRepository
+
+type InstrumentPriceRepository interface {
+	commonRepository.Repository[model.InstrumentPrice]
+	FindByInstrumentId(ctx context.Context, instrumentId uuid.UUID, from *time.Time, to *time.Time) []model.InstrumentPrice
+	FindLatest(ctx context.Context, instrumentIds []uuid.UUID, date time.Time) map[uuid.UUID]model.InstrumentPrice
+	Upsert(ctx context.Context, prices []model.InstrumentPrice)
+	DeleteByInstrumentId(ctx context.Context, instrumentId uuid.UUID)
+}
+
+type instrumentPriceRepository struct {
+	commonRepository.Repository[model.InstrumentPrice]
+	*commonDB.DataSource
+}
+
+func GetInstrumentPriceRepository() InstrumentPriceRepository {
+	if instrumentPriceRepo != nil {
+		return instrumentPriceRepo
+	}
+	instrumentPriceRepo = &instrumentPriceRepository{
+		commonRepository.NewBaseRepository[model.InstrumentPrice](commonDB.GetDataSource()),
+		commonDB.GetDataSource(),
+	}
+	return instrumentPriceRepo
+}
+
+func (repo *instrumentPriceRepository) FindByInstrumentId(ctx context.Context, instrumentId uuid.UUID, from *time.Time, to *time.Time) []model.InstrumentPrice {
+	query := repo.DataSource.Where("instrument_id = ?", instrumentId)
+	if from != nil {
+		query = query.Where("date >= ?", *from)
+	}
+	if to != nil {
+		query = query.Where("date < ?", *to)
+	}
+
+	var result []model.InstrumentPrice
+	commonUtil.Must(query.Order("date").Find(&result).Error)
+	return result
+}
+
+// FindLatest returns the last price known at the date for every instrument having one
+func (repo *instrumentPriceRepository) FindLatest(ctx context.Context, instrumentIds []uuid.UUID, date time.Time) map[uuid.UUID]model.InstrumentPrice {
+	result := make(map[uuid.UUID]model.InstrumentPrice)
+	if len(instrumentIds) == 0 {
+		return result
+	}
+
+	var prices []model.InstrumentPrice
+	commonUtil.Must(repo.DataSource.Raw(
+		"select distinct on (instrument_id) * from instrument_prices where instrument_id in ? and date <= ? order by instrument_id, date desc",
+		instrumentIds, date,
+	).Scan(&prices).Error)
+	for _, price := range prices {
+		result[price.InstrumentID] = price
+	}
+	return result
+}
+
+// Upsert creates the prices and replaces the existing ones at the same dates
+func (repo *instrumentPriceRepository) Upsert(ctx context.Context, prices []model.InstrumentPrice) {
+	if len(prices) == 0 {
+		return
+	}
+	commonUtil.Must(repo.DataSource.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "instrument_id"}, {Name: "date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"price"}),
+	}).Create(&prices).Error)
+}
+
+func (repo *instrumentPriceRepository) DeleteByInstrumentId(ctx context.Context, instrumentId uuid.UUID) {
+	commonUtil.Must(repo.DataSource.Where("instrument_id = ?", instrumentId).Delete(&model.InstrumentPrice{}).Error)
+}
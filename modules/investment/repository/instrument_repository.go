@@ -0,0 +1,86 @@
+package repository
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonDB "assets/common/db"
+	commonModel "assets/common/model"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/investment/model"
+	"context"
+	"github.com/google/uuid"
+)
+
+var instrumentRepo InstrumentRepository
+
+type InstrumentRepository interface {
+	commonRepository.Repository[model.Instrument]
+	FindAllWithPage(ctx context.Context, filter model.InstrumentFilter, page commonModel.Pageable) commonModel.Page[model.Instrument]
+	IsUsed(ctx context.Context, id uuid.UUID) bool
+}
+
+type instrumentRepository struct {
+	commonRepository.Repository[model.Instrument]
+	*commonDB.DataSource
+}
+
+func GetInstrumentRepository() InstrumentRepository {
+	if instrumentRepo != nil {
+		return instrumentRepo
+	}
+	instrumentRepo = &instrumentRepository{
+		commonRepository.NewBaseRepository[model.Instrument](commonDB.GetDataSource()),
+		commonDB.GetDataSource(),
+	}
+	return instrumentRepo
+}
+
+func (repo *instrumentRepository) FindAllWithPage(ctx context.Context, filter model.InstrumentFilter, page commonModel.Pageable) commonModel.Page[model.Instrument] {
+	query := repo.DataSource.Model(&model.Instrument{})
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+	if filter.Query != "" {
+		pattern := "%" + filter.Query + "%"
+		query = query.Where("ticker ilike ? or isin ilike ? or name ilike ?", pattern, pattern, pattern)
+	}
+
+	var total int64
+	commonUtil.Must(query.Count(&total).Error)
+
+	var result []model.Instrument
+	query = query.Limit(page.Size).Offset(page.Page * page.Size)
+	query = commonRepository.OrderBy[model.Instrument](query, page.Sort, "ticker")
+	commonUtil.Must(query.Find(&result).Error)
+	return commonModel.NewPage[model.Instrument](result, page).WithTotal(int(total))
+}
+
+// IsUsed checks whether any holding of any user refers to the instrument
+func (repo *instrumentRepository) IsUsed(ctx context.Context, id uuid.UUID) bool {
+	var count int64
+	commonUtil.Must(repo.DataSource.Model(&model.Holding{}).Where("instrument_id = ?", id).Count(&count).Error)
+	return count != 0
+}
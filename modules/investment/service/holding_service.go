@@ -0,0 +1,267 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonCache "assets/common/cache"
+	commonError "assets/common/custom_error"
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	assetModel "assets/modules/asset/model"
+	assetService "assets/modules/asset/service"
+	"assets/modules/investment/model"
+	"assets/modules/investment/repository"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+var incomeDescriptions = map[string]string{
+	model.DividendTransaction: "Dividend",
+	model.CouponTransaction:   "Coupon",
+}
+
+var holdingSrv HoldingService
+
+type HoldingService interface {
+	GetById(ctx context.Context, id uuid.UUID) model.Holding
+	GetAll(ctx context.Context, filter model.HoldingFilter, page commonModel.Pageable) commonModel.Page[model.Holding]
+	Create(ctx context.Context, holding model.Holding) model.Holding
+	Update(ctx context.Context, holding model.Holding) model.Holding
+	DeleteById(ctx context.Context, id uuid.UUID)
+
+	AddTransaction(ctx context.Context, id uuid.UUID, transaction model.HoldingTransaction) model.Holding
+	DeleteTransaction(ctx context.Context, id uuid.UUID, transactionId uuid.UUID) model.Holding
+	GetPosition(ctx context.Context, id uuid.UUID, date time.Time) model.Position
+	GetPositions(ctx context.Context, filter model.HoldingFilter, date time.Time) []model.Position
+	GetMarketValues(ctx context.Context, assetIds []uuid.UUID, date time.Time) map[uuid.UUID][]*assetModel.MarketValue
+}
+
+type holdingService struct {
+	repository            repository.HoldingRepository
+	transactionRepository repository.HoldingTransactionRepository
+	priceRepository       repository.InstrumentPriceRepository
+	instrumentService     InstrumentService
+	assetService          assetService.AssetService
+	cache                 *commonCache.Cache[commonModel.Page[model.Holding]]
+}
+
+func GetHoldingService() HoldingService {
+	if holdingSrv != nil {
+		return holdingSrv
+	}
+
+	holdingSrv = &holdingService{
+		repository:            repository.GetHoldingRepository(),
+		transactionRepository: repository.GetHoldingTransactionRepository(),
+		priceRepository:       repository.GetInstrumentPriceRepository(),
+		instrumentService:     GetInstrumentService(),
+		assetService:          assetService.GetAssetService(),
+		cache:                 commonCache.NewCache[commonModel.Page[model.Holding]]("holdings", 24*time.Hour),
+	}
+	assetService.GetAnalyticsService().RegisterValuationSource(holdingSrv)
+	assetService.GetTimelineService().RegisterSource(&holdingTimelineSource{repository: repository.GetHoldingRepository()})
+
+	return holdingSrv
+}
+
+func (service *holdingService) GetById(ctx context.Context, id uuid.UUID) model.Holding {
+	result := service.repository.GetById(ctx, []uuid.UUID{id})
+	if len(result) == 0 {
+		panic(commonError.NotFoundError)
+	}
+	return result[0]
+}
+
+func (service *holdingService) GetAll(ctx context.Context, filter model.HoldingFilter, page commonModel.Pageable) commonModel.Page[model.Holding] {
+	result, err := service.cache.Get(ctx)
+	if err == nil {
+		return result
+	}
+	return service.cache.Set(ctx, service.repository.FindAllWithPage(ctx, filter, page))
+}
+
+// Create opens an empty holding, the units are added by the transactions
+func (service *holdingService) Create(ctx context.Context, holding model.Holding) model.Holding {
+	holding.CostMethod = strings.ToUpper(holding.CostMethod)
+	if holding.CostMethod != "" && !commonUtil.ArrayContains(model.CostMethods, holding.CostMethod) {
+		panic(commonError.IllegalArgumentError)
+	}
+	service.instrumentService.GetById(ctx, holding.InstrumentID)
+	service.assetService.CheckWritable(ctx, holding.AssetID)
+
+	holding.Instrument, holding.Transactions = nil, nil
+	defer service.cache.Evict(ctx)
+	holding = service.repository.Create(ctx, []model.Holding{holding})[0]
+	return service.GetById(ctx, holding.ID)
+}
+
+// Update changes the cost method only, the asset and the instrument of the holding are kept
+func (service *holdingService) Update(ctx context.Context, holding model.Holding) model.Holding {
+	costMethod := strings.ToUpper(holding.CostMethod)
+	if !commonUtil.ArrayContains(model.CostMethods, costMethod) {
+		panic(commonError.IllegalArgumentError)
+	}
+
+	stored := service.GetById(ctx, holding.ID)
+	stored.CostMethod, stored.Instrument, stored.Transactions = costMethod, nil, nil
+	defer service.cache.Evict(ctx)
+	service.repository.Update(ctx, []model.Holding{stored})
+	return service.GetById(ctx, holding.ID)
+}
+
+// DeleteById deletes the holding with its transactions and the incomes registered by them
+func (service *holdingService) DeleteById(ctx context.Context, id uuid.UUID) {
+	holding := service.GetById(ctx, id)
+	service.assetService.CheckWritable(ctx, holding.AssetID)
+
+	defer service.cache.Evict(ctx)
+	for _, transaction := range holding.Transactions {
+		if transaction.CashFlowID != nil {
+			service.assetService.RemoveIncome(ctx, holding.AssetID, *transaction.CashFlowID)
+		}
+	}
+	service.repository.DeleteWithTransactions(ctx, id)
+}
+
+// AddTransaction adds the trade or the income to the holding. Trades must keep the held quantity
+// non-negative through the whole history, incomes are registered as incomes of the holding's asset
+func (service *holdingService) AddTransaction(ctx context.Context, id uuid.UUID, transaction model.HoldingTransaction) model.Holding {
+	holding := service.GetById(ctx, id)
+	service.assetService.CheckWritable(ctx, holding.AssetID)
+	if holding.Instrument == nil {
+		panic(commonError.NotFoundError)
+	}
+
+	transaction.ID, transaction.HoldingID, transaction.CashFlowID = uuid.Nil, id, nil
+	transaction.Type = strings.ToUpper(transaction.Type)
+	if !transaction.IsValid() || (transaction.IsTrade() && !holding.Instrument.IsWholeLots(transaction.Quantity)) {
+		panic(commonError.IllegalArgumentError)
+	}
+
+	if transaction.IsTrade() {
+		holding.Transactions = append(holding.Transactions, &transaction)
+		checkHistory(holding)
+	}
+
+	defer service.cache.Evict(ctx)
+	if transaction.IsIncome() {
+		cashFlow := service.assetService.AddIncome(ctx, holding.AssetID, assetModel.CashFlow{
+			Type:        transaction.Type,
+			Date:        transaction.Date,
+			Amount:      transaction.Amount - transaction.Fee,
+			Currency:    holding.Instrument.Currency,
+			Description: fmt.Sprintf("%s: %s", incomeDescriptions[transaction.Type], getInstrumentName(*holding.Instrument)),
+		})
+		transaction.CashFlowID = &cashFlow.ID
+	}
+	service.transactionRepository.Create(ctx, []model.HoldingTransaction{transaction})
+	return service.GetById(ctx, id)
+}
+
+func (service *holdingService) DeleteTransaction(ctx context.Context, id uuid.UUID, transactionId uuid.UUID) model.Holding {
+	holding := service.GetById(ctx, id)
+	service.assetService.CheckWritable(ctx, holding.AssetID)
+
+	exists, transaction := commonUtil.ArrayFindFirst(holding.Transactions, func(it *model.HoldingTransaction) bool { return it.ID == transactionId })
+	if !exists {
+		panic(commonError.NotFoundError)
+	}
+	if transaction.IsTrade() {
+		holding.Transactions = commonUtil.ArrayFilter(holding.Transactions, func(it *model.HoldingTransaction) bool { return it.ID != transactionId })
+		checkHistory(holding)
+	}
+
+	defer service.cache.Evict(ctx)
+	if transaction.CashFlowID != nil {
+		service.assetService.RemoveIncome(ctx, holding.AssetID, *transaction.CashFlowID)
+	}
+	service.transactionRepository.DeleteById(ctx, transactionId)
+	return service.GetById(ctx, id)
+}
+
+func (service *holdingService) GetPosition(ctx context.Context, id uuid.UUID, date time.Time) model.Position {
+	return service.value(ctx, []model.Holding{service.GetById(ctx, id)}, date)[0]
+}
+
+func (service *holdingService) GetPositions(ctx context.Context, filter model.HoldingFilter, date time.Time) []model.Position {
+	return service.value(ctx, service.repository.FindAll(ctx, filter), date)
+}
+
+// GetMarketValues values the holdings of the assets for the portfolio views of the asset analytics
+func (service *holdingService) GetMarketValues(ctx context.Context, assetIds []uuid.UUID, date time.Time) map[uuid.UUID][]*assetModel.MarketValue {
+	result := make(map[uuid.UUID][]*assetModel.MarketValue)
+	for _, position := range service.value(ctx, service.repository.FindByAssetIds(ctx, assetIds), date) {
+		if position.MarketValue == nil || position.Quantity == 0 || position.Instrument == nil {
+			continue
+		}
+		result[position.AssetID] = append(result[position.AssetID], assetModel.NewMarketValue(position.Instrument.Currency, *position.MarketValue))
+	}
+	return result
+}
+
+// value computes positions of the holdings at the date with the last known prices. Deposits
+// without quoted prices are valued at their cost
+func (service *holdingService) value(ctx context.Context, holdings []model.Holding, date time.Time) []model.Position {
+	instrumentIds := commonUtil.Unique(commonUtil.Map(holdings, func(it model.Holding) uuid.UUID { return it.InstrumentID }))
+	prices := service.priceRepository.FindLatest(ctx, instrumentIds, date)
+
+	result := make([]model.Position, 0, len(holdings))
+	for _, holding := range holdings {
+		position, err := holding.PositionAt(date)
+		if err != nil {
+			panic(commonError.InsufficientQuantityError)
+		}
+		if price, ok := prices[holding.InstrumentID]; ok {
+			position.ApplyPrice(price.Price, price.Date)
+		} else if holding.Instrument != nil && holding.Instrument.Type == model.DepositInstrument {
+			position.ApplyPrice(position.AverageCost, nil)
+		}
+		result = append(result, position)
+	}
+	return result
+}
+
+// checkHistory replays all the transactions of the holding to make sure no sale exceeds the held quantity
+func checkHistory(holding model.Holding) {
+	var lastDate time.Time
+	for _, transaction := range holding.Transactions {
+		if transaction.Date != nil && transaction.Date.After(lastDate) {
+			lastDate = *transaction.Date
+		}
+	}
+	if _, err := holding.PositionAt(lastDate); err != nil {
+		panic(commonError.InsufficientQuantityError)
+	}
+}
+
+func getInstrumentName(instrument model.Instrument) string {
+	if instrument.Ticker != "" {
+		return instrument.Ticker
+	}
+	return instrument.Isin
+}
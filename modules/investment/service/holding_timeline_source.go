@@ -0,0 +1,61 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	assetModel "assets/modules/asset/model"
+	"assets/modules/investment/repository"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+)
+
+// holdingTimelineSource puts the trades of the asset holdings on the asset timeline as they change its valuation,
+// incomes paid on the holdings are already there as the asset cash flows
+type holdingTimelineSource struct {
+	repository repository.HoldingRepository
+}
+
+func (source *holdingTimelineSource) Type() string {
+	return assetModel.ValuationTimelineEvent
+}
+
+func (source *holdingTimelineSource) Collect(ctx context.Context, asset assetModel.Asset) []assetModel.TimelineEvent {
+	var result []assetModel.TimelineEvent
+	for _, holding := range source.repository.FindByAssetIds(ctx, []uuid.UUID{asset.ID}) {
+		instrument := ""
+		if holding.Instrument != nil {
+			instrument = holding.Instrument.Ticker
+		}
+		for _, transaction := range holding.Transactions {
+			if !transaction.IsTrade() || transaction.Date == nil {
+				continue
+			}
+			summary := fmt.Sprintf("%s %v %s at %v", transaction.Type, transaction.Quantity, instrument, transaction.Price)
+			result = append(result, assetModel.NewTimelineEvent(assetModel.ValuationTimelineEvent, *transaction.Date, transaction.ID, summary, transaction))
+		}
+	}
+	return result
+}
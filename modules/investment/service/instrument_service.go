@@ -0,0 +1,152 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	commonCache "assets/common/cache"
+	commonError "assets/common/custom_error"
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"assets/modules/investment/model"
+	"assets/modules/investment/repository"
+	"context"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"mime/multipart"
+	"strings"
+	"time"
+)
+
+var instrumentSrv InstrumentService
+
+type InstrumentService interface {
+	GetById(ctx context.Context, id uuid.UUID) model.Instrument
+	GetAll(ctx context.Context, filter model.InstrumentFilter, page commonModel.Pageable) commonModel.Page[model.Instrument]
+	Create(ctx context.Context, instrument model.Instrument) model.Instrument
+	Update(ctx context.Context, instrument model.Instrument) model.Instrument
+	DeleteById(ctx context.Context, id uuid.UUID)
+
+	GetPrices(ctx context.Context, id uuid.UUID, from *time.Time, to *time.Time) []model.InstrumentPrice
+	ImportPrices(ctx context.Context, id uuid.UUID, fileHeader *multipart.FileHeader) []model.InstrumentPrice
+	DeletePrices(ctx context.Context, id uuid.UUID)
+}
+
+type instrumentService struct {
+	repository      repository.InstrumentRepository
+	priceRepository repository.InstrumentPriceRepository
+	cache           *commonCache.Cache[commonModel.Page[model.Instrument]]
+}
+
+func GetInstrumentService() InstrumentService {
+	if instrumentSrv != nil {
+		return instrumentSrv
+	}
+
+	instrumentSrv = &instrumentService{
+		repository:      repository.GetInstrumentRepository(),
+		priceRepository: repository.GetInstrumentPriceRepository(),
+		cache:           commonCache.NewCache[commonModel.Page[model.Instrument]]("instruments", 24*time.Hour),
+	}
+
+	return instrumentSrv
+}
+
+func (service *instrumentService) GetById(ctx context.Context, id uuid.UUID) model.Instrument {
+	result := service.repository.GetById(ctx, []uuid.UUID{id})
+	if len(result) == 0 {
+		panic(commonError.NotFoundError)
+	}
+	return result[0]
+}
+
+func (service *instrumentService) GetAll(ctx context.Context, filter model.InstrumentFilter, page commonModel.Pageable) commonModel.Page[model.Instrument] {
+	result, err := service.cache.Get(ctx)
+	if err == nil {
+		return result
+	}
+	return service.cache.Set(ctx, service.repository.FindAllWithPage(ctx, filter, page))
+}
+
+func (service *instrumentService) Create(ctx context.Context, instrument model.Instrument) model.Instrument {
+	instrument = normalizeInstrument(instrument)
+	if !instrument.IsValid() {
+		panic(commonError.IllegalArgumentError)
+	}
+	defer service.cache.Evict(ctx)
+	return service.repository.Create(ctx, []model.Instrument{instrument})[0]
+}
+
+func (service *instrumentService) Update(ctx context.Context, instrument model.Instrument) model.Instrument {
+	instrument = normalizeInstrument(instrument)
+	if !instrument.IsValid() {
+		panic(commonError.IllegalArgumentError)
+	}
+	service.GetById(ctx, instrument.ID)
+	defer service.cache.Evict(ctx)
+	return service.repository.Update(ctx, []model.Instrument{instrument})[0]
+}
+
+func (service *instrumentService) DeleteById(ctx context.Context, id uuid.UUID) {
+	if service.repository.IsUsed(ctx, id) {
+		panic(commonError.InstrumentInUseError)
+	}
+	defer service.cache.Evict(ctx)
+	service.repository.DeleteById(ctx, id)
+	service.priceRepository.DeleteByInstrumentId(ctx, id)
+}
+
+func (service *instrumentService) GetPrices(ctx context.Context, id uuid.UUID, from *time.Time, to *time.Time) []model.InstrumentPrice {
+	service.GetById(ctx, id)
+	return service.priceRepository.FindByInstrumentId(ctx, id, from, to)
+}
+
+// ImportPrices stores the price history from the CSV file replacing prices at the same dates
+func (service *instrumentService) ImportPrices(ctx context.Context, id uuid.UUID, fileHeader *multipart.FileHeader) []model.InstrumentPrice {
+	service.GetById(ctx, id)
+	records, err := parsePrices(commonUtil.GetData(fileHeader))
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Warnf("InstrumentService: couldn't parse price history %s", fileHeader.Filename)
+		panic(commonError.InvalidPriceFileError)
+	}
+
+	service.priceRepository.Upsert(ctx, commonUtil.Map(records, func(record priceRecord) model.InstrumentPrice {
+		return model.NewInstrumentPrice(id, record.date, record.price)
+	}))
+	log.WithContext(ctx).Infof("InstrumentService: %d prices imported", len(records))
+	return service.priceRepository.FindByInstrumentId(ctx, id, nil, nil)
+}
+
+func (service *instrumentService) DeletePrices(ctx context.Context, id uuid.UUID) {
+	service.GetById(ctx, id)
+	service.priceRepository.DeleteByInstrumentId(ctx, id)
+}
+
+func normalizeInstrument(instrument model.Instrument) model.Instrument {
+	instrument.Ticker = strings.ToUpper(strings.TrimSpace(instrument.Ticker))
+	instrument.Isin = strings.ToUpper(strings.TrimSpace(instrument.Isin))
+	instrument.Currency = strings.ToUpper(instrument.Currency)
+	instrument.Type = strings.ToUpper(instrument.Type)
+	return instrument
+}
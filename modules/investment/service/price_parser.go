@@ -0,0 +1,148 @@
+package service
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type priceRecord struct {
+	date  time.Time
+	price float64
+}
+
+var priceColumns = map[string][]string{
+	"date":  {"date", "tradedate", "trade date", "дата", "дата торгов"},
+	"price": {"price", "close", "legalcloseprice", "цена", "цена закрытия", "закрытие"},
+}
+
+var priceDateLayouts = []string{time.DateOnly, "02.01.2006", "02/01/2006", time.RFC3339}
+
+// parsePrices reads a CSV price history with a header row, the date and price columns are recognized
+// by the common names including the ones of the exchange exports
+func parsePrices(data []byte) ([]priceRecord, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = detectPriceDelimiter(data)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) < 2 {
+		return nil, errors.New("price history is empty")
+	}
+
+	columns := mapPriceColumns(records[0])
+	dateColumn, ok := columns["date"]
+	if !ok {
+		return nil, errors.New("date column not found")
+	}
+	priceColumn, ok := columns["price"]
+	if !ok {
+		return nil, errors.New("price column not found")
+	}
+
+	var result []priceRecord
+	for i, record := range records[1:] {
+		if strings.Join(record, "") == "" {
+			continue
+		}
+		if dateColumn >= len(record) || priceColumn >= len(record) {
+			return nil, fmt.Errorf("line %d: not enough columns", i+2)
+		}
+		// days without trades are exported with an empty price
+		if strings.TrimSpace(record[priceColumn]) == "" {
+			continue
+		}
+
+		date, err := parsePriceDate(record[dateColumn])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+2, err)
+		}
+		price, err := parsePrice(record[priceColumn])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+2, err)
+		}
+		result = append(result, priceRecord{date: date, price: price})
+	}
+	return result, nil
+}
+
+func detectPriceDelimiter(data []byte) rune {
+	header, _, _ := bytes.Cut(data, []byte("\n"))
+	delimiter, maxCount := ',', 0
+	for _, candidate := range []rune{',', ';', '\t'} {
+		if count := bytes.Count(header, []byte(string(candidate))); count > maxCount {
+			delimiter, maxCount = candidate, count
+		}
+	}
+	return delimiter
+}
+
+func mapPriceColumns(header []string) map[string]int {
+	result := make(map[string]int)
+	for index, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		for column, aliases := range priceColumns {
+			if _, ok := result[column]; ok {
+				continue
+			}
+			for _, alias := range aliases {
+				if name == alias {
+					result[column] = index
+				}
+			}
+		}
+	}
+	return result
+}
+
+func parsePriceDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range priceDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("couldn't parse date %q", value)
+}
+
+func parsePrice(value string) (float64, error) {
+	value = strings.ReplaceAll(strings.NewReplacer(" ", "", " ", "").Replace(value), ",", ".")
+	result, err := strconv.ParseFloat(value, 64)
+	if err != nil || result < 0 {
+		return 0, fmt.Errorf("couldn't parse price %q", value)
+	}
+	return result, nil
+}
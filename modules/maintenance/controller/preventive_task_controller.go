@@ -0,0 +1,245 @@
+package controller
+
+import (
+	commonController "assets/common/controller"
+	commonMiddleware "assets/common/middleware"
+	commonResolver "assets/common/resolver"
+	commonUtil "assets/common/util"
+	"assets/modules/maintenance/model"
+	"assets/modules/maintenance/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var preventiveTaskCntr commonController.HttpController
+
+type preventiveTaskController struct {
+	service service.PreventiveTaskService
+}
+
+func GetPreventiveTaskController() commonController.HttpController {
+	if preventiveTaskCntr != nil {
+		return preventiveTaskCntr
+	}
+	preventiveTaskCntr = &preventiveTaskController{service: service.GetPreventiveTaskService()}
+	return preventiveTaskCntr
+}
+
+func (controller *preventiveTaskController) RegisterHttpController(router *gin.Engine) {
+	preventiveTaskRouter := router.Group("/api/maintenance/preventiveTasks", commonMiddleware.SecurityHandler)
+
+	preventiveTaskRouter.GET(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("READ_PREVENTIVE_TASK"),
+		controller.getById,
+	)
+
+	preventiveTaskRouter.GET(
+		"",
+		commonMiddleware.HasAnyAuthorities("READ_PREVENTIVE_TASK"),
+		commonMiddleware.PaginationHandler,
+		controller.getAll,
+	)
+
+	preventiveTaskRouter.POST(
+		"",
+		commonMiddleware.HasAnyAuthorities("CREATE_PREVENTIVE_TASK"),
+		commonResolver.Resolver[model.PreventiveTask],
+		controller.create,
+	)
+
+	preventiveTaskRouter.PUT(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("UPDATE_PREVENTIVE_TASK"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.UpdateResolver[model.PreventiveTask],
+		controller.update,
+	)
+
+	preventiveTaskRouter.PATCH(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("UPDATE_PREVENTIVE_TASK"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.PatchResolver(controller.service.GetById),
+		controller.update,
+	)
+
+	preventiveTaskRouter.DELETE(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("DELETE_PREVENTIVE_TASK"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		controller.deleteById,
+	)
+
+	preventiveTaskRouter.GET(
+		"/trash",
+		commonMiddleware.HasAnyAuthorities("READ_PREVENTIVE_TASK"),
+		commonMiddleware.PaginationHandler,
+		controller.getTrash,
+	)
+
+	preventiveTaskRouter.POST(
+		"/:id/restore",
+		commonMiddleware.HasAnyAuthorities("DELETE_PREVENTIVE_TASK"),
+		controller.restore,
+	)
+}
+
+// preventiveTaskController godoc
+// @Security BearerAuth
+// @Summary      getById
+// @Description  Get preventive task by id
+// @Tags         PreventiveTask controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "PreventiveTask.ID"
+// @Success      200	{object}  model.PreventiveTask
+// @Failure      400
+// @Failure      500
+// @Router       /api/maintenance/preventiveTasks/{id} [GET]
+func (controller *preventiveTaskController) getById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("PreventiveTaskController: GetById(id: %s): Start", id)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.GetById(ctx, id))
+	log.WithContext(ctx).Info("PreventiveTaskController: GetById(): End")
+}
+
+// preventiveTaskController godoc
+// @Security BearerAuth
+// @Summary      getAll
+// @Description  Get all preventive tasks
+// @Tags         PreventiveTask controller
+// @Accept       json
+// @Produce      json
+// @Success      200	{array}  model.PreventiveTask
+// @Failure      400
+// @Failure      500
+// @Router       /api/maintenance/preventiveTasks/ [GET]
+func (controller *preventiveTaskController) getAll(ctx *gin.Context) {
+	page := commonUtil.MustGetPageable(ctx)
+	log.WithContext(ctx).Info("PreventiveTaskController: GetAll(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetAll(ctx, page))
+	log.WithContext(ctx).Info("PreventiveTaskController: GetAll(): End")
+}
+
+// preventiveTaskController godoc
+// @Security BearerAuth
+// @Summary      create
+// @Description  Create preventive task
+// @Tags         PreventiveTask controller
+// @Accept       json
+// @Produce      json
+// @Param        preventiveTask	body	  model.PreventiveTask  true  "Create PreventiveTask"
+// @Success      201	{object}  model.PreventiveTask
+// @Failure      400
+// @Failure      500
+// @Router       /api/maintenance/preventiveTasks/ [POST]
+func (controller *preventiveTaskController) create(ctx *gin.Context) {
+	log.WithContext(ctx).Info("PreventiveTaskController: Create(): Start")
+	task := ctx.MustGet("RequestBody").(model.PreventiveTask)
+	commonUtil.AbortWithEntity(ctx, http.StatusCreated, controller.service.Create(ctx, task))
+	log.WithContext(ctx).Info("PreventiveTaskController: Create(): End")
+}
+
+// preventiveTaskController godoc
+// @Security BearerAuth
+// @Summary      update
+// @Description  Update preventive task
+// @Tags         PreventiveTask controller
+// @Accept       json
+// @Produce      json
+// @Param        preventiveTask	body	  model.PreventiveTask  true  "Update PreventiveTask"
+// @Success      200	{object}  model.PreventiveTask
+// @Failure      400
+// @Failure      500
+// @Router       /api/maintenance/preventiveTasks/{id} [PUT]
+// @Router       /api/maintenance/preventiveTasks/{id} [PATCH]
+func (controller *preventiveTaskController) update(ctx *gin.Context) {
+	log.WithContext(ctx).Info("PreventiveTaskController: Update(): Start")
+	task := ctx.MustGet("RequestBody").(model.PreventiveTask)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Update(ctx, task))
+	log.WithContext(ctx).Info("PreventiveTaskController: Update(): End")
+}
+
+// preventiveTaskController godoc
+// @Security BearerAuth
+// @Summary      deleteById
+// @Description  Move preventive task to the trash
+// @Tags         PreventiveTask controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "PreventiveTask.ID"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/maintenance/preventiveTasks/{id} [DELETE]
+func (controller *preventiveTaskController) deleteById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("PreventiveTaskController: DeleteById(id: %s): Start", id)
+	controller.service.DeleteById(ctx, id)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("PreventiveTaskController: DeleteById(): End")
+}
+
+// preventiveTaskController godoc
+// @Security BearerAuth
+// @Summary      getTrash
+// @Description  Get preventive tasks in the trash available to current user
+// @Tags         PreventiveTask controller
+// @Accept       json
+// @Produce      json
+// @Success      200	{array}  model.PreventiveTask
+// @Failure      400
+// @Failure      500
+// @Router       /api/maintenance/preventiveTasks/trash [GET]
+func (controller *preventiveTaskController) getTrash(ctx *gin.Context) {
+	page := commonUtil.MustGetPageable(ctx)
+	log.WithContext(ctx).Info("PreventiveTaskController: GetTrash(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetTrash(ctx, page))
+	log.WithContext(ctx).Info("PreventiveTaskController: GetTrash(): End")
+}
+
+// preventiveTaskController godoc
+// @Security BearerAuth
+// @Summary      restore
+// @Description  Restore preventive task from the trash
+// @Tags         PreventiveTask controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "PreventiveTask.ID"
+// @Success      200	{object}  model.PreventiveTask
+// @Failure      400
+// @Failure      500
+// @Router       /api/maintenance/preventiveTasks/{id}/restore [POST]
+func (controller *preventiveTaskController) restore(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("PreventiveTaskController: Restore(id: %s): Start", id)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Restore(ctx, id))
+	log.WithContext(ctx).Info("PreventiveTaskController: Restore(): End")
+}
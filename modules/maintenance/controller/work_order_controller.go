@@ -0,0 +1,333 @@
+package controller
+
+import (
+	commonController "assets/common/controller"
+	commonMiddleware "assets/common/middleware"
+	commonResolver "assets/common/resolver"
+	commonUtil "assets/common/util"
+	"assets/modules/maintenance/model"
+	"assets/modules/maintenance/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var workOrderCntr commonController.HttpController
+
+type workOrderController struct {
+	service service.WorkOrderService
+}
+
+func GetWorkOrderController() commonController.HttpController {
+	if workOrderCntr != nil {
+		return workOrderCntr
+	}
+	workOrderCntr = &workOrderController{service: service.GetWorkOrderService()}
+	return workOrderCntr
+}
+
+func (controller *workOrderController) RegisterHttpController(router *gin.Engine) {
+	workOrderRouter := router.Group("/api/maintenance/workOrders", commonMiddleware.SecurityHandler)
+
+	workOrderRouter.GET(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("READ_WORK_ORDER"),
+		controller.getById,
+	)
+
+	workOrderRouter.GET(
+		"",
+		commonMiddleware.HasAnyAuthorities("READ_WORK_ORDER"),
+		commonMiddleware.PaginationHandler,
+		commonMiddleware.FilterHandler[model.WorkOrderFilter],
+		controller.getAll,
+	)
+
+	workOrderRouter.POST(
+		"",
+		commonMiddleware.HasAnyAuthorities("CREATE_WORK_ORDER"),
+		commonResolver.Resolver[model.WorkOrder],
+		controller.create,
+	)
+
+	workOrderRouter.PUT(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("UPDATE_WORK_ORDER"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.UpdateResolver[model.WorkOrder],
+		controller.update,
+	)
+
+	workOrderRouter.PATCH(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("UPDATE_WORK_ORDER"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.PatchResolver(controller.service.GetById),
+		controller.update,
+	)
+
+	workOrderRouter.DELETE(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("DELETE_WORK_ORDER"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		controller.deleteById,
+	)
+
+	workOrderRouter.GET(
+		"/trash",
+		commonMiddleware.HasAnyAuthorities("READ_WORK_ORDER"),
+		commonMiddleware.PaginationHandler,
+		controller.getTrash,
+	)
+
+	workOrderRouter.POST(
+		"/:id/restore",
+		commonMiddleware.HasAnyAuthorities("DELETE_WORK_ORDER"),
+		controller.restore,
+	)
+
+	workOrderRouter.PUT(
+		"/:id/status",
+		commonMiddleware.HasAnyAuthorities("UPDATE_WORK_ORDER"),
+		controller.changeStatus,
+	)
+
+	workOrderRouter.PUT(
+		"/:id/attachments",
+		commonMiddleware.HasAnyAuthorities("UPDATE_WORK_ORDER"),
+		controller.addAttachments,
+	)
+
+	workOrderRouter.DELETE(
+		"/:id/attachments",
+		commonMiddleware.HasAnyAuthorities("UPDATE_WORK_ORDER"),
+		controller.removeAttachments,
+	)
+}
+
+// workOrderController godoc
+// @Security BearerAuth
+// @Summary      getById
+// @Description  Get work order by id
+// @Tags         WorkOrder controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "WorkOrder.ID"
+// @Success      200	{object}  model.WorkOrder
+// @Failure      400
+// @Failure      500
+// @Router       /api/maintenance/workOrders/{id} [GET]
+func (controller *workOrderController) getById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("WorkOrderController: GetById(id: %s): Start", id)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.GetById(ctx, id))
+	log.WithContext(ctx).Info("WorkOrderController: GetById(): End")
+}
+
+// workOrderController godoc
+// @Security BearerAuth
+// @Summary      getAll
+// @Description  Get all work orders
+// @Tags         WorkOrder controller
+// @Accept       json
+// @Produce      json
+// @Param        assetId	query	string  false  "WorkOrder.AssetID"
+// @Param        status		query	string  false  "WorkOrder.Status"
+// @Success      200	{array}  model.WorkOrder
+// @Failure      400
+// @Failure      500
+// @Router       /api/maintenance/workOrders/ [GET]
+func (controller *workOrderController) getAll(ctx *gin.Context) {
+	page := commonUtil.MustGetPageable(ctx)
+	filter := commonUtil.MustGetFilterObject[model.WorkOrderFilter](ctx)
+	log.WithContext(ctx).Info("WorkOrderController: GetAll(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetAll(ctx, filter, page))
+	log.WithContext(ctx).Info("WorkOrderController: GetAll(): End")
+}
+
+// workOrderController godoc
+// @Security BearerAuth
+// @Summary      create
+// @Description  Create work order
+// @Tags         WorkOrder controller
+// @Accept       json
+// @Produce      json
+// @Param        workOrder	body	  model.WorkOrder  true  "Create WorkOrder"
+// @Success      201	{object}  model.WorkOrder
+// @Failure      400
+// @Failure      500
+// @Router       /api/maintenance/workOrders/ [POST]
+func (controller *workOrderController) create(ctx *gin.Context) {
+	log.WithContext(ctx).Info("WorkOrderController: Create(): Start")
+	workOrder := ctx.MustGet("RequestBody").(model.WorkOrder)
+	commonUtil.AbortWithEntity(ctx, http.StatusCreated, controller.service.Create(ctx, workOrder))
+	log.WithContext(ctx).Info("WorkOrderController: Create(): End")
+}
+
+// workOrderController godoc
+// @Security BearerAuth
+// @Summary      update
+// @Description  Update work order
+// @Tags         WorkOrder controller
+// @Accept       json
+// @Produce      json
+// @Param        workOrder	body	  model.WorkOrder  true  "Update WorkOrder"
+// @Success      200	{object}  model.WorkOrder
+// @Failure      400
+// @Failure      500
+// @Router       /api/maintenance/workOrders/{id} [PUT]
+// @Router       /api/maintenance/workOrders/{id} [PATCH]
+func (controller *workOrderController) update(ctx *gin.Context) {
+	log.WithContext(ctx).Info("WorkOrderController: Update(): Start")
+	workOrder := ctx.MustGet("RequestBody").(model.WorkOrder)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Update(ctx, workOrder))
+	log.WithContext(ctx).Info("WorkOrderController: Update(): End")
+}
+
+// workOrderController godoc
+// @Security BearerAuth
+// @Summary      deleteById
+// @Description  Move work order to the trash
+// @Tags         WorkOrder controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "WorkOrder.ID"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/maintenance/workOrders/{id} [DELETE]
+func (controller *workOrderController) deleteById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("WorkOrderController: DeleteById(id: %s): Start", id)
+	controller.service.DeleteById(ctx, id)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("WorkOrderController: DeleteById(): End")
+}
+
+// workOrderController godoc
+// @Security BearerAuth
+// @Summary      getTrash
+// @Description  Get work orders in the trash available to current user
+// @Tags         WorkOrder controller
+// @Accept       json
+// @Produce      json
+// @Success      200	{array}  model.WorkOrder
+// @Failure      400
+// @Failure      500
+// @Router       /api/maintenance/workOrders/trash [GET]
+func (controller *workOrderController) getTrash(ctx *gin.Context) {
+	page := commonUtil.MustGetPageable(ctx)
+	log.WithContext(ctx).Info("WorkOrderController: GetTrash(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetTrash(ctx, page))
+	log.WithContext(ctx).Info("WorkOrderController: GetTrash(): End")
+}
+
+// workOrderController godoc
+// @Security BearerAuth
+// @Summary      restore
+// @Description  Restore work order from the trash
+// @Tags         WorkOrder controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "WorkOrder.ID"
+// @Success      200	{object}  model.WorkOrder
+// @Failure      400
+// @Failure      500
+// @Router       /api/maintenance/workOrders/{id}/restore [POST]
+func (controller *workOrderController) restore(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("WorkOrderController: Restore(id: %s): Start", id)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Restore(ctx, id))
+	log.WithContext(ctx).Info("WorkOrderController: Restore(): End")
+}
+
+// workOrderController godoc
+// @Security BearerAuth
+// @Summary      changeStatus
+// @Description  Change work order status, completed work order is registered as asset expense
+// @Tags         WorkOrder controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "WorkOrder.ID"
+// @Param        status	query	string  true  "enum(NEW, IN_PROGRESS, ON_HOLD, DONE, CANCELLED)"
+// @Success      200	{object}  model.WorkOrder
+// @Failure      400
+// @Failure      409
+// @Failure      500
+// @Router       /api/maintenance/workOrders/{id}/status [PUT]
+func (controller *workOrderController) changeStatus(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	status := ctx.Query("status")
+	log.WithContext(ctx).Infof("WorkOrderController: ChangeStatus(id: %s, status: %s): Start", id, status)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.ChangeStatus(ctx, id, status))
+	log.WithContext(ctx).Info("WorkOrderController: ChangeStatus(): End")
+}
+
+// workOrderController godoc
+// @Security BearerAuth
+// @Summary      addAttachments
+// @Description  Add photos, invoices and other attachments to work order by id
+// @Tags         WorkOrder controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "WorkOrder.ID"
+// @Param        attachmentsIds	query	string  true  "WorkOrder.Attachments"
+// @Success      200	{object}  model.WorkOrder
+// @Failure      400
+// @Failure      500
+// @Router       /api/maintenance/workOrders/{id}/attachments [PUT]
+func (controller *workOrderController) addAttachments(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	attachmentsIds, _ := ctx.GetQueryArray("attachmentsIds")
+	attachmentUuids := commonUtil.Map(attachmentsIds, func(it string) uuid.UUID { return uuid.MustParse(it) })
+	log.WithContext(ctx).Infof("WorkOrderController: AddAttachments(id: %s, attachmentsIds: %s): Start", id, attachmentUuids)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.AddAttachments(ctx, id, attachmentUuids))
+	log.WithContext(ctx).Info("WorkOrderController: AddAttachments(): End")
+}
+
+// workOrderController godoc
+// @Security BearerAuth
+// @Summary      removeAttachments
+// @Description  Remove attachments from work order by id
+// @Tags         WorkOrder controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "WorkOrder.ID"
+// @Param        attachmentsIds	query	string  true  "WorkOrder.Attachments"
+// @Success      200	{object}  model.WorkOrder
+// @Failure      400
+// @Failure      500
+// @Router       /api/maintenance/workOrders/{id}/attachments [DELETE]
+func (controller *workOrderController) removeAttachments(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	attachmentsIds, _ := ctx.GetQueryArray("attachmentsIds")
+	attachmentUuids := commonUtil.Map(attachmentsIds, func(it string) uuid.UUID { return uuid.MustParse(it) })
+	log.WithContext(ctx).Infof("WorkOrderController: RemoveAttachments(id: %s, attachmentsIds: %s): Start", id, attachmentUuids)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.RemoveAttachments(ctx, id, attachmentUuids))
+	log.WithContext(ctx).Info("WorkOrderController: RemoveAttachments(): End")
+}
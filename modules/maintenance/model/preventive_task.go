@@ -0,0 +1,79 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var NilPreventiveTask = PreventiveTask{}
+
+type PreventiveTask struct {
+	ID             uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	AssetID        uuid.UUID  `json:"assetId,omitempty" gorm:"type:uuid;index"`
+	Title          string     `json:"title,omitempty"`
+	Description    string     `json:"description,omitempty"`
+	Priority       string     `json:"priority,omitempty"`
+	Contractor     string     `json:"contractor,omitempty"`
+	CostEstimate   float64    `json:"costEstimate,omitempty"`
+	Currency       string     `json:"currency,omitempty"`
+	IntervalMonths int        `json:"intervalMonths,omitempty"`
+	IntervalDays   int        `json:"intervalDays,omitempty"`
+	LeadDays       int        `json:"leadDays,omitempty"`
+	NextDueDate    *time.Time `json:"nextDueDate,omitempty"`
+	IsActive       bool       `json:"isActive,omitempty"`
+	commonModel.Versioned
+	commonModel.SoftDeletable
+}
+
+func (task PreventiveTask) GetID() uuid.UUID {
+	return task.ID
+}
+
+func (task *PreventiveTask) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(task.ID) {
+		task.ID = uuid.New()
+	}
+	if task.Priority == "" {
+		task.Priority = MediumPriority
+	}
+	return nil
+}
+
+func (task PreventiveTask) IsValid() bool {
+	return task.Title != "" && task.NextDueDate != nil && task.LeadDays >= 0 &&
+		task.IntervalMonths >= 0 && task.IntervalDays >= 0 && task.IntervalMonths+task.IntervalDays > 0 &&
+		(task.Priority == "" || IsValidPriority(task.Priority))
+}
+
+// Advance moves the next due date to the following occurrence
+func (task *PreventiveTask) Advance() {
+	nextDueDate := task.NextDueDate.AddDate(0, task.IntervalMonths, task.IntervalDays)
+	task.NextDueDate = &nextDueDate
+}
@@ -0,0 +1,294 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	uuid "github.com/google/uuid"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonFff62012DecodeAssetsModulesMaintenanceModel(in *jlexer.Lexer, out *PreventiveTask) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "assetId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AssetID).UnmarshalText(data))
+			}
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "priority":
+			out.Priority = string(in.String())
+		case "contractor":
+			out.Contractor = string(in.String())
+		case "costEstimate":
+			out.CostEstimate = float64(in.Float64())
+		case "currency":
+			out.Currency = string(in.String())
+		case "intervalMonths":
+			out.IntervalMonths = int(in.Int())
+		case "intervalDays":
+			out.IntervalDays = int(in.Int())
+		case "leadDays":
+			out.LeadDays = int(in.Int())
+		case "nextDueDate":
+			if in.IsNull() {
+				in.Skip()
+				out.NextDueDate = nil
+			} else {
+				if out.NextDueDate == nil {
+					out.NextDueDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.NextDueDate).UnmarshalJSON(data))
+				}
+			}
+		case "isActive":
+			out.IsActive = bool(in.Bool())
+		case "deletedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DeletedAt).UnmarshalJSON(data))
+			}
+		case "deletedBy":
+			if in.IsNull() {
+				in.Skip()
+				out.DeletedBy = nil
+			} else {
+				if out.DeletedBy == nil {
+					out.DeletedBy = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.DeletedBy).UnmarshalText(data))
+				}
+			}
+		case "version":
+			out.Version = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonFff62012EncodeAssetsModulesMaintenanceModel(out *jwriter.Writer, in PreventiveTask) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"assetId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.AssetID).MarshalText())
+	}
+	if in.Title != "" {
+		const prefix string = ",\"title\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Title))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Description))
+	}
+	if in.Priority != "" {
+		const prefix string = ",\"priority\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Priority))
+	}
+	if in.Contractor != "" {
+		const prefix string = ",\"contractor\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Contractor))
+	}
+	if in.CostEstimate != 0 {
+		const prefix string = ",\"costEstimate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.CostEstimate))
+	}
+	if in.Currency != "" {
+		const prefix string = ",\"currency\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Currency))
+	}
+	if in.IntervalMonths != 0 {
+		const prefix string = ",\"intervalMonths\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.IntervalMonths))
+	}
+	if in.IntervalDays != 0 {
+		const prefix string = ",\"intervalDays\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.IntervalDays))
+	}
+	if in.LeadDays != 0 {
+		const prefix string = ",\"leadDays\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.LeadDays))
+	}
+	if in.NextDueDate != nil {
+		const prefix string = ",\"nextDueDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.NextDueDate).MarshalJSON())
+	}
+	if in.IsActive {
+		const prefix string = ",\"isActive\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.IsActive))
+	}
+	if true {
+		const prefix string = ",\"deletedAt\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.DeletedAt).MarshalJSON())
+	}
+	if in.DeletedBy != nil {
+		const prefix string = ",\"deletedBy\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((*in.DeletedBy).MarshalText())
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PreventiveTask) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonFff62012EncodeAssetsModulesMaintenanceModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PreventiveTask) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonFff62012EncodeAssetsModulesMaintenanceModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PreventiveTask) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonFff62012DecodeAssetsModulesMaintenanceModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PreventiveTask) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonFff62012DecodeAssetsModulesMaintenanceModel(l, v)
+}
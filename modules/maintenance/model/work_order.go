@@ -0,0 +1,160 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	attachmentModel "assets/modules/attachment/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const (
+	LowPriority      = "LOW"
+	MediumPriority   = "MEDIUM"
+	HighPriority     = "HIGH"
+	CriticalPriority = "CRITICAL"
+)
+
+const (
+	NewStatus        = "NEW"
+	InProgressStatus = "IN_PROGRESS"
+	OnHoldStatus     = "ON_HOLD"
+	DoneStatus       = "DONE"
+	CancelledStatus  = "CANCELLED"
+)
+
+var statusTransitions = map[string][]string{
+	NewStatus:        {InProgressStatus, OnHoldStatus, DoneStatus, CancelledStatus},
+	InProgressStatus: {OnHoldStatus, DoneStatus, CancelledStatus},
+	OnHoldStatus:     {InProgressStatus, CancelledStatus},
+	DoneStatus:       {},
+	CancelledStatus:  {},
+}
+
+var NilWorkOrder = WorkOrder{}
+
+type WorkOrder struct {
+	ID               uuid.UUID                     `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	AssetID          uuid.UUID                     `json:"assetId,omitempty" gorm:"type:uuid;index"`
+	PreventiveTaskID *uuid.UUID                    `json:"preventiveTaskId,omitempty" gorm:"type:uuid;index"`
+	Title            string                        `json:"title,omitempty"`
+	Description      string                        `json:"description,omitempty"`
+	Priority         string                        `json:"priority,omitempty"`
+	Status           string                        `json:"status,omitempty"`
+	Contractor       string                        `json:"contractor,omitempty"`
+	CreateDate       *time.Time                    `json:"createDate,omitempty"`
+	DueDate          *time.Time                    `json:"dueDate,omitempty"`
+	CompleteDate     *time.Time                    `json:"completeDate,omitempty"`
+	CostEstimate     float64                       `json:"costEstimate,omitempty"`
+	ActualCost       float64                       `json:"actualCost,omitempty"`
+	Currency         string                        `json:"currency,omitempty"`
+	ExpenseID        *uuid.UUID                    `json:"expenseId,omitempty" gorm:"type:uuid"`
+	Attachments      []*attachmentModel.Attachment `json:"attachments,omitempty" gorm:"many2many:work_order_attachment;"`
+	CreatedBy        uuid.UUID                     `json:"createdBy,omitempty" gorm:"type:uuid"`
+	commonModel.Versioned
+	commonModel.SoftDeletable
+}
+
+func (workOrder WorkOrder) GetID() uuid.UUID {
+	return workOrder.ID
+}
+
+func (workOrder *WorkOrder) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(workOrder.ID) {
+		workOrder.ID = uuid.New()
+	}
+	if workOrder.CreateDate == nil {
+		now := time.Now()
+		workOrder.CreateDate = &now
+	}
+	if workOrder.Status == "" {
+		workOrder.Status = NewStatus
+	}
+	if workOrder.Priority == "" {
+		workOrder.Priority = MediumPriority
+	}
+	return nil
+}
+
+func (workOrder WorkOrder) CanTransitTo(status string) bool {
+	if workOrder.Status == status {
+		return true
+	}
+	return commonUtil.ArrayContains(statusTransitions[workOrder.Status], status)
+}
+
+func (workOrder WorkOrder) IsOverdue(date time.Time) bool {
+	return workOrder.DueDate != nil && workOrder.DueDate.Before(date) &&
+		workOrder.Status != DoneStatus && workOrder.Status != CancelledStatus
+}
+
+// GetCost returns the actual cost of the work if it is known, otherwise the estimated one
+func (workOrder WorkOrder) GetCost() float64 {
+	if workOrder.ActualCost != 0 {
+		return workOrder.ActualCost
+	}
+	return workOrder.CostEstimate
+}
+
+func IsValidPriority(priority string) bool {
+	return commonUtil.ArrayContains([]string{LowPriority, MediumPriority, HighPriority, CriticalPriority}, priority)
+}
+
+type WorkOrderFilter struct {
+	AssetID  string   `form:"assetId"`
+	Statuses []string `form:"status"`
+}
+
+func NewWorkOrder(assetId uuid.UUID, title string, opts ...WorkOrderOption) WorkOrder {
+	workOrder := WorkOrder{
+		AssetID:  assetId,
+		Title:    title,
+		Priority: MediumPriority,
+		Status:   NewStatus,
+	}
+
+	for _, opt := range opts {
+		opt(&workOrder)
+	}
+
+	return workOrder
+}
+
+type WorkOrderOption func(*WorkOrder)
+
+func WorkOrderFromPreventiveTask(task PreventiveTask) WorkOrderOption {
+	return func(workOrder *WorkOrder) {
+		workOrder.PreventiveTaskID = &task.ID
+		workOrder.Description = task.Description
+		workOrder.Priority = task.Priority
+		workOrder.Contractor = task.Contractor
+		workOrder.DueDate = task.NextDueDate
+		workOrder.CostEstimate = task.CostEstimate
+		workOrder.Currency = task.Currency
+	}
+}
@@ -0,0 +1,532 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	model "assets/modules/attachment/model"
+	json "encoding/json"
+	uuid "github.com/google/uuid"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson203c9f72DecodeAssetsModulesMaintenanceModel(in *jlexer.Lexer, out *WorkOrderFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "AssetID":
+			out.AssetID = string(in.String())
+		case "Statuses":
+			if in.IsNull() {
+				in.Skip()
+				out.Statuses = nil
+			} else {
+				in.Delim('[')
+				if out.Statuses == nil {
+					if !in.IsDelim(']') {
+						out.Statuses = make([]string, 0, 4)
+					} else {
+						out.Statuses = []string{}
+					}
+				} else {
+					out.Statuses = (out.Statuses)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Statuses = append(out.Statuses, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson203c9f72EncodeAssetsModulesMaintenanceModel(out *jwriter.Writer, in WorkOrderFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"AssetID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AssetID))
+	}
+	{
+		const prefix string = ",\"Statuses\":"
+		out.RawString(prefix)
+		if in.Statuses == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Statuses {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WorkOrderFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson203c9f72EncodeAssetsModulesMaintenanceModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WorkOrderFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson203c9f72EncodeAssetsModulesMaintenanceModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WorkOrderFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson203c9f72DecodeAssetsModulesMaintenanceModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WorkOrderFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson203c9f72DecodeAssetsModulesMaintenanceModel(l, v)
+}
+func easyjson203c9f72DecodeAssetsModulesMaintenanceModel1(in *jlexer.Lexer, out *WorkOrder) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "assetId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AssetID).UnmarshalText(data))
+			}
+		case "preventiveTaskId":
+			if in.IsNull() {
+				in.Skip()
+				out.PreventiveTaskID = nil
+			} else {
+				if out.PreventiveTaskID == nil {
+					out.PreventiveTaskID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.PreventiveTaskID).UnmarshalText(data))
+				}
+			}
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "priority":
+			out.Priority = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "contractor":
+			out.Contractor = string(in.String())
+		case "createDate":
+			if in.IsNull() {
+				in.Skip()
+				out.CreateDate = nil
+			} else {
+				if out.CreateDate == nil {
+					out.CreateDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreateDate).UnmarshalJSON(data))
+				}
+			}
+		case "dueDate":
+			if in.IsNull() {
+				in.Skip()
+				out.DueDate = nil
+			} else {
+				if out.DueDate == nil {
+					out.DueDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DueDate).UnmarshalJSON(data))
+				}
+			}
+		case "completeDate":
+			if in.IsNull() {
+				in.Skip()
+				out.CompleteDate = nil
+			} else {
+				if out.CompleteDate == nil {
+					out.CompleteDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CompleteDate).UnmarshalJSON(data))
+				}
+			}
+		case "costEstimate":
+			out.CostEstimate = float64(in.Float64())
+		case "actualCost":
+			out.ActualCost = float64(in.Float64())
+		case "currency":
+			out.Currency = string(in.String())
+		case "expenseId":
+			if in.IsNull() {
+				in.Skip()
+				out.ExpenseID = nil
+			} else {
+				if out.ExpenseID == nil {
+					out.ExpenseID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.ExpenseID).UnmarshalText(data))
+				}
+			}
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]*model.Attachment, 0, 8)
+					} else {
+						out.Attachments = []*model.Attachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 *model.Attachment
+					if in.IsNull() {
+						in.Skip()
+						v4 = nil
+					} else {
+						if v4 == nil {
+							v4 = new(model.Attachment)
+						}
+						(*v4).UnmarshalEasyJSON(in)
+					}
+					out.Attachments = append(out.Attachments, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "createdBy":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.CreatedBy).UnmarshalText(data))
+			}
+		case "deletedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DeletedAt).UnmarshalJSON(data))
+			}
+		case "deletedBy":
+			if in.IsNull() {
+				in.Skip()
+				out.DeletedBy = nil
+			} else {
+				if out.DeletedBy == nil {
+					out.DeletedBy = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.DeletedBy).UnmarshalText(data))
+				}
+			}
+		case "version":
+			out.Version = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson203c9f72EncodeAssetsModulesMaintenanceModel1(out *jwriter.Writer, in WorkOrder) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"assetId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.AssetID).MarshalText())
+	}
+	if in.PreventiveTaskID != nil {
+		const prefix string = ",\"preventiveTaskId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((*in.PreventiveTaskID).MarshalText())
+	}
+	if in.Title != "" {
+		const prefix string = ",\"title\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Title))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Description))
+	}
+	if in.Priority != "" {
+		const prefix string = ",\"priority\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Priority))
+	}
+	if in.Status != "" {
+		const prefix string = ",\"status\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Status))
+	}
+	if in.Contractor != "" {
+		const prefix string = ",\"contractor\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Contractor))
+	}
+	if in.CreateDate != nil {
+		const prefix string = ",\"createDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.CreateDate).MarshalJSON())
+	}
+	if in.DueDate != nil {
+		const prefix string = ",\"dueDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.DueDate).MarshalJSON())
+	}
+	if in.CompleteDate != nil {
+		const prefix string = ",\"completeDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.CompleteDate).MarshalJSON())
+	}
+	if in.CostEstimate != 0 {
+		const prefix string = ",\"costEstimate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.CostEstimate))
+	}
+	if in.ActualCost != 0 {
+		const prefix string = ",\"actualCost\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.ActualCost))
+	}
+	if in.Currency != "" {
+		const prefix string = ",\"currency\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Currency))
+	}
+	if in.ExpenseID != nil {
+		const prefix string = ",\"expenseId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((*in.ExpenseID).MarshalText())
+	}
+	if len(in.Attachments) != 0 {
+		const prefix string = ",\"attachments\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.Attachments {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				if v6 == nil {
+					out.RawString("null")
+				} else {
+					(*v6).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if true {
+		const prefix string = ",\"createdBy\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.CreatedBy).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"deletedAt\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.DeletedAt).MarshalJSON())
+	}
+	if in.DeletedBy != nil {
+		const prefix string = ",\"deletedBy\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((*in.DeletedBy).MarshalText())
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WorkOrder) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson203c9f72EncodeAssetsModulesMaintenanceModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WorkOrder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson203c9f72EncodeAssetsModulesMaintenanceModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WorkOrder) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson203c9f72DecodeAssetsModulesMaintenanceModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WorkOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson203c9f72DecodeAssetsModulesMaintenanceModel1(l, v)
+}
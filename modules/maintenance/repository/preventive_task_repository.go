@@ -0,0 +1,80 @@
+package repository
+
+import (
+	commonDB "assets/common/db"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	assetRepository "assets/modules/asset/repository"
+	"assets/modules/maintenance/model"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var preventiveTaskRepo PreventiveTaskRepository
+
+type PreventiveTaskRepository interface {
+	commonRepository.SoftDeleteRepository[model.PreventiveTask]
+	FindDue(ctx context.Context, date time.Time) []model.PreventiveTask
+	PurgeByAssetId(ctx context.Context, assetId uuid.UUID)
+	InTransaction(ctx context.Context, fn func(ctx context.Context))
+}
+
+type preventiveTaskRepository struct {
+	commonRepository.SoftDeleteRepository[model.PreventiveTask]
+	*commonDB.DataSource
+}
+
+func GetPreventiveTaskRepository() PreventiveTaskRepository {
+	if preventiveTaskRepo != nil {
+		return preventiveTaskRepo
+	}
+	preventiveTaskRepo = &preventiveTaskRepository{
+		commonRepository.NewSoftDeleteRepository[model.PreventiveTask](
+			commonDB.GetDataSource(),
+			commonRepository.WithReadScope[model.PreventiveTask](assetRepository.AssetIdScope("asset_id", false)),
+			commonRepository.WithWriteScope[model.PreventiveTask](assetRepository.AssetIdScope("asset_id", true)),
+		),
+		commonDB.GetDataSource(),
+	}
+	return preventiveTaskRepo
+}
+
+// FindDue returns the active tasks which occurrence is coming, tasks of the assets in the trash are skipped
+func (repo *preventiveTaskRepository) FindDue(ctx context.Context, date time.Time) []model.PreventiveTask {
+	var result []model.PreventiveTask
+	commonUtil.Must(repo.DataSource.
+		Where("is_active and next_due_date - make_interval(days => lead_days) <= ?", date).
+		Where("asset_id in (select id from assets where deleted_at is null)").
+		Find(&result).Error)
+	return result
+}
+
+// PurgeByAssetId deletes for good the preventive tasks of the asset including the ones in the trash
+func (repo *preventiveTaskRepository) PurgeByAssetId(ctx context.Context, assetId uuid.UUID) {
+	commonUtil.Must(repo.DataSource.For(ctx).Unscoped().Where("asset_id = ?", assetId).Delete(&model.PreventiveTask{}).Error)
+}
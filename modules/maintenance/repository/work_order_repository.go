@@ -0,0 +1,124 @@
+package repository
+
+import (
+	commonDB "assets/common/db"
+	commonModel "assets/common/model"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	assetRepository "assets/modules/asset/repository"
+	attachmentModel "assets/modules/attachment/model"
+	"assets/modules/maintenance/model"
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var workOrderRepo WorkOrderRepository
+
+type WorkOrderRepository interface {
+	commonRepository.SoftDeleteRepository[model.WorkOrder]
+	FindAllWithPage(ctx context.Context, filter model.WorkOrderFilter, page commonModel.Pageable) commonModel.Page[model.WorkOrder]
+	FindByAssetId(ctx context.Context, assetId uuid.UUID) []model.WorkOrder
+	FindOverdue(ctx context.Context, date time.Time) []model.WorkOrder
+	RemoveAttachments(ctx context.Context, id uuid.UUID, attachments []attachmentModel.Attachment)
+	CountByAttachmentId(ctx context.Context, attachmentId uuid.UUID) int64
+	PurgeByAssetId(ctx context.Context, assetId uuid.UUID)
+	InTransaction(ctx context.Context, fn func(ctx context.Context))
+}
+
+type workOrderRepository struct {
+	commonRepository.SoftDeleteRepository[model.WorkOrder]
+	*commonDB.DataSource
+}
+
+func GetWorkOrderRepository() WorkOrderRepository {
+	if workOrderRepo != nil {
+		return workOrderRepo
+	}
+	workOrderRepo = &workOrderRepository{
+		commonRepository.NewSoftDeleteRepository[model.WorkOrder](
+			commonDB.GetDataSource(),
+			commonRepository.WithReadScope[model.WorkOrder](assetRepository.AssetIdScope("asset_id", false)),
+			commonRepository.WithWriteScope[model.WorkOrder](assetRepository.AssetIdScope("asset_id", true)),
+		),
+		commonDB.GetDataSource(),
+	}
+	return workOrderRepo
+}
+
+func (repo *workOrderRepository) FindAllWithPage(ctx context.Context, filter model.WorkOrderFilter, page commonModel.Pageable) commonModel.Page[model.WorkOrder] {
+	query := assetRepository.AssetIdScope("asset_id", false)(ctx, repo.DataSource.Model(&model.WorkOrder{}))
+	if filter.AssetID != "" {
+		query = query.Where("asset_id = ?", uuid.MustParse(filter.AssetID))
+	}
+	if len(filter.Statuses) != 0 {
+		query = query.Where("status in ?", filter.Statuses)
+	}
+
+	var total int64
+	commonUtil.Must(query.Count(&total).Error)
+
+	var result []model.WorkOrder
+	query = query.Preload(clause.Associations).Limit(page.Size).Offset(page.Page * page.Size)
+	query = commonRepository.OrderBy[model.WorkOrder](query, page.Sort, "due_date")
+	commonUtil.Must(query.Find(&result).Error)
+	return commonModel.NewPage[model.WorkOrder](result, page).WithTotal(int(total))
+}
+
+func (repo *workOrderRepository) FindByAssetId(ctx context.Context, assetId uuid.UUID) []model.WorkOrder {
+	var result []model.WorkOrder
+	commonUtil.Must(assetRepository.AssetIdScope("asset_id", false)(ctx, repo.DataSource.DB).
+		Where("asset_id = ?", assetId).
+		Order("create_date").
+		Find(&result).Error)
+	return result
+}
+
+func (repo *workOrderRepository) FindOverdue(ctx context.Context, date time.Time) []model.WorkOrder {
+	var result []model.WorkOrder
+	commonUtil.Must(assetRepository.AssetIdScope("asset_id", false)(ctx, repo.DataSource.DB).
+		Where("due_date < ? and status not in ?", date, []string{model.DoneStatus, model.CancelledStatus}).
+		Find(&result).Error)
+	return result
+}
+
+func (repo *workOrderRepository) RemoveAttachments(ctx context.Context, id uuid.UUID, attachments []attachmentModel.Attachment) {
+	commonUtil.Must(repo.DataSource.Model(&model.WorkOrder{ID: id}).Association("Attachments").Delete(&attachments))
+}
+
+// CountByAttachmentId counts the work orders the attachment is added to including the ones in the trash
+func (repo *workOrderRepository) CountByAttachmentId(ctx context.Context, attachmentId uuid.UUID) int64 {
+	var count int64
+	commonUtil.Must(repo.DataSource.Table("work_order_attachment").Where("attachment_id = ?", attachmentId).Count(&count).Error)
+	return count
+}
+
+// PurgeByAssetId deletes for good the work orders of the asset including the ones in the trash
+func (repo *workOrderRepository) PurgeByAssetId(ctx context.Context, assetId uuid.UUID) {
+	commonUtil.Must(repo.DataSource.For(ctx).Unscoped().Where("asset_id = ?", assetId).Delete(&model.WorkOrder{}).Error)
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	commonCache "assets/common/cache"
+	"assets/common/config"
+	commonError "assets/common/custom_error"
+	commonModel "assets/common/model"
+	"assets/common/scheduler"
+	commonUtil "assets/common/util"
+	assetModel "assets/modules/asset/model"
+	assetService "assets/modules/asset/service"
+	"assets/modules/maintenance/model"
+	"assets/modules/maintenance/repository"
+	"context"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var preventiveTaskSrv PreventiveTaskService
+
+type PreventiveTaskService interface {
+	GetById(ctx context.Context, id uuid.UUID) model.PreventiveTask
+	GetAll(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.PreventiveTask]
+	Create(ctx context.Context, task model.PreventiveTask) model.PreventiveTask
+	Update(ctx context.Context, task model.PreventiveTask) model.PreventiveTask
+	DeleteById(ctx context.Context, id uuid.UUID)
+	GetTrash(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.PreventiveTask]
+	Restore(ctx context.Context, id uuid.UUID) model.PreventiveTask
+	PurgeTrash(ctx context.Context)
+	CreateDueWorkOrders(ctx context.Context)
+}
+
+type preventiveTaskService struct {
+	repository       repository.PreventiveTaskRepository
+	workOrderService WorkOrderService
+	assetService     assetService.AssetService
+	cache            *commonCache.Cache[commonModel.Page[model.PreventiveTask]]
+}
+
+func GetPreventiveTaskService() PreventiveTaskService {
+	if preventiveTaskSrv != nil {
+		return preventiveTaskSrv
+	}
+
+	preventiveTaskSrv = (&preventiveTaskService{
+		repository:       repository.GetPreventiveTaskRepository(),
+		workOrderService: GetWorkOrderService(),
+		assetService:     assetService.GetAssetService(),
+		cache:            commonCache.NewCache[commonModel.Page[model.PreventiveTask]]("preventiveTasks", 24*time.Hour),
+	}).schedule()
+
+	return preventiveTaskSrv
+}
+
+func (service *preventiveTaskService) GetById(ctx context.Context, id uuid.UUID) model.PreventiveTask {
+	result := service.repository.GetById(ctx, []uuid.UUID{id})
+	if len(result) == 0 {
+		panic(commonError.NotFoundError)
+	}
+	return result[0]
+}
+
+func (service *preventiveTaskService) GetAll(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.PreventiveTask] {
+	result, err := service.cache.Get(ctx)
+	if err == nil {
+		return result
+	}
+	return service.cache.Set(ctx, service.repository.GetAllWithPage(ctx, page))
+}
+
+func (service *preventiveTaskService) Create(ctx context.Context, task model.PreventiveTask) model.PreventiveTask {
+	if !task.IsValid() {
+		panic(commonError.IllegalArgumentError)
+	}
+	service.assetService.CheckWritable(ctx, task.AssetID)
+	defer service.cache.Evict(ctx)
+	return service.repository.Create(ctx, []model.PreventiveTask{task})[0]
+}
+
+func (service *preventiveTaskService) Update(ctx context.Context, task model.PreventiveTask) model.PreventiveTask {
+	if !task.IsValid() {
+		panic(commonError.IllegalArgumentError)
+	}
+	task.AssetID = service.GetById(ctx, task.ID).AssetID
+	defer service.cache.Evict(ctx)
+	return service.repository.Update(ctx, []model.PreventiveTask{task})[0]
+}
+
+func (service *preventiveTaskService) DeleteById(ctx context.Context, id uuid.UUID) {
+	defer service.cache.Evict(ctx)
+	service.repository.DeleteById(ctx, id)
+}
+
+func (service *preventiveTaskService) GetTrash(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.PreventiveTask] {
+	return service.repository.FindDeleted(ctx, page)
+}
+
+func (service *preventiveTaskService) Restore(ctx context.Context, id uuid.UUID) model.PreventiveTask {
+	defer service.cache.Evict(ctx)
+	service.repository.Restore(ctx, id)
+	return service.GetById(ctx, id)
+}
+
+// PurgeTrash deletes for good the preventive tasks which are in the trash longer than the retention
+func (service *preventiveTaskService) PurgeTrash(ctx context.Context) {
+	tasks := service.repository.FindDeletedBefore(ctx, time.Now().Add(-config.CoreConfig.Database.GetTrashRetention()))
+	if len(tasks) == 0 {
+		return
+	}
+	service.repository.Purge(ctx, commonUtil.Map(tasks, model.PreventiveTask.GetID)...)
+	log.WithContext(ctx).Infof("PreventiveTaskService: %d preventive tasks purged from the trash", len(tasks))
+}
+
+// CreateDueWorkOrders creates work orders for the preventive tasks which occurrence is coming
+// and moves the tasks to the next occurrence. Occurrences missed completely are skipped.
+// A task failed is logged and tried again on the next check, the other tasks aren't affected
+func (service *preventiveTaskService) CreateDueWorkOrders(ctx context.Context) {
+	now := time.Now()
+	for _, task := range service.repository.FindDue(ctx, now) {
+		service.createWorkOrder(ctx, task, now)
+	}
+	service.cache.Evict(ctx)
+}
+
+func (service *preventiveTaskService) createWorkOrder(ctx context.Context, task model.PreventiveTask, now time.Time) {
+	defer commonUtil.DefaultRecovery(ctx)
+
+	service.repository.InTransaction(ctx, func(ctx context.Context) {
+		workOrder := service.workOrderService.Create(ctx, model.NewWorkOrder(task.AssetID, task.Title, model.WorkOrderFromPreventiveTask(task)))
+		log.WithContext(ctx).Infof("PreventiveTaskService: work order %s created for task %s", workOrder.ID, task.ID)
+
+		task.Advance()
+		for task.NextDueDate.Before(now) {
+			task.Advance()
+		}
+		service.repository.Update(ctx, []model.PreventiveTask{task})
+	})
+}
+
+// onAssetDeleted deletes the preventive tasks of the asset purged from the trash
+func (service *preventiveTaskService) onAssetDeleted(ctx context.Context, asset assetModel.Asset) {
+	defer service.cache.Evict(ctx)
+	service.repository.PurgeByAssetId(ctx, asset.ID)
+}
+
+func (service *preventiveTaskService) schedule() *preventiveTaskService {
+	interval := config.CoreConfig.Maintenance.PreventiveTaskCheckInterval
+	if interval == 0 {
+		interval = time.Hour
+	}
+	service.assetService.AddDeleteListener(service.onAssetDeleted)
+	scheduler.Schedule("preventiveTasks", interval, service.CreateDueWorkOrders)
+	scheduler.Schedule("preventiveTaskTrash", config.CoreConfig.Database.GetTrashPurgeInterval(), service.PurgeTrash)
+	return service
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	commonCache "assets/common/cache"
+	"assets/common/config"
+	commonError "assets/common/custom_error"
+	commonModel "assets/common/model"
+	"assets/common/scheduler"
+	commonUtil "assets/common/util"
+	assetModel "assets/modules/asset/model"
+	assetService "assets/modules/asset/service"
+	attachmentModel "assets/modules/attachment/model"
+	attachmentRepository "assets/modules/attachment/repository"
+	attachmentService "assets/modules/attachment/service"
+	"assets/modules/maintenance/model"
+	"assets/modules/maintenance/repository"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const maintenanceCashFlowType = "MAINTENANCE"
+
+var workOrderSrv WorkOrderService
+
+type WorkOrderService interface {
+	GetById(ctx context.Context, id uuid.UUID) model.WorkOrder
+	GetAll(ctx context.Context, filter model.WorkOrderFilter, page commonModel.Pageable) commonModel.Page[model.WorkOrder]
+	Create(ctx context.Context, workOrder model.WorkOrder) model.WorkOrder
+	Update(ctx context.Context, workOrder model.WorkOrder) model.WorkOrder
+	DeleteById(ctx context.Context, id uuid.UUID)
+	GetTrash(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.WorkOrder]
+	Restore(ctx context.Context, id uuid.UUID) model.WorkOrder
+	PurgeTrash(ctx context.Context)
+
+	ChangeStatus(ctx context.Context, id uuid.UUID, status string) model.WorkOrder
+	AddAttachments(ctx context.Context, id uuid.UUID, attachmentsIds []uuid.UUID) model.WorkOrder
+	RemoveAttachments(ctx context.Context, id uuid.UUID, attachmentsIds []uuid.UUID) model.WorkOrder
+	FindOverdue(ctx context.Context, date time.Time) []model.WorkOrder
+}
+
+type workOrderService struct {
+	repository           repository.WorkOrderRepository
+	attachmentRepository attachmentRepository.AttachmentRepository
+	attachmentService    attachmentService.AttachmentService
+	assetService         assetService.AssetService
+	cache                *commonCache.Cache[commonModel.Page[model.WorkOrder]]
+}
+
+func GetWorkOrderService() WorkOrderService {
+	if workOrderSrv != nil {
+		return workOrderSrv
+	}
+
+	workOrderSrv = &workOrderService{
+		repository:           repository.GetWorkOrderRepository(),
+		attachmentRepository: attachmentRepository.GetAttachmentRepository(),
+		attachmentService:    attachmentService.GetAttachmentService(),
+		assetService:         assetService.GetAssetService(),
+		cache:                commonCache.NewCache[commonModel.Page[model.WorkOrder]]("workOrders", 24*time.Hour),
+	}
+	assetService.GetAssetService().AddDeleteListener(workOrderSrv.(*workOrderService).onAssetDeleted)
+	attachmentService.GetAttachmentService().AddReferenceCounter(repository.GetWorkOrderRepository().CountByAttachmentId)
+	assetService.GetTimelineService().RegisterSource(&workOrderTimelineSource{repository: repository.GetWorkOrderRepository()})
+	scheduler.Schedule("workOrderTrash", config.CoreConfig.Database.GetTrashPurgeInterval(), workOrderSrv.PurgeTrash)
+
+	return workOrderSrv
+}
+
+func (service *workOrderService) GetById(ctx context.Context, id uuid.UUID) model.WorkOrder {
+	result := service.repository.GetById(ctx, []uuid.UUID{id})
+	if len(result) == 0 {
+		panic(commonError.NotFoundError)
+	}
+	return result[0]
+}
+
+func (service *workOrderService) GetAll(ctx context.Context, filter model.WorkOrderFilter, page commonModel.Pageable) commonModel.Page[model.WorkOrder] {
+	result, err := service.cache.Get(ctx)
+	if err == nil {
+		return result
+	}
+	return service.cache.Set(ctx, service.repository.FindAllWithPage(ctx, filter, page))
+}
+
+func (service *workOrderService) Create(ctx context.Context, workOrder model.WorkOrder) model.WorkOrder {
+	if workOrder.Priority != "" && !model.IsValidPriority(workOrder.Priority) {
+		panic(commonError.IllegalArgumentError)
+	}
+	service.assetService.CheckWritable(ctx, workOrder.AssetID)
+
+	status := workOrder.Status
+	workOrder.Status, workOrder.ExpenseID, workOrder.CompleteDate = model.NewStatus, nil, nil
+	if tokenInfo, ok := commonUtil.GetCurrentTokenInfo(ctx); ok {
+		workOrder.CreatedBy = tokenInfo.UserId
+	}
+	defer service.cache.Evict(ctx)
+	workOrder = service.repository.Create(ctx, []model.WorkOrder{workOrder})[0]
+	if status != "" && status != model.NewStatus {
+		return service.ChangeStatus(ctx, workOrder.ID, status)
+	}
+	return workOrder
+}
+
+func (service *workOrderService) Update(ctx context.Context, workOrder model.WorkOrder) model.WorkOrder {
+	if workOrder.Priority != "" && !model.IsValidPriority(workOrder.Priority) {
+		panic(commonError.IllegalArgumentError)
+	}
+	stored := service.GetById(ctx, workOrder.ID)
+	service.assetService.CheckWritable(ctx, stored.AssetID)
+	if !stored.CanTransitTo(workOrder.Status) {
+		panic(commonError.IllegalStatusTransitionError)
+	}
+	workOrder.AssetID, workOrder.ExpenseID, workOrder.CreateDate = stored.AssetID, stored.ExpenseID, stored.CreateDate
+	workOrder.CreatedBy = stored.CreatedBy
+
+	defer service.cache.Evict(ctx)
+	return service.save(ctx, workOrder)
+}
+
+func (service *workOrderService) DeleteById(ctx context.Context, id uuid.UUID) {
+	defer service.cache.Evict(ctx)
+	service.repository.DeleteById(ctx, id)
+}
+
+func (service *workOrderService) GetTrash(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.WorkOrder] {
+	return service.repository.FindDeleted(ctx, page)
+}
+
+func (service *workOrderService) Restore(ctx context.Context, id uuid.UUID) model.WorkOrder {
+	defer service.cache.Evict(ctx)
+	service.repository.Restore(ctx, id)
+	return service.GetById(ctx, id)
+}
+
+// PurgeTrash deletes for good the work orders which are in the trash longer than the retention,
+// the expenses registered on completion are kept
+func (service *workOrderService) PurgeTrash(ctx context.Context) {
+	workOrders := service.repository.FindDeletedBefore(ctx, time.Now().Add(-config.CoreConfig.Database.GetTrashRetention()))
+	if len(workOrders) == 0 {
+		return
+	}
+	service.repository.Purge(ctx, commonUtil.Map(workOrders, model.WorkOrder.GetID)...)
+	log.WithContext(ctx).Infof("WorkOrderService: %d work orders purged from the trash", len(workOrders))
+}
+
+func (service *workOrderService) ChangeStatus(ctx context.Context, id uuid.UUID, status string) model.WorkOrder {
+	workOrder := service.GetById(ctx, id)
+	service.assetService.CheckWritable(ctx, workOrder.AssetID)
+	if !workOrder.CanTransitTo(status) {
+		panic(commonError.IllegalStatusTransitionError)
+	}
+	workOrder.Status = status
+
+	defer service.cache.Evict(ctx)
+	return service.save(ctx, workOrder)
+}
+
+// AddAttachments adds the attachments uploaded by the current user to the work order
+func (service *workOrderService) AddAttachments(ctx context.Context, id uuid.UUID, attachmentsIds []uuid.UUID) model.WorkOrder {
+	workOrder := service.GetById(ctx, id)
+	service.assetService.CheckWritable(ctx, workOrder.AssetID)
+	attachments := service.attachmentService.GetLinkable(ctx, attachmentsIds)
+	workOrder.Attachments = append(workOrder.Attachments, commonUtil.Map(attachments, func(it attachmentModel.Attachment) *attachmentModel.Attachment { return &it })...)
+	defer service.cache.Evict(ctx)
+	return service.repository.Update(ctx, []model.WorkOrder{workOrder})[0]
+}
+
+func (service *workOrderService) RemoveAttachments(ctx context.Context, id uuid.UUID, attachmentsIds []uuid.UUID) model.WorkOrder {
+	service.assetService.CheckWritable(ctx, service.GetById(ctx, id).AssetID)
+	defer service.cache.Evict(ctx)
+	service.repository.RemoveAttachments(ctx, id, service.attachmentRepository.GetById(ctx, attachmentsIds))
+	return service.GetById(ctx, id)
+}
+
+func (service *workOrderService) FindOverdue(ctx context.Context, date time.Time) []model.WorkOrder {
+	return service.repository.FindOverdue(ctx, date)
+}
+
+// save stores the work order, the expense of the work order done is registered in the same transaction
+func (service *workOrderService) save(ctx context.Context, workOrder model.WorkOrder) (result model.WorkOrder) {
+	service.repository.InTransaction(ctx, func(ctx context.Context) {
+		if workOrder.Status == model.DoneStatus {
+			service.complete(ctx, &workOrder)
+		}
+		result = service.repository.Update(ctx, []model.WorkOrder{workOrder})[0]
+	})
+	return result
+}
+
+// onAssetDeleted deletes the work orders of the asset purged from the trash, their expenses are kept
+func (service *workOrderService) onAssetDeleted(ctx context.Context, asset assetModel.Asset) {
+	defer service.cache.Evict(ctx)
+	service.repository.PurgeByAssetId(ctx, asset.ID)
+}
+
+// complete registers the cost of the finished work as an expense of the asset
+func (service *workOrderService) complete(ctx context.Context, workOrder *model.WorkOrder) {
+	if workOrder.CompleteDate == nil {
+		now := time.Now()
+		workOrder.CompleteDate = &now
+	}
+	if workOrder.ExpenseID != nil || workOrder.GetCost() == 0 {
+		return
+	}
+
+	expense := service.assetService.AddExpense(ctx, workOrder.AssetID, assetModel.CashFlow{
+		Type:        maintenanceCashFlowType,
+		Date:        workOrder.CompleteDate,
+		Amount:      workOrder.GetCost(),
+		Currency:    workOrder.Currency,
+		Description: fmt.Sprintf("Work order: %s", workOrder.Title),
+	})
+	workOrder.ExpenseID = &expense.ID
+}
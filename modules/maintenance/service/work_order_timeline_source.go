@@ -0,0 +1,58 @@
+package service
+
+import (
+	assetModel "assets/modules/asset/model"
+	"assets/modules/maintenance/model"
+	"assets/modules/maintenance/repository"
+	"context"
+	"fmt"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+// workOrderTimelineSource puts the opening and the completion of the asset work orders on the asset timeline
+type workOrderTimelineSource struct {
+	repository repository.WorkOrderRepository
+}
+
+func (source *workOrderTimelineSource) Type() string {
+	return assetModel.MaintenanceTimelineEvent
+}
+
+func (source *workOrderTimelineSource) Collect(ctx context.Context, asset assetModel.Asset) []assetModel.TimelineEvent {
+	var result []assetModel.TimelineEvent
+	for _, workOrder := range source.repository.FindByAssetId(ctx, asset.ID) {
+		if workOrder.CreateDate != nil {
+			summary := fmt.Sprintf("Work order opened: %s", workOrder.Title)
+			event := assetModel.NewTimelineEvent(assetModel.MaintenanceTimelineEvent, *workOrder.CreateDate, workOrder.ID, summary, workOrder)
+			result = append(result, event.WithUser(workOrder.CreatedBy))
+		}
+		if workOrder.Status == model.DoneStatus && workOrder.CompleteDate != nil {
+			summary := fmt.Sprintf("Work order completed: %s", workOrder.Title)
+			result = append(result, assetModel.NewTimelineEvent(assetModel.MaintenanceTimelineEvent, *workOrder.CompleteDate, workOrder.ID, summary, workOrder))
+		}
+	}
+	return result
+}
@@ -0,0 +1,187 @@
+package controller
+
+import (
+	commonController "assets/common/controller"
+	commonMiddleware "assets/common/middleware"
+	commonResolver "assets/common/resolver"
+	commonUtil "assets/common/util"
+	"assets/modules/notification/model"
+	"assets/modules/notification/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var notificationCntr commonController.HttpController
+
+type notificationController struct {
+	service           service.NotificationService
+	preferenceService service.NotificationPreferenceService
+}
+
+func GetNotificationController() commonController.HttpController {
+	if notificationCntr != nil {
+		return notificationCntr
+	}
+	notificationCntr = &notificationController{
+		service:           service.GetNotificationService(),
+		preferenceService: service.GetNotificationPreferenceService(),
+	}
+	return notificationCntr
+}
+
+func (controller *notificationController) RegisterHttpController(router *gin.Engine) {
+	notificationRouter := router.Group("/api/notifications", commonMiddleware.SecurityHandler)
+
+	notificationRouter.GET(
+		"/inbox",
+		commonMiddleware.HasAnyAuthorities("READ_NOTIFICATION"),
+		commonMiddleware.PaginationHandler,
+		controller.getInbox,
+	)
+
+	notificationRouter.PUT(
+		"/inbox/read",
+		commonMiddleware.HasAnyAuthorities("READ_NOTIFICATION"),
+		controller.markRead,
+	)
+
+	notificationRouter.GET(
+		"/preferences",
+		commonMiddleware.HasAnyAuthorities("READ_NOTIFICATION"),
+		controller.getPreferences,
+	)
+
+	notificationRouter.PUT(
+		"/preferences",
+		commonMiddleware.HasAnyAuthorities("EDIT_NOTIFICATION"),
+		commonResolver.Resolver[model.NotificationPreference],
+		controller.updatePreferences,
+	)
+
+	notificationRouter.POST(
+		"/test",
+		commonMiddleware.HasAnyAuthorities("EDIT_NOTIFICATION"),
+		controller.sendTest,
+	)
+}
+
+// notificationController godoc
+// @Security BearerAuth
+// @Summary      getInbox
+// @Description  Get in-app notifications of the current user
+// @Tags         Notification controller
+// @Accept       json
+// @Produce      json
+// @Param        unreadOnly	query	bool  false  "Only unread notifications"
+// @Success      200	{array}  model.Notification
+// @Failure      400
+// @Failure      500
+// @Router       /api/notifications/inbox [GET]
+func (controller *notificationController) getInbox(ctx *gin.Context) {
+	page := commonUtil.MustGetPageable(ctx)
+	log.WithContext(ctx).Info("NotificationController: GetInbox(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetInbox(ctx, ctx.Query("unreadOnly") == "true", page))
+	log.WithContext(ctx).Info("NotificationController: GetInbox(): End")
+}
+
+// notificationController godoc
+// @Security BearerAuth
+// @Summary      markRead
+// @Description  Mark notifications of the current user as read, all of them if ids are not passed
+// @Tags         Notification controller
+// @Accept       json
+// @Produce      json
+// @Param        ids	query	string  false  "Notification.ID"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/notifications/inbox/read [PUT]
+func (controller *notificationController) markRead(ctx *gin.Context) {
+	ids, _ := ctx.GetQueryArray("ids")
+	notificationUuids := commonUtil.Map(ids, func(it string) uuid.UUID { return uuid.MustParse(it) })
+	log.WithContext(ctx).Infof("NotificationController: MarkRead(ids: %s): Start", notificationUuids)
+	controller.service.MarkRead(ctx, notificationUuids)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("NotificationController: MarkRead(): End")
+}
+
+// notificationController godoc
+// @Security BearerAuth
+// @Summary      getPreferences
+// @Description  Get notification preferences of the current user
+// @Tags         Notification controller
+// @Accept       json
+// @Produce      json
+// @Success      200	{object}  model.NotificationPreference
+// @Failure      400
+// @Failure      500
+// @Router       /api/notifications/preferences [GET]
+func (controller *notificationController) getPreferences(ctx *gin.Context) {
+	log.WithContext(ctx).Info("NotificationController: GetPreferences(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.preferenceService.Get(ctx))
+	log.WithContext(ctx).Info("NotificationController: GetPreferences(): End")
+}
+
+// notificationController godoc
+// @Security BearerAuth
+// @Summary      updatePreferences
+// @Description  Update notification preferences of the current user
+// @Tags         Notification controller
+// @Accept       json
+// @Produce      json
+// @Param        preference	body	  model.NotificationPreference  true  "Update NotificationPreference"
+// @Success      200	{object}  model.NotificationPreference
+// @Failure      400
+// @Failure      500
+// @Router       /api/notifications/preferences [PUT]
+func (controller *notificationController) updatePreferences(ctx *gin.Context) {
+	log.WithContext(ctx).Info("NotificationController: UpdatePreferences(): Start")
+	preference := ctx.MustGet("RequestBody").(model.NotificationPreference)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.preferenceService.Update(ctx, preference))
+	log.WithContext(ctx).Info("NotificationController: UpdatePreferences(): End")
+}
+
+// notificationController godoc
+// @Security BearerAuth
+// @Summary      sendTest
+// @Description  Send a test notification to the current user and return delivery results
+// @Tags         Notification controller
+// @Accept       json
+// @Produce      json
+// @Param        channel	query	string  true  "enum(EMAIL, TELEGRAM, IN_APP)"
+// @Success      200	{array}  model.Delivery
+// @Failure      400
+// @Failure      500
+// @Router       /api/notifications/test [POST]
+func (controller *notificationController) sendTest(ctx *gin.Context) {
+	channels, _ := ctx.GetQueryArray("channel")
+	log.WithContext(ctx).Infof("NotificationController: SendTest(channels: %s): Start", channels)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.SendTest(ctx, channels))
+	log.WithContext(ctx).Info("NotificationController: SendTest(): End")
+}
@@ -0,0 +1,195 @@
+package controller
+
+import (
+	commonController "assets/common/controller"
+	commonMiddleware "assets/common/middleware"
+	commonResolver "assets/common/resolver"
+	commonUtil "assets/common/util"
+	"assets/modules/notification/model"
+	"assets/modules/notification/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var notificationRuleCntr commonController.HttpController
+
+type notificationRuleController struct {
+	service service.NotificationRuleService
+}
+
+func GetNotificationRuleController() commonController.HttpController {
+	if notificationRuleCntr != nil {
+		return notificationRuleCntr
+	}
+	notificationRuleCntr = &notificationRuleController{service: service.GetNotificationRuleService()}
+	return notificationRuleCntr
+}
+
+func (controller *notificationRuleController) RegisterHttpController(router *gin.Engine) {
+	notificationRuleRouter := router.Group("/api/notifications/rules", commonMiddleware.SecurityHandler)
+
+	notificationRuleRouter.GET(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("READ_NOTIFICATION"),
+		controller.getById,
+	)
+
+	notificationRuleRouter.GET(
+		"",
+		commonMiddleware.HasAnyAuthorities("READ_NOTIFICATION"),
+		commonMiddleware.PaginationHandler,
+		controller.getAll,
+	)
+
+	notificationRuleRouter.POST(
+		"",
+		commonMiddleware.HasAnyAuthorities("EDIT_NOTIFICATION"),
+		commonResolver.Resolver[model.NotificationRule],
+		controller.create,
+	)
+
+	notificationRuleRouter.PUT(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_NOTIFICATION"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.UpdateResolver[model.NotificationRule],
+		controller.update,
+	)
+
+	notificationRuleRouter.PATCH(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_NOTIFICATION"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.PatchResolver(controller.service.GetById),
+		controller.update,
+	)
+
+	notificationRuleRouter.DELETE(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_NOTIFICATION"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		controller.deleteById,
+	)
+}
+
+// notificationRuleController godoc
+// @Security BearerAuth
+// @Summary      getById
+// @Description  Get notification rule of the current user by id
+// @Tags         NotificationRule controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "NotificationRule.ID"
+// @Success      200	{object}  model.NotificationRule
+// @Failure      400
+// @Failure      500
+// @Router       /api/notifications/rules/{id} [GET]
+func (controller *notificationRuleController) getById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("NotificationRuleController: GetById(id: %s): Start", id)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.GetById(ctx, id))
+	log.WithContext(ctx).Info("NotificationRuleController: GetById(): End")
+}
+
+// notificationRuleController godoc
+// @Security BearerAuth
+// @Summary      getAll
+// @Description  Get all notification rules of the current user
+// @Tags         NotificationRule controller
+// @Accept       json
+// @Produce      json
+// @Success      200	{array}  model.NotificationRule
+// @Failure      400
+// @Failure      500
+// @Router       /api/notifications/rules/ [GET]
+func (controller *notificationRuleController) getAll(ctx *gin.Context) {
+	page := commonUtil.MustGetPageable(ctx)
+	log.WithContext(ctx).Info("NotificationRuleController: GetAll(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetAll(ctx, page))
+	log.WithContext(ctx).Info("NotificationRuleController: GetAll(): End")
+}
+
+// notificationRuleController godoc
+// @Security BearerAuth
+// @Summary      create
+// @Description  Create notification rule for the current user
+// @Tags         NotificationRule controller
+// @Accept       json
+// @Produce      json
+// @Param        rule	body	  model.NotificationRule  true  "Create NotificationRule"
+// @Success      201	{object}  model.NotificationRule
+// @Failure      400
+// @Failure      500
+// @Router       /api/notifications/rules/ [POST]
+func (controller *notificationRuleController) create(ctx *gin.Context) {
+	log.WithContext(ctx).Info("NotificationRuleController: Create(): Start")
+	rule := ctx.MustGet("RequestBody").(model.NotificationRule)
+	commonUtil.AbortWithEntity(ctx, http.StatusCreated, controller.service.Create(ctx, rule))
+	log.WithContext(ctx).Info("NotificationRuleController: Create(): End")
+}
+
+// notificationRuleController godoc
+// @Security BearerAuth
+// @Summary      update
+// @Description  Update notification rule of the current user
+// @Tags         NotificationRule controller
+// @Accept       json
+// @Produce      json
+// @Param        rule	body	  model.NotificationRule  true  "Update NotificationRule"
+// @Success      200	{object}  model.NotificationRule
+// @Failure      400
+// @Failure      500
+// @Router       /api/notifications/rules/{id} [PUT]
+// @Router       /api/notifications/rules/{id} [PATCH]
+func (controller *notificationRuleController) update(ctx *gin.Context) {
+	log.WithContext(ctx).Info("NotificationRuleController: Update(): Start")
+	rule := ctx.MustGet("RequestBody").(model.NotificationRule)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Update(ctx, rule))
+	log.WithContext(ctx).Info("NotificationRuleController: Update(): End")
+}
+
+// notificationRuleController godoc
+// @Security BearerAuth
+// @Summary      deleteById
+// @Description  Delete notification rule of the current user by id
+// @Tags         NotificationRule controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "NotificationRule.ID"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/notifications/rules/{id} [DELETE]
+func (controller *notificationRuleController) deleteById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("NotificationRuleController: DeleteById(id: %s): Start", id)
+	controller.service.DeleteById(ctx, id)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("NotificationRuleController: DeleteById(): End")
+}
@@ -0,0 +1,91 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"math"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const (
+	PendingDeliveryStatus = "PENDING"
+	SentDeliveryStatus    = "SENT"
+	FailedDeliveryStatus  = "FAILED"
+)
+
+// Delivery is an attempt to send the notification through an external channel
+type Delivery struct {
+	ID              uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	NotificationID  uuid.UUID  `json:"notificationId,omitempty" gorm:"type:uuid;index"`
+	Channel         string     `json:"channel,omitempty"`
+	Status          string     `json:"status,omitempty" gorm:"index"`
+	Attempts        int        `json:"attempts,omitempty"`
+	LastError       string     `json:"lastError,omitempty"`
+	NextAttemptDate *time.Time `json:"nextAttemptDate,omitempty"`
+	SentDate        *time.Time `json:"sentDate,omitempty"`
+	commonModel.Versioned
+}
+
+func (delivery Delivery) GetID() uuid.UUID {
+	return delivery.ID
+}
+
+func (delivery *Delivery) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(delivery.ID) {
+		delivery.ID = uuid.New()
+	}
+	return nil
+}
+
+func (delivery *Delivery) MarkSent(date time.Time) {
+	delivery.Attempts++
+	delivery.Status, delivery.SentDate, delivery.NextAttemptDate, delivery.LastError = SentDeliveryStatus, &date, nil, ""
+}
+
+// MarkFailed schedules the next attempt with exponential backoff or gives up after maxAttempts
+func (delivery *Delivery) MarkFailed(date time.Time, err error, maxAttempts int, retryDelay time.Duration) {
+	delivery.Attempts++
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= maxAttempts {
+		delivery.Status, delivery.NextAttemptDate = FailedDeliveryStatus, nil
+		return
+	}
+	next := date.Add(retryDelay * time.Duration(math.Pow(2, float64(delivery.Attempts-1))))
+	delivery.NextAttemptDate = &next
+}
+
+func NewDelivery(notificationId uuid.UUID, channel string) Delivery {
+	now := time.Now()
+	return Delivery{
+		NotificationID:  notificationId,
+		Channel:         channel,
+		Status:          PendingDeliveryStatus,
+		NextAttemptDate: &now,
+	}
+}
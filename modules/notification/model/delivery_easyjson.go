@@ -0,0 +1,207 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonCea158a8DecodeAssetsModulesNotificationModel(in *jlexer.Lexer, out *Delivery) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "notificationId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.NotificationID).UnmarshalText(data))
+			}
+		case "channel":
+			out.Channel = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "attempts":
+			out.Attempts = int(in.Int())
+		case "lastError":
+			out.LastError = string(in.String())
+		case "nextAttemptDate":
+			if in.IsNull() {
+				in.Skip()
+				out.NextAttemptDate = nil
+			} else {
+				if out.NextAttemptDate == nil {
+					out.NextAttemptDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.NextAttemptDate).UnmarshalJSON(data))
+				}
+			}
+		case "sentDate":
+			if in.IsNull() {
+				in.Skip()
+				out.SentDate = nil
+			} else {
+				if out.SentDate == nil {
+					out.SentDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.SentDate).UnmarshalJSON(data))
+				}
+			}
+		case "version":
+			out.Version = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeAssetsModulesNotificationModel(out *jwriter.Writer, in Delivery) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"notificationId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.NotificationID).MarshalText())
+	}
+	if in.Channel != "" {
+		const prefix string = ",\"channel\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Channel))
+	}
+	if in.Status != "" {
+		const prefix string = ",\"status\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Status))
+	}
+	if in.Attempts != 0 {
+		const prefix string = ",\"attempts\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Attempts))
+	}
+	if in.LastError != "" {
+		const prefix string = ",\"lastError\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.LastError))
+	}
+	if in.NextAttemptDate != nil {
+		const prefix string = ",\"nextAttemptDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.NextAttemptDate).MarshalJSON())
+	}
+	if in.SentDate != nil {
+		const prefix string = ",\"sentDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.SentDate).MarshalJSON())
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Delivery) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeAssetsModulesNotificationModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Delivery) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeAssetsModulesNotificationModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Delivery) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeAssetsModulesNotificationModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Delivery) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeAssetsModulesNotificationModel(l, v)
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+// Notification is an entry of the user's in-app inbox. The rule, entity and event date
+// identify the occurrence, so the same event is never notified twice
+type Notification struct {
+	ID         uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	UserID     uuid.UUID  `json:"userId,omitempty" gorm:"type:uuid;index"`
+	RuleID     *uuid.UUID `json:"ruleId,omitempty" gorm:"type:uuid;uniqueIndex:idx_notification_occurrence"`
+	EntityID   *uuid.UUID `json:"entityId,omitempty" gorm:"type:uuid;uniqueIndex:idx_notification_occurrence"`
+	EventDate  *time.Time `json:"eventDate,omitempty" gorm:"uniqueIndex:idx_notification_occurrence"`
+	Event      string     `json:"event,omitempty"`
+	Subject    string     `json:"subject,omitempty"`
+	Body       string     `json:"body,omitempty"`
+	IsInApp    bool       `json:"-"`
+	IsRead     bool       `json:"isRead,omitempty"`
+	CreateDate *time.Time `json:"createDate,omitempty"`
+	commonModel.Versioned
+}
+
+func (notification Notification) GetID() uuid.UUID {
+	return notification.ID
+}
+
+func (notification *Notification) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(notification.ID) {
+		notification.ID = uuid.New()
+	}
+	if notification.CreateDate == nil {
+		now := time.Now()
+		notification.CreateDate = &now
+	}
+	return nil
+}
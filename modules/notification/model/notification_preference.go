@@ -0,0 +1,66 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+// NotificationPreference keeps the user's delivery addresses. Email falls back to the user's email if empty
+type NotificationPreference struct {
+	ID               uuid.UUID `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	UserID           uuid.UUID `json:"userId,omitempty" gorm:"type:uuid;uniqueIndex"`
+	Email            string    `json:"email,omitempty"`
+	TelegramChatId   string    `json:"telegramChatId,omitempty"`
+	DisabledChannels []string  `json:"disabledChannels,omitempty" gorm:"serializer:json"`
+	MutedEvents      []string  `json:"mutedEvents,omitempty" gorm:"serializer:json"`
+	commonModel.Versioned
+}
+
+func (preference NotificationPreference) GetID() uuid.UUID {
+	return preference.ID
+}
+
+func (preference *NotificationPreference) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(preference.ID) {
+		preference.ID = uuid.New()
+	}
+	return nil
+}
+
+func (preference NotificationPreference) IsMuted(event string) bool {
+	return commonUtil.ArrayContains(preference.MutedEvents, event)
+}
+
+func (preference NotificationPreference) Allows(event string, channel string) bool {
+	return !preference.IsMuted(event) && !commonUtil.ArrayContains(preference.DisabledChannels, channel)
+}
+
+func NewNotificationPreference(userId uuid.UUID) NotificationPreference {
+	return NotificationPreference{UserID: userId}
+}
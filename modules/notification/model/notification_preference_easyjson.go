@@ -0,0 +1,222 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonEafc0cc5DecodeAssetsModulesNotificationModel(in *jlexer.Lexer, out *NotificationPreference) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "userId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserID).UnmarshalText(data))
+			}
+		case "email":
+			out.Email = string(in.String())
+		case "telegramChatId":
+			out.TelegramChatId = string(in.String())
+		case "disabledChannels":
+			if in.IsNull() {
+				in.Skip()
+				out.DisabledChannels = nil
+			} else {
+				in.Delim('[')
+				if out.DisabledChannels == nil {
+					if !in.IsDelim(']') {
+						out.DisabledChannels = make([]string, 0, 4)
+					} else {
+						out.DisabledChannels = []string{}
+					}
+				} else {
+					out.DisabledChannels = (out.DisabledChannels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.DisabledChannels = append(out.DisabledChannels, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "mutedEvents":
+			if in.IsNull() {
+				in.Skip()
+				out.MutedEvents = nil
+			} else {
+				in.Delim('[')
+				if out.MutedEvents == nil {
+					if !in.IsDelim(']') {
+						out.MutedEvents = make([]string, 0, 4)
+					} else {
+						out.MutedEvents = []string{}
+					}
+				} else {
+					out.MutedEvents = (out.MutedEvents)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 string
+					v2 = string(in.String())
+					out.MutedEvents = append(out.MutedEvents, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "version":
+			out.Version = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEafc0cc5EncodeAssetsModulesNotificationModel(out *jwriter.Writer, in NotificationPreference) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"userId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.UserID).MarshalText())
+	}
+	if in.Email != "" {
+		const prefix string = ",\"email\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Email))
+	}
+	if in.TelegramChatId != "" {
+		const prefix string = ",\"telegramChatId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.TelegramChatId))
+	}
+	if len(in.DisabledChannels) != 0 {
+		const prefix string = ",\"disabledChannels\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v3, v4 := range in.DisabledChannels {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v4))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.MutedEvents) != 0 {
+		const prefix string = ",\"mutedEvents\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.MutedEvents {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v6))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationPreference) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEafc0cc5EncodeAssetsModulesNotificationModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationPreference) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEafc0cc5EncodeAssetsModulesNotificationModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationPreference) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEafc0cc5DecodeAssetsModulesNotificationModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationPreference) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEafc0cc5DecodeAssetsModulesNotificationModel(l, v)
+}
@@ -0,0 +1,120 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const (
+	UpcomingCashFlowEvent = "UPCOMING_CASH_FLOW"
+	OverdueWorkOrderEvent = "OVERDUE_WORK_ORDER"
+	DocumentExpiryEvent   = "DOCUMENT_EXPIRY"
+	TestEvent             = "TEST"
+)
+
+const (
+	EmailChannel    = "EMAIL"
+	TelegramChannel = "TELEGRAM"
+	InAppChannel    = "IN_APP"
+)
+
+var Events = []string{UpcomingCashFlowEvent, OverdueWorkOrderEvent, DocumentExpiryEvent}
+
+var Channels = []string{EmailChannel, TelegramChannel, InAppChannel}
+
+var NilNotificationRule = NotificationRule{}
+
+// NotificationRule describes which events the user wants to be notified about and where.
+// CashFlowType optionally narrows cash flow events down to one type, e.g. RENT or LOAN
+type NotificationRule struct {
+	ID           uuid.UUID `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	UserID       uuid.UUID `json:"userId,omitempty" gorm:"type:uuid;index"`
+	Event        string    `json:"event,omitempty"`
+	CashFlowType string    `json:"cashFlowType,omitempty"`
+	DaysBefore   int       `json:"daysBefore,omitempty"`
+	Channels     []string  `json:"channels,omitempty" gorm:"serializer:json"`
+	IsActive     bool      `json:"isActive,omitempty"`
+	commonModel.Versioned
+}
+
+func (rule NotificationRule) GetID() uuid.UUID {
+	return rule.ID
+}
+
+func (rule *NotificationRule) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(rule.ID) {
+		rule.ID = uuid.New()
+	}
+	return nil
+}
+
+func (rule NotificationRule) IsValid() bool {
+	if !commonUtil.ArrayContains(Events, rule.Event) || rule.DaysBefore < 0 || len(rule.Channels) == 0 {
+		return false
+	}
+	for _, channel := range rule.Channels {
+		if !commonUtil.ArrayContains(Channels, channel) {
+			return false
+		}
+	}
+	return true
+}
+
+func (rule NotificationRule) HasChannel(channel string) bool {
+	return commonUtil.ArrayContains(rule.Channels, channel)
+}
+
+func NewNotificationRule(userId uuid.UUID, event string, opts ...NotificationRuleOption) NotificationRule {
+	rule := NotificationRule{
+		UserID:   userId,
+		Event:    event,
+		Channels: []string{InAppChannel},
+		IsActive: true,
+	}
+
+	for _, opt := range opts {
+		opt(&rule)
+	}
+
+	return rule
+}
+
+type NotificationRuleOption func(*NotificationRule)
+
+func NotificationRuleWithChannels(channels ...string) NotificationRuleOption {
+	return func(rule *NotificationRule) {
+		rule.Channels = channels
+	}
+}
+
+func NotificationRuleWithDaysBefore(daysBefore int) NotificationRuleOption {
+	return func(rule *NotificationRule) {
+		rule.DaysBefore = daysBefore
+	}
+}
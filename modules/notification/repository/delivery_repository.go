@@ -0,0 +1,74 @@
+package repository
+
+import (
+	commonDB "assets/common/db"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/notification/model"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var deliveryRepo DeliveryRepository
+
+type DeliveryRepository interface {
+	commonRepository.Repository[model.Delivery]
+	FindPending(ctx context.Context, date time.Time, limit int) []model.Delivery
+	FindByNotificationId(ctx context.Context, notificationId uuid.UUID) []model.Delivery
+}
+
+type deliveryRepository struct {
+	commonRepository.Repository[model.Delivery]
+	*commonDB.DataSource
+}
+
+func GetDeliveryRepository() DeliveryRepository {
+	if deliveryRepo != nil {
+		return deliveryRepo
+	}
+	deliveryRepo = &deliveryRepository{
+		commonRepository.NewBaseRepository[model.Delivery](commonDB.GetDataSource()),
+		commonDB.GetDataSource(),
+	}
+	return deliveryRepo
+}
+
+func (repo *deliveryRepository) FindPending(ctx context.Context, date time.Time, limit int) []model.Delivery {
+	var result []model.Delivery
+	commonUtil.Must(repo.DataSource.
+		Where("status = ? and next_attempt_date <= ?", model.PendingDeliveryStatus, date).
+		Order("next_attempt_date").Limit(limit).
+		Find(&result).Error)
+	return result
+}
+
+func (repo *deliveryRepository) FindByNotificationId(ctx context.Context, notificationId uuid.UUID) []model.Delivery {
+	var result []model.Delivery
+	commonUtil.Must(repo.DataSource.Where("notification_id = ?", notificationId).Find(&result).Error)
+	return result
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	commonDB "assets/common/db"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/notification/model"
+	"context"
+	"github.com/google/uuid"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var notificationPreferenceRepo NotificationPreferenceRepository
+
+type NotificationPreferenceRepository interface {
+	commonRepository.Repository[model.NotificationPreference]
+	FindByUserId(ctx context.Context, userId uuid.UUID) []model.NotificationPreference
+}
+
+type notificationPreferenceRepository struct {
+	commonRepository.Repository[model.NotificationPreference]
+	*commonDB.DataSource
+}
+
+func GetNotificationPreferenceRepository() NotificationPreferenceRepository {
+	if notificationPreferenceRepo != nil {
+		return notificationPreferenceRepo
+	}
+	notificationPreferenceRepo = &notificationPreferenceRepository{
+		commonRepository.NewBaseRepository[model.NotificationPreference](
+			commonDB.GetDataSource(),
+			commonRepository.WithReadScope[model.NotificationPreference](userScope),
+			commonRepository.WithWriteScope[model.NotificationPreference](userScope),
+		),
+		commonDB.GetDataSource(),
+	}
+	return notificationPreferenceRepo
+}
+
+func (repo *notificationPreferenceRepository) FindByUserId(ctx context.Context, userId uuid.UUID) []model.NotificationPreference {
+	var result []model.NotificationPreference
+	commonUtil.Must(userScope(ctx, repo.DataSource.DB).Where("user_id = ?", userId).Find(&result).Error)
+	return result
+}
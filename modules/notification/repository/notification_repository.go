@@ -0,0 +1,96 @@
+package repository
+
+import (
+	commonDB "assets/common/db"
+	commonModel "assets/common/model"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/notification/model"
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var notificationRepo NotificationRepository
+
+type NotificationRepository interface {
+	commonRepository.Repository[model.Notification]
+	FindInbox(ctx context.Context, userId uuid.UUID, unreadOnly bool, page commonModel.Pageable) commonModel.Page[model.Notification]
+	CreateIfAbsent(ctx context.Context, notification model.Notification) (model.Notification, bool)
+	MarkRead(ctx context.Context, userId uuid.UUID, ids []uuid.UUID)
+}
+
+type notificationRepository struct {
+	commonRepository.Repository[model.Notification]
+	*commonDB.DataSource
+}
+
+func GetNotificationRepository() NotificationRepository {
+	if notificationRepo != nil {
+		return notificationRepo
+	}
+	notificationRepo = &notificationRepository{
+		commonRepository.NewBaseRepository[model.Notification](
+			commonDB.GetDataSource(),
+			commonRepository.WithReadScope[model.Notification](userScope),
+			commonRepository.WithWriteScope[model.Notification](userScope),
+		),
+		commonDB.GetDataSource(),
+	}
+	return notificationRepo
+}
+
+func (repo *notificationRepository) FindInbox(ctx context.Context, userId uuid.UUID, unreadOnly bool, page commonModel.Pageable) commonModel.Page[model.Notification] {
+	query := repo.DataSource.Model(&model.Notification{}).Where("user_id = ? and is_in_app", userId)
+	if unreadOnly {
+		query = query.Where("not is_read")
+	}
+
+	var total int64
+	commonUtil.Must(query.Count(&total).Error)
+
+	var result []model.Notification
+	query = query.Limit(page.Size).Offset(page.Page * page.Size)
+	query = commonRepository.OrderBy[model.Notification](query, page.Sort, "create_date desc")
+	commonUtil.Must(query.Find(&result).Error)
+	return commonModel.NewPage[model.Notification](result, page).WithTotal(int(total))
+}
+
+// CreateIfAbsent stores the notification unless the same occurrence was already notified
+func (repo *notificationRepository) CreateIfAbsent(ctx context.Context, notification model.Notification) (model.Notification, bool) {
+	result := repo.DataSource.Clauses(clause.OnConflict{DoNothing: true}).Create(&notification)
+	commonUtil.Must(result.Error)
+	return notification, result.RowsAffected != 0
+}
+
+func (repo *notificationRepository) MarkRead(ctx context.Context, userId uuid.UUID, ids []uuid.UUID) {
+	query := repo.DataSource.Model(&model.Notification{}).Where("user_id = ?", userId)
+	if len(ids) != 0 {
+		query = query.Where("id in ?", ids)
+	}
+	commonUtil.Must(query.Update("is_read", true).Error)
+}
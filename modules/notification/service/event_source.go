@@ -0,0 +1,160 @@
+package service
+
+import (
+	commonUtil "assets/common/util"
+	assetModel "assets/modules/asset/model"
+	assetRepository "assets/modules/asset/repository"
+	documentModel "assets/modules/document/model"
+	documentService "assets/modules/document/service"
+	maintenanceModel "assets/modules/maintenance/model"
+	maintenanceService "assets/modules/maintenance/service"
+	"assets/modules/notification/model"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+// NotificationEvent is an occurrence the rule's user should be notified about,
+// Data is passed to the event template
+type NotificationEvent struct {
+	EntityID uuid.UUID
+	Date     time.Time
+	Data     map[string]any
+}
+
+// EventSource finds occurrences of one event type for the rule
+type EventSource interface {
+	Event() string
+	Collect(ctx context.Context, rule model.NotificationRule, date time.Time) []NotificationEvent
+}
+
+type cashFlowEventSource struct {
+	assetRepository assetRepository.AssetRepository
+}
+
+func (source *cashFlowEventSource) Event() string {
+	return model.UpcomingCashFlowEvent
+}
+
+// Collect finds incomes and expenses of the user's assets dated from today to rule.DaysBefore days ahead
+func (source *cashFlowEventSource) Collect(ctx context.Context, rule model.NotificationRule, date time.Time) []NotificationEvent {
+	from := date.Truncate(24 * time.Hour)
+	to := from.AddDate(0, 0, rule.DaysBefore+1)
+
+	var result []NotificationEvent
+	collect := func(asset assetModel.Asset, cashFlows []*assetModel.CashFlow, kind string) {
+		for _, cashFlow := range cashFlows {
+			if cashFlow.Date == nil || cashFlow.Date.Before(from) || !cashFlow.Date.Before(to) {
+				continue
+			}
+			if rule.CashFlowType != "" && rule.CashFlowType != cashFlow.Type {
+				continue
+			}
+			result = append(result, NotificationEvent{
+				EntityID: cashFlow.ID,
+				Date:     *cashFlow.Date,
+				Data:     map[string]any{"Asset": asset, "CashFlow": cashFlow, "Kind": kind},
+			})
+		}
+	}
+
+	for _, asset := range source.assetRepository.FindByOwnerOrShareholder(ctx, rule.UserID) {
+		collect(asset, asset.Incomes, "income")
+		collect(asset, asset.Expenses, "expense")
+	}
+	return result
+}
+
+type workOrderEventSource struct {
+	assetRepository  assetRepository.AssetRepository
+	workOrderService maintenanceService.WorkOrderService
+}
+
+func (source *workOrderEventSource) Event() string {
+	return model.OverdueWorkOrderEvent
+}
+
+// Collect finds unfinished work orders of the user's assets which are overdue
+// or become overdue in rule.DaysBefore days
+func (source *workOrderEventSource) Collect(ctx context.Context, rule model.NotificationRule, date time.Time) []NotificationEvent {
+	assets := make(map[uuid.UUID]assetModel.Asset)
+	for _, asset := range source.assetRepository.FindByOwnerOrShareholder(ctx, rule.UserID) {
+		assets[asset.ID] = asset
+	}
+	if len(assets) == 0 {
+		return nil
+	}
+
+	workOrders := source.workOrderService.FindOverdue(ctx, date.AddDate(0, 0, rule.DaysBefore))
+	workOrders = commonUtil.ArrayFilter(workOrders, func(it maintenanceModel.WorkOrder) bool {
+		_, ok := assets[it.AssetID]
+		return ok
+	})
+
+	return commonUtil.Map(workOrders, func(it maintenanceModel.WorkOrder) NotificationEvent {
+		return NotificationEvent{
+			EntityID: it.ID,
+			Date:     *it.DueDate,
+			Data:     map[string]any{"Asset": assets[it.AssetID], "WorkOrder": it, "IsOverdue": it.IsOverdue(date)},
+		}
+	})
+}
+
+type documentEventSource struct {
+	assetRepository assetRepository.AssetRepository
+	documentService documentService.DocumentService
+}
+
+func (source *documentEventSource) Event() string {
+	return model.DocumentExpiryEvent
+}
+
+// Collect finds documents of the user's assets expiring from today to rule.DaysBefore days ahead
+func (source *documentEventSource) Collect(ctx context.Context, rule model.NotificationRule, date time.Time) []NotificationEvent {
+	assets := make(map[uuid.UUID]assetModel.Asset)
+	for _, asset := range source.assetRepository.FindByOwnerOrShareholder(ctx, rule.UserID) {
+		assets[asset.ID] = asset
+	}
+	if len(assets) == 0 {
+		return nil
+	}
+
+	from := date.Truncate(24 * time.Hour)
+	documents := source.documentService.FindExpiring(ctx, from, from.AddDate(0, 0, rule.DaysBefore+1))
+	documents = commonUtil.ArrayFilter(documents, func(it documentModel.Document) bool {
+		_, ok := assets[it.AssetID]
+		return ok
+	})
+
+	return commonUtil.Map(documents, func(it documentModel.Document) NotificationEvent {
+		return NotificationEvent{
+			EntityID: it.ID,
+			Date:     *it.ExpiryDate,
+			Data:     map[string]any{"Asset": assets[it.AssetID], "Document": it},
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	commonUtil "assets/common/util"
+	"assets/modules/notification/model"
+	"assets/modules/notification/repository"
+	"context"
+	"github.com/google/uuid"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var notificationPreferenceSrv NotificationPreferenceService
+
+type NotificationPreferenceService interface {
+	Get(ctx context.Context) model.NotificationPreference
+	Update(ctx context.Context, preference model.NotificationPreference) model.NotificationPreference
+	GetByUserId(ctx context.Context, userId uuid.UUID) model.NotificationPreference
+}
+
+type notificationPreferenceService struct {
+	repository repository.NotificationPreferenceRepository
+}
+
+func GetNotificationPreferenceService() NotificationPreferenceService {
+	if notificationPreferenceSrv != nil {
+		return notificationPreferenceSrv
+	}
+
+	notificationPreferenceSrv = &notificationPreferenceService{
+		repository: repository.GetNotificationPreferenceRepository(),
+	}
+
+	return notificationPreferenceSrv
+}
+
+func (service *notificationPreferenceService) Get(ctx context.Context) model.NotificationPreference {
+	return service.GetByUserId(ctx, commonUtil.MustGetCurrentTokenInfo(ctx).UserId)
+}
+
+func (service *notificationPreferenceService) Update(ctx context.Context, preference model.NotificationPreference) model.NotificationPreference {
+	stored := service.Get(ctx)
+	preference.ID, preference.UserID = stored.ID, stored.UserID
+	if commonUtil.IsZeroObject(preference.ID) {
+		return service.repository.Create(ctx, []model.NotificationPreference{preference})[0]
+	}
+	return service.repository.Update(ctx, []model.NotificationPreference{preference})[0]
+}
+
+// GetByUserId returns stored preferences of the user or the default ones which are not stored yet
+func (service *notificationPreferenceService) GetByUserId(ctx context.Context, userId uuid.UUID) model.NotificationPreference {
+	result := service.repository.FindByUserId(ctx, userId)
+	if len(result) == 0 {
+		return model.NewNotificationPreference(userId)
+	}
+	return result[0]
+}
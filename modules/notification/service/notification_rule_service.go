@@ -0,0 +1,104 @@
+package service
+
+import (
+	commonCache "assets/common/cache"
+	commonError "assets/common/custom_error"
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"assets/modules/notification/model"
+	"assets/modules/notification/repository"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var notificationRuleSrv NotificationRuleService
+
+type NotificationRuleService interface {
+	GetById(ctx context.Context, id uuid.UUID) model.NotificationRule
+	GetAll(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.NotificationRule]
+	Create(ctx context.Context, rule model.NotificationRule) model.NotificationRule
+	Update(ctx context.Context, rule model.NotificationRule) model.NotificationRule
+	DeleteById(ctx context.Context, id uuid.UUID)
+}
+
+type notificationRuleService struct {
+	repository repository.NotificationRuleRepository
+	cache      *commonCache.Cache[commonModel.Page[model.NotificationRule]]
+}
+
+func GetNotificationRuleService() NotificationRuleService {
+	if notificationRuleSrv != nil {
+		return notificationRuleSrv
+	}
+
+	notificationRuleSrv = &notificationRuleService{
+		repository: repository.GetNotificationRuleRepository(),
+		cache:      commonCache.NewCache[commonModel.Page[model.NotificationRule]]("notificationRules", 24*time.Hour),
+	}
+
+	return notificationRuleSrv
+}
+
+func (service *notificationRuleService) GetById(ctx context.Context, id uuid.UUID) model.NotificationRule {
+	result := service.repository.GetById(ctx, []uuid.UUID{id})
+	if len(result) == 0 {
+		panic(commonError.NotFoundError)
+	}
+	return result[0]
+}
+
+func (service *notificationRuleService) GetAll(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.NotificationRule] {
+	result, err := service.cache.Get(ctx)
+	if err == nil {
+		return result
+	}
+	return service.cache.Set(ctx, service.repository.GetAllWithPage(ctx, page))
+}
+
+func (service *notificationRuleService) Create(ctx context.Context, rule model.NotificationRule) model.NotificationRule {
+	if !rule.IsValid() {
+		panic(commonError.IllegalArgumentError)
+	}
+	rule.UserID = commonUtil.MustGetCurrentTokenInfo(ctx).UserId
+	defer service.cache.Evict(ctx)
+	return service.repository.Create(ctx, []model.NotificationRule{rule})[0]
+}
+
+func (service *notificationRuleService) Update(ctx context.Context, rule model.NotificationRule) model.NotificationRule {
+	if !rule.IsValid() {
+		panic(commonError.IllegalArgumentError)
+	}
+	rule.UserID = service.GetById(ctx, rule.ID).UserID
+	defer service.cache.Evict(ctx)
+	return service.repository.Update(ctx, []model.NotificationRule{rule})[0]
+}
+
+func (service *notificationRuleService) DeleteById(ctx context.Context, id uuid.UUID) {
+	defer service.cache.Evict(ctx)
+	service.repository.DeleteById(ctx, id)
+}
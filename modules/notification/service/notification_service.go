@@ -0,0 +1,277 @@
+package service
+
+import (
+	"assets/common/config"
+	commonError "assets/common/custom_error"
+	commonModel "assets/common/model"
+	"assets/common/scheduler"
+	commonUtil "assets/common/util"
+	assetRepository "assets/modules/asset/repository"
+	authorizationRepository "assets/modules/authorization/repository"
+	documentService "assets/modules/document/service"
+	maintenanceService "assets/modules/maintenance/service"
+	"assets/modules/notification/model"
+	"assets/modules/notification/repository"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var notificationSrv NotificationService
+
+type NotificationService interface {
+	GetInbox(ctx context.Context, unreadOnly bool, page commonModel.Pageable) commonModel.Page[model.Notification]
+	MarkRead(ctx context.Context, ids []uuid.UUID)
+	SendTest(ctx context.Context, channels []string) []model.Delivery
+
+	ProcessRules(ctx context.Context)
+	ProcessDeliveries(ctx context.Context)
+}
+
+type notificationService struct {
+	repository          repository.NotificationRepository
+	ruleRepository      repository.NotificationRuleRepository
+	deliveryRepository  repository.DeliveryRepository
+	userRepository      authorizationRepository.UserRepository
+	preferenceService   NotificationPreferenceService
+	sources             map[string]EventSource
+	senders             map[string]Sender
+	maxAttempts         int
+	retryDelay          time.Duration
+	deliveriesBatchSize int
+}
+
+func GetNotificationService() NotificationService {
+	if notificationSrv != nil {
+		return notificationSrv
+	}
+
+	property := config.CoreConfig.Notification
+	notificationSrv = (&notificationService{
+		repository:          repository.GetNotificationRepository(),
+		ruleRepository:      repository.GetNotificationRuleRepository(),
+		deliveryRepository:  repository.GetDeliveryRepository(),
+		userRepository:      authorizationRepository.GetUserRepository(),
+		preferenceService:   GetNotificationPreferenceService(),
+		sources:             make(map[string]EventSource),
+		senders:             newSenders(property),
+		maxAttempts:         property.MaxAttempts,
+		retryDelay:          property.RetryDelay,
+		deliveriesBatchSize: 100,
+	}).registerSources(
+		&cashFlowEventSource{assetRepository: assetRepository.GetAssetRepository()},
+		&workOrderEventSource{assetRepository: assetRepository.GetAssetRepository(), workOrderService: maintenanceService.GetWorkOrderService()},
+		&documentEventSource{assetRepository: assetRepository.GetAssetRepository(), documentService: documentService.GetDocumentService()},
+	).schedule(property.CheckInterval)
+
+	return notificationSrv
+}
+
+func (service *notificationService) GetInbox(ctx context.Context, unreadOnly bool, page commonModel.Pageable) commonModel.Page[model.Notification] {
+	return service.repository.FindInbox(ctx, commonUtil.MustGetCurrentTokenInfo(ctx).UserId, unreadOnly, page)
+}
+
+// MarkRead marks the notifications of the current user as read, all of them if ids are empty
+func (service *notificationService) MarkRead(ctx context.Context, ids []uuid.UUID) {
+	service.repository.MarkRead(ctx, commonUtil.MustGetCurrentTokenInfo(ctx).UserId, ids)
+}
+
+// SendTest sends a test notification to the current user through the channels right away,
+// so delivery settings could be checked without waiting for the scheduler
+func (service *notificationService) SendTest(ctx context.Context, channels []string) []model.Delivery {
+	for _, channel := range channels {
+		if !commonUtil.ArrayContains(model.Channels, channel) {
+			panic(commonError.IllegalArgumentError)
+		}
+	}
+
+	users := service.userRepository.GetById(ctx, []uuid.UUID{commonUtil.MustGetCurrentTokenInfo(ctx).UserId})
+	if len(users) == 0 {
+		panic(commonError.NotFoundError)
+	}
+	subject, body, err := renderTemplate(model.TestEvent, map[string]any{"User": users[0]})
+	commonUtil.Must(err)
+
+	notification := service.repository.Create(ctx, []model.Notification{{
+		UserID:  users[0].ID,
+		Event:   model.TestEvent,
+		Subject: subject,
+		Body:    body,
+		IsInApp: commonUtil.ArrayContains(channels, model.InAppChannel),
+	}})[0]
+
+	deliveries := service.createDeliveries(ctx, notification, channels, model.NewNotificationPreference(notification.UserID))
+	for i := range deliveries {
+		service.deliver(ctx, &deliveries[i])
+	}
+	return deliveries
+}
+
+// ProcessRules creates notifications for the events found by active rules, every occurrence is notified once
+func (service *notificationService) ProcessRules(ctx context.Context) {
+	now := time.Now()
+	preferences := make(map[uuid.UUID]model.NotificationPreference)
+
+	for _, rule := range service.ruleRepository.FindActive(ctx) {
+		source, ok := service.sources[rule.Event]
+		if !ok {
+			continue
+		}
+
+		preference, ok := preferences[rule.UserID]
+		if !ok {
+			preference = service.preferenceService.GetByUserId(ctx, rule.UserID)
+			preferences[rule.UserID] = preference
+		}
+
+		for _, event := range source.Collect(ctx, rule, now) {
+			service.notify(ctx, rule, event, preference)
+		}
+	}
+}
+
+// ProcessDeliveries sends pending deliveries, failed ones are retried with exponential backoff
+func (service *notificationService) ProcessDeliveries(ctx context.Context) {
+	for _, delivery := range service.deliveryRepository.FindPending(ctx, time.Now(), service.deliveriesBatchSize) {
+		service.deliver(ctx, &delivery)
+	}
+}
+
+func (service *notificationService) notify(ctx context.Context, rule model.NotificationRule, event NotificationEvent, preference model.NotificationPreference) {
+	if preference.IsMuted(rule.Event) {
+		return
+	}
+
+	subject, body, err := renderTemplate(rule.Event, event.Data)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Errorf("NotificationService: couldn't render template of rule %s", rule.ID)
+		return
+	}
+
+	notification, created := service.repository.CreateIfAbsent(ctx, model.Notification{
+		UserID:    rule.UserID,
+		RuleID:    &rule.ID,
+		EntityID:  &event.EntityID,
+		EventDate: &event.Date,
+		Event:     rule.Event,
+		Subject:   subject,
+		Body:      body,
+		IsInApp:   rule.HasChannel(model.InAppChannel) && preference.Allows(rule.Event, model.InAppChannel),
+	})
+	if created {
+		service.createDeliveries(ctx, notification, rule.Channels, preference)
+	}
+}
+
+func (service *notificationService) createDeliveries(ctx context.Context, notification model.Notification, channels []string, preference model.NotificationPreference) []model.Delivery {
+	var deliveries []model.Delivery
+	for _, channel := range channels {
+		if _, ok := service.senders[channel]; ok && preference.Allows(notification.Event, channel) {
+			deliveries = append(deliveries, model.NewDelivery(notification.ID, channel))
+		}
+	}
+	if len(deliveries) == 0 {
+		return deliveries
+	}
+	return service.deliveryRepository.Create(ctx, deliveries)
+}
+
+func (service *notificationService) deliver(ctx context.Context, delivery *model.Delivery) {
+	now := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			log.WithContext(ctx).Errorf("NotificationService: delivery %s panicked: %v", delivery.ID, r)
+			delivery.MarkFailed(now, fmt.Errorf("%v", r), service.getMaxAttempts(), service.getRetryDelay())
+			service.deliveryRepository.Update(ctx, []model.Delivery{*delivery})
+		}
+	}()
+
+	notifications := service.repository.GetById(ctx, []uuid.UUID{delivery.NotificationID})
+	if len(notifications) == 0 {
+		delivery.MarkFailed(now, commonError.NotFoundError, 0, 0)
+		service.deliveryRepository.Update(ctx, []model.Delivery{*delivery})
+		return
+	}
+
+	err := service.senders[delivery.Channel].Send(ctx, service.getRecipient(ctx, notifications[0].UserID), notifications[0])
+	switch {
+	case err == nil:
+		delivery.MarkSent(now)
+	case err == errNoRecipient:
+		// there is no point to retry until the user sets the address
+		delivery.MarkFailed(now, err, 0, 0)
+	default:
+		log.WithContext(ctx).WithError(err).Warnf("NotificationService: delivery %s failed", delivery.ID)
+		delivery.MarkFailed(now, err, service.getMaxAttempts(), service.getRetryDelay())
+	}
+	service.deliveryRepository.Update(ctx, []model.Delivery{*delivery})
+}
+
+func (service *notificationService) getRecipient(ctx context.Context, userId uuid.UUID) Recipient {
+	preference := service.preferenceService.GetByUserId(ctx, userId)
+	recipient := Recipient{Email: preference.Email, TelegramChatId: preference.TelegramChatId}
+	if recipient.Email == "" {
+		if users := service.userRepository.GetById(ctx, []uuid.UUID{userId}); len(users) != 0 {
+			recipient.Email = users[0].Email
+		}
+	}
+	return recipient
+}
+
+func (service *notificationService) getMaxAttempts() int {
+	if service.maxAttempts == 0 {
+		return 5
+	}
+	return service.maxAttempts
+}
+
+func (service *notificationService) getRetryDelay() time.Duration {
+	if service.retryDelay == 0 {
+		return 5 * time.Minute
+	}
+	return service.retryDelay
+}
+
+func (service *notificationService) registerSources(sources ...EventSource) *notificationService {
+	for _, source := range sources {
+		service.sources[source.Event()] = source
+	}
+	return service
+}
+
+func (service *notificationService) schedule(interval time.Duration) *notificationService {
+	if interval == 0 {
+		interval = 15 * time.Minute
+	}
+	scheduler.Schedule("notifications", interval, func(ctx context.Context) {
+		service.ProcessRules(ctx)
+		service.ProcessDeliveries(ctx)
+	})
+	return service
+}
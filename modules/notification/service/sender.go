@@ -0,0 +1,126 @@
+package service
+
+import (
+	"assets/common/config"
+	"assets/common/mail"
+	"assets/modules/notification/model"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var errNoRecipient = errors.New("recipient address is not set")
+
+type Recipient struct {
+	Email          string
+	TelegramChatId string
+}
+
+// Sender delivers notifications through an external channel
+type Sender interface {
+	Channel() string
+	Send(ctx context.Context, recipient Recipient, notification model.Notification) error
+}
+
+type emailSender struct {
+	property config.SmtpProperty
+}
+
+func (sender *emailSender) Channel() string {
+	return model.EmailChannel
+}
+
+func (sender *emailSender) Send(ctx context.Context, recipient Recipient, notification model.Notification) error {
+	if recipient.Email == "" {
+		return errNoRecipient
+	}
+	return mail.Send(sender.property, recipient.Email, notification.Subject, notification.Body)
+}
+
+type telegramSender struct {
+	property config.TelegramProperty
+	client   *http.Client
+}
+
+func (sender *telegramSender) Channel() string {
+	return model.TelegramChannel
+}
+
+func (sender *telegramSender) Send(ctx context.Context, recipient Recipient, notification model.Notification) error {
+	if recipient.TelegramChatId == "" {
+		return errNoRecipient
+	}
+	if sender.property.Url == "" || sender.property.Token == "" {
+		return errors.New("telegram bot is not configured")
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"chat_id": recipient.TelegramChatId,
+		"text":    notification.Subject + "\n\n" + notification.Body,
+	})
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/bot%s/sendMessage", strings.TrimSuffix(sender.property.Url, "/"), sender.property.Token)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := sender.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		responseBody, _ := io.ReadAll(io.LimitReader(response.Body, 512))
+		return fmt.Errorf("telegram responded with %d: %s", response.StatusCode, responseBody)
+	}
+	return nil
+}
+
+func newSenders(property config.NotificationProperty) map[string]Sender {
+	senders := []Sender{
+		&emailSender{property: property.Smtp},
+		&telegramSender{property: property.Telegram, client: &http.Client{Timeout: 10 * time.Second}},
+	}
+
+	result := make(map[string]Sender, len(senders))
+	for _, sender := range senders {
+		result[sender.Channel()] = sender
+	}
+	return result
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"assets/common/config"
+	"assets/modules/notification/model"
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var defaultTemplates = map[string]config.NotificationTemplate{
+	model.UpcomingCashFlowEvent: {
+		Subject: "Upcoming {{.Kind}} for {{.Asset.Name}}",
+		Body:    "{{.CashFlow.Type}} {{.CashFlow.Amount}} {{.CashFlow.Currency}} for {{.Asset.Name}} is due on {{date .CashFlow.Date}}.{{with .CashFlow.Description}}\n{{.}}{{end}}",
+	},
+	model.OverdueWorkOrderEvent: {
+		Subject: "{{if .IsOverdue}}Overdue{{else}}Upcoming{{end}} work order for {{.Asset.Name}}",
+		Body:    "Work order \"{{.WorkOrder.Title}}\" ({{.WorkOrder.Status}}) is due on {{date .WorkOrder.DueDate}}.{{with .WorkOrder.Contractor}}\nContractor: {{.}}{{end}}",
+	},
+	model.DocumentExpiryEvent: {
+		Subject: "Document of {{.Asset.Name}} expires",
+		Body:    "{{.Document.Type}} \"{{.Document.Title}}\"{{with .Document.Number}} No. {{.}}{{end}} expires on {{date .Document.ExpiryDate}}.",
+	},
+	model.TestEvent: {
+		Subject: "Test notification",
+		Body:    "Notifications for {{.User.Username}} are delivered to this channel.",
+	},
+}
+
+var templateFuncs = template.FuncMap{
+	"date": func(value any) string {
+		switch date := value.(type) {
+		case time.Time:
+			return date.Format(time.DateOnly)
+		case *time.Time:
+			if date != nil {
+				return date.Format(time.DateOnly)
+			}
+		}
+		return ""
+	},
+}
+
+// renderTemplate renders the subject and the body of the event, templates from the configuration
+// take precedence over the default ones. Configuration keys are lowercased by viper
+func renderTemplate(event string, data any) (string, string, error) {
+	eventTemplate, ok := config.CoreConfig.Notification.Templates[strings.ToLower(event)]
+	if !ok {
+		if eventTemplate, ok = defaultTemplates[event]; !ok {
+			return "", "", fmt.Errorf("template for event %s not found", event)
+		}
+	}
+
+	subject, err := execute(eventTemplate.Subject, data)
+	if err != nil {
+		return "", "", err
+	}
+	body, err := execute(eventTemplate.Body, data)
+	return subject, body, err
+}
+
+func execute(text string, data any) (string, error) {
+	tmpl, err := template.New("").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buffer bytes.Buffer
+	if err = tmpl.Execute(&buffer, data); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
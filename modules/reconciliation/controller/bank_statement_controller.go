@@ -0,0 +1,159 @@
+package controller
+
+import (
+	commonController "assets/common/controller"
+	commonMiddleware "assets/common/middleware"
+	commonUtil "assets/common/util"
+	"assets/modules/reconciliation/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var bankStatementCntr commonController.HttpController
+
+type bankStatementController struct {
+	service service.BankStatementService
+}
+
+func GetBankStatementController() commonController.HttpController {
+	if bankStatementCntr != nil {
+		return bankStatementCntr
+	}
+	bankStatementCntr = &bankStatementController{service: service.GetBankStatementService()}
+	return bankStatementCntr
+}
+
+func (controller *bankStatementController) RegisterHttpController(router *gin.Engine) {
+	bankStatementRouter := router.Group("/api/reconciliation/statements", commonMiddleware.SecurityHandler)
+
+	bankStatementRouter.GET(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("READ_RECONCILIATION"),
+		controller.getById,
+	)
+
+	bankStatementRouter.GET(
+		"",
+		commonMiddleware.HasAnyAuthorities("READ_RECONCILIATION"),
+		commonMiddleware.PaginationHandler,
+		controller.getAll,
+	)
+
+	bankStatementRouter.POST(
+		"",
+		commonMiddleware.HasAnyAuthorities("EDIT_RECONCILIATION"),
+		controller.importStatement,
+	)
+
+	bankStatementRouter.DELETE(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_RECONCILIATION"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		controller.deleteById,
+	)
+}
+
+// bankStatementController godoc
+// @Security BearerAuth
+// @Summary      getById
+// @Description  Get bank statement with its transactions by id
+// @Tags         BankStatement controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "BankStatement.ID"
+// @Success      200	{object}  model.BankStatement
+// @Failure      400
+// @Failure      500
+// @Router       /api/reconciliation/statements/{id} [GET]
+func (controller *bankStatementController) getById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("BankStatementController: GetById(id: %s): Start", id)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.GetById(ctx, id))
+	log.WithContext(ctx).Info("BankStatementController: GetById(): End")
+}
+
+// bankStatementController godoc
+// @Security BearerAuth
+// @Summary      getAll
+// @Description  Get all bank statements
+// @Tags         BankStatement controller
+// @Accept       json
+// @Produce      json
+// @Success      200	{array}  model.BankStatement
+// @Failure      400
+// @Failure      500
+// @Router       /api/reconciliation/statements/ [GET]
+func (controller *bankStatementController) getAll(ctx *gin.Context) {
+	page := commonUtil.MustGetPageable(ctx)
+	log.WithContext(ctx).Info("BankStatementController: GetAll(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetAll(ctx, page))
+	log.WithContext(ctx).Info("BankStatementController: GetAll(): End")
+}
+
+// bankStatementController godoc
+// @Security BearerAuth
+// @Summary      importStatement
+// @Description  Import CSV bank statement of the asset and reconcile its transactions with the asset's cash flows
+// @Tags         BankStatement controller
+// @Accept       mpfd
+// @Produce      json
+// @Param        assetId	query	string  true  "Asset.ID"
+// @Param        currency	query	string  false  "Currency of transactions if the statement has no currency column"
+// @Param        file		formData	file  true  "CSV bank statement"
+// @Success      201	{object}  model.BankStatement
+// @Failure      400
+// @Failure      500
+// @Router       /api/reconciliation/statements/ [POST]
+func (controller *bankStatementController) importStatement(ctx *gin.Context) {
+	assetId := uuid.MustParse(ctx.Query("assetId"))
+	log.WithContext(ctx).Infof("BankStatementController: Import(assetId: %s): Start", assetId)
+	fileHeader := commonUtil.MustOne(ctx.FormFile("file"))
+	ctx.AbortWithStatusJSON(http.StatusCreated, controller.service.Import(ctx, assetId, ctx.Query("currency"), fileHeader))
+	log.WithContext(ctx).Info("BankStatementController: Import(): End")
+}
+
+// bankStatementController godoc
+// @Security BearerAuth
+// @Summary      deleteById
+// @Description  Delete bank statement with its transactions and matches by id
+// @Tags         BankStatement controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "BankStatement.ID"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/reconciliation/statements/{id} [DELETE]
+func (controller *bankStatementController) deleteById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("BankStatementController: DeleteById(id: %s): Start", id)
+	controller.service.DeleteById(ctx, id)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("BankStatementController: DeleteById(): End")
+}
@@ -0,0 +1,271 @@
+package controller
+
+import (
+	commonController "assets/common/controller"
+	commonMiddleware "assets/common/middleware"
+	commonResolver "assets/common/resolver"
+	commonUtil "assets/common/util"
+	"assets/modules/reconciliation/model"
+	"assets/modules/reconciliation/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var reconciliationCntr commonController.HttpController
+
+type reconciliationController struct {
+	service service.ReconciliationService
+}
+
+func GetReconciliationController() commonController.HttpController {
+	if reconciliationCntr != nil {
+		return reconciliationCntr
+	}
+	reconciliationCntr = &reconciliationController{service: service.GetReconciliationService()}
+	return reconciliationCntr
+}
+
+func (controller *reconciliationController) RegisterHttpController(router *gin.Engine) {
+	reconciliationRouter := router.Group("/api/reconciliation", commonMiddleware.SecurityHandler)
+
+	reconciliationRouter.POST(
+		"/assets/:assetId",
+		commonMiddleware.HasAnyAuthorities("EDIT_RECONCILIATION"),
+		controller.reconcile,
+	)
+
+	reconciliationRouter.GET(
+		"/report",
+		commonMiddleware.HasAnyAuthorities("READ_RECONCILIATION"),
+		controller.getReport,
+	)
+
+	reconciliationRouter.GET(
+		"/matches",
+		commonMiddleware.HasAnyAuthorities("READ_RECONCILIATION"),
+		commonMiddleware.PaginationHandler,
+		commonMiddleware.FilterHandler[model.MatchFilter],
+		controller.getAllMatches,
+	)
+
+	reconciliationRouter.POST(
+		"/matches",
+		commonMiddleware.HasAnyAuthorities("EDIT_RECONCILIATION"),
+		commonResolver.Resolver[model.MatchRequest],
+		controller.match,
+	)
+
+	reconciliationRouter.POST(
+		"/matches/split",
+		commonMiddleware.HasAnyAuthorities("EDIT_RECONCILIATION"),
+		commonResolver.Resolver[model.SplitRequest],
+		controller.split,
+	)
+
+	reconciliationRouter.POST(
+		"/matches/merge",
+		commonMiddleware.HasAnyAuthorities("EDIT_RECONCILIATION"),
+		commonResolver.Resolver[model.MergeRequest],
+		controller.merge,
+	)
+
+	reconciliationRouter.PUT(
+		"/matches/:id/confirm",
+		commonMiddleware.HasAnyAuthorities("EDIT_RECONCILIATION"),
+		controller.confirm,
+	)
+
+	reconciliationRouter.DELETE(
+		"/matches/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_RECONCILIATION"),
+		controller.deleteMatchById,
+	)
+}
+
+// reconciliationController godoc
+// @Security BearerAuth
+// @Summary      reconcile
+// @Description  Match unmatched bank transactions of the asset with its cash flows
+// @Tags         Reconciliation controller
+// @Accept       json
+// @Produce      json
+// @Param        assetId	path     string  true  "Asset.ID"
+// @Success      200	{array}  model.ReconciliationMatch
+// @Failure      400
+// @Failure      500
+// @Router       /api/reconciliation/assets/{assetId} [POST]
+func (controller *reconciliationController) reconcile(ctx *gin.Context) {
+	assetId := uuid.MustParse(ctx.Param("assetId"))
+	log.WithContext(ctx).Infof("ReconciliationController: Reconcile(assetId: %s): Start", assetId)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.Reconcile(ctx, assetId))
+	log.WithContext(ctx).Info("ReconciliationController: Reconcile(): End")
+}
+
+// reconciliationController godoc
+// @Security BearerAuth
+// @Summary      getReport
+// @Description  Get unmatched transactions and cash flows per asset for the period
+// @Tags         Reconciliation controller
+// @Accept       json
+// @Produce      json
+// @Param        assetId	query	string  false  "Asset.ID"
+// @Param        from		query	string  false  "Period start, RFC3339 or YYYY-MM-DD"
+// @Param        to			query	string  false  "Period end (exclusive), RFC3339 or YYYY-MM-DD"
+// @Success      200	{array}  model.ReconciliationReport
+// @Failure      400
+// @Failure      500
+// @Router       /api/reconciliation/report [GET]
+func (controller *reconciliationController) getReport(ctx *gin.Context) {
+	var assetId *uuid.UUID
+	if value := ctx.Query("assetId"); value != "" {
+		id := uuid.MustParse(value)
+		assetId = &id
+	}
+	from, to := commonUtil.GetTimeQuery(ctx, "from"), commonUtil.GetTimeQuery(ctx, "to")
+	log.WithContext(ctx).Infof("ReconciliationController: GetReport(assetId: %v, from: %v, to: %v): Start", assetId, from, to)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetReport(ctx, assetId, from, to))
+	log.WithContext(ctx).Info("ReconciliationController: GetReport(): End")
+}
+
+// reconciliationController godoc
+// @Security BearerAuth
+// @Summary      getAllMatches
+// @Description  Get all reconciliation matches
+// @Tags         Reconciliation controller
+// @Accept       json
+// @Produce      json
+// @Param        assetId	query	string  false  "ReconciliationMatch.AssetID"
+// @Param        status		query	string  false  "enum(SUGGESTED, CONFIRMED)"
+// @Success      200	{array}  model.ReconciliationMatch
+// @Failure      400
+// @Failure      500
+// @Router       /api/reconciliation/matches [GET]
+func (controller *reconciliationController) getAllMatches(ctx *gin.Context) {
+	page := commonUtil.MustGetPageable(ctx)
+	filter := commonUtil.MustGetFilterObject[model.MatchFilter](ctx)
+	log.WithContext(ctx).Info("ReconciliationController: GetAllMatches(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetAllMatches(ctx, filter, page))
+	log.WithContext(ctx).Info("ReconciliationController: GetAllMatches(): End")
+}
+
+// reconciliationController godoc
+// @Security BearerAuth
+// @Summary      match
+// @Description  Manually match bank transactions with cash flows of the same asset, the amounts must be equal
+// @Tags         Reconciliation controller
+// @Accept       json
+// @Produce      json
+// @Param        request	body	  model.MatchRequest  true  "Transactions and cash flows"
+// @Success      201	{object}  model.ReconciliationMatch
+// @Failure      400
+// @Failure      500
+// @Router       /api/reconciliation/matches [POST]
+func (controller *reconciliationController) match(ctx *gin.Context) {
+	log.WithContext(ctx).Info("ReconciliationController: Match(): Start")
+	request := ctx.MustGet("RequestBody").(model.MatchRequest)
+	ctx.AbortWithStatusJSON(http.StatusCreated, controller.service.Match(ctx, request))
+	log.WithContext(ctx).Info("ReconciliationController: Match(): End")
+}
+
+// reconciliationController godoc
+// @Security BearerAuth
+// @Summary      split
+// @Description  Split bank transaction into several new cash flows of the asset
+// @Tags         Reconciliation controller
+// @Accept       json
+// @Produce      json
+// @Param        request	body	  model.SplitRequest  true  "Transaction and cash flow parts"
+// @Success      201	{object}  model.ReconciliationMatch
+// @Failure      400
+// @Failure      500
+// @Router       /api/reconciliation/matches/split [POST]
+func (controller *reconciliationController) split(ctx *gin.Context) {
+	log.WithContext(ctx).Info("ReconciliationController: Split(): Start")
+	request := ctx.MustGet("RequestBody").(model.SplitRequest)
+	ctx.AbortWithStatusJSON(http.StatusCreated, controller.service.Split(ctx, request))
+	log.WithContext(ctx).Info("ReconciliationController: Split(): End")
+}
+
+// reconciliationController godoc
+// @Security BearerAuth
+// @Summary      merge
+// @Description  Merge several bank transactions into one new cash flow of the asset
+// @Tags         Reconciliation controller
+// @Accept       json
+// @Produce      json
+// @Param        request	body	  model.MergeRequest  true  "Transactions and cash flow"
+// @Success      201	{object}  model.ReconciliationMatch
+// @Failure      400
+// @Failure      500
+// @Router       /api/reconciliation/matches/merge [POST]
+func (controller *reconciliationController) merge(ctx *gin.Context) {
+	log.WithContext(ctx).Info("ReconciliationController: Merge(): Start")
+	request := ctx.MustGet("RequestBody").(model.MergeRequest)
+	ctx.AbortWithStatusJSON(http.StatusCreated, controller.service.Merge(ctx, request))
+	log.WithContext(ctx).Info("ReconciliationController: Merge(): End")
+}
+
+// reconciliationController godoc
+// @Security BearerAuth
+// @Summary      confirm
+// @Description  Confirm suggested reconciliation match
+// @Tags         Reconciliation controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "ReconciliationMatch.ID"
+// @Success      200	{object}  model.ReconciliationMatch
+// @Failure      400
+// @Failure      500
+// @Router       /api/reconciliation/matches/{id}/confirm [PUT]
+func (controller *reconciliationController) confirm(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("ReconciliationController: Confirm(id: %s): Start", id)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.Confirm(ctx, id))
+	log.WithContext(ctx).Info("ReconciliationController: Confirm(): End")
+}
+
+// reconciliationController godoc
+// @Security BearerAuth
+// @Summary      deleteMatchById
+// @Description  Reject suggested or cancel confirmed match, its transactions and cash flows become unmatched
+// @Tags         Reconciliation controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "ReconciliationMatch.ID"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/reconciliation/matches/{id} [DELETE]
+func (controller *reconciliationController) deleteMatchById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("ReconciliationController: DeleteMatchById(id: %s): Start", id)
+	controller.service.DeleteMatchById(ctx, id)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("ReconciliationController: DeleteMatchById(): End")
+}
@@ -0,0 +1,113 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const (
+	UnmatchedTransactionStatus = "UNMATCHED"
+	SuggestedTransactionStatus = "SUGGESTED"
+	MatchedTransactionStatus   = "MATCHED"
+)
+
+// BankStatement is an imported statement of the bank account the asset's cash flows go through
+type BankStatement struct {
+	ID           uuid.UUID          `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	AssetID      uuid.UUID          `json:"assetId,omitempty" gorm:"type:uuid;index"`
+	FileName     string             `json:"fileName,omitempty"`
+	ImportDate   *time.Time         `json:"importDate,omitempty"`
+	Transactions []*BankTransaction `json:"transactions,omitempty" gorm:"foreignKey:StatementID"`
+	commonModel.Versioned
+}
+
+func (statement BankStatement) GetID() uuid.UUID {
+	return statement.ID
+}
+
+func (statement *BankStatement) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(statement.ID) {
+		statement.ID = uuid.New()
+	}
+	if statement.ImportDate == nil {
+		now := time.Now()
+		statement.ImportDate = &now
+	}
+	return nil
+}
+
+// BankTransaction is a line of the bank statement, positive amounts are incomes and negative ones are expenses
+type BankTransaction struct {
+	ID           uuid.UUID  `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	StatementID  uuid.UUID  `json:"statementId,omitempty" gorm:"type:uuid;index"`
+	AssetID      uuid.UUID  `json:"assetId,omitempty" gorm:"type:uuid;index"`
+	MatchID      *uuid.UUID `json:"matchId,omitempty" gorm:"type:uuid;index"`
+	Date         *time.Time `json:"date,omitempty"`
+	Amount       float64    `json:"amount,omitempty"`
+	Currency     string     `json:"currency,omitempty"`
+	Counterparty string     `json:"counterparty,omitempty"`
+	Description  string     `json:"description,omitempty"`
+	Reference    string     `json:"reference,omitempty"`
+	Status       string     `json:"status,omitempty"`
+	commonModel.Versioned
+}
+
+func (transaction BankTransaction) GetID() uuid.UUID {
+	return transaction.ID
+}
+
+func (transaction *BankTransaction) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(transaction.ID) {
+		transaction.ID = uuid.New()
+	}
+	if transaction.Status == "" {
+		transaction.Status = UnmatchedTransactionStatus
+	}
+	return nil
+}
+
+func (transaction BankTransaction) IsIncome() bool {
+	return transaction.Amount > 0
+}
+
+func (transaction BankTransaction) IsMatched() bool {
+	return transaction.MatchID != nil
+}
+
+func NewBankStatement(assetId uuid.UUID, fileName string, transactions []*BankTransaction) BankStatement {
+	for _, transaction := range transactions {
+		transaction.AssetID = assetId
+	}
+	return BankStatement{
+		AssetID:      assetId,
+		FileName:     fileName,
+		Transactions: transactions,
+	}
+}
@@ -0,0 +1,130 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	assetModel "assets/modules/asset/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const (
+	AutoMatchType   = "AUTO"
+	ManualMatchType = "MANUAL"
+	SplitMatchType  = "SPLIT"
+	MergeMatchType  = "MERGE"
+)
+
+const (
+	SuggestedMatchStatus = "SUGGESTED"
+	ConfirmedMatchStatus = "CONFIRMED"
+)
+
+// ReconciliationMatch links bank transactions with the cash flows they correspond to.
+// Automatic matches are one to one, manual ones may link several transactions with several cash flows
+type ReconciliationMatch struct {
+	ID           uuid.UUID              `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	AssetID      uuid.UUID              `json:"assetId,omitempty" gorm:"type:uuid;index"`
+	Type         string                 `json:"type,omitempty"`
+	Status       string                 `json:"status,omitempty"`
+	Confidence   float64                `json:"confidence,omitempty"`
+	CreateDate   *time.Time             `json:"createDate,omitempty"`
+	Transactions []*BankTransaction     `json:"transactions,omitempty" gorm:"foreignKey:MatchID"`
+	CashFlows    []*assetModel.CashFlow `json:"cashFlows,omitempty" gorm:"many2many:reconciliation_match_cash_flow;"`
+	commonModel.Versioned
+}
+
+func (match ReconciliationMatch) GetID() uuid.UUID {
+	return match.ID
+}
+
+func (match *ReconciliationMatch) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(match.ID) {
+		match.ID = uuid.New()
+	}
+	if match.CreateDate == nil {
+		now := time.Now()
+		match.CreateDate = &now
+	}
+	return nil
+}
+
+func (match ReconciliationMatch) TransactionStatus() string {
+	if match.Status == ConfirmedMatchStatus {
+		return MatchedTransactionStatus
+	}
+	return SuggestedTransactionStatus
+}
+
+func NewReconciliationMatch(assetId uuid.UUID, matchType string, cashFlows []*assetModel.CashFlow, opts ...ReconciliationMatchOption) ReconciliationMatch {
+	match := ReconciliationMatch{
+		AssetID:    assetId,
+		Type:       matchType,
+		Status:     ConfirmedMatchStatus,
+		Confidence: 1,
+		CashFlows:  cashFlows,
+	}
+
+	for _, opt := range opts {
+		opt(&match)
+	}
+
+	return match
+}
+
+type ReconciliationMatchOption func(*ReconciliationMatch)
+
+func ReconciliationMatchSuggested(confidence float64) ReconciliationMatchOption {
+	return func(match *ReconciliationMatch) {
+		match.Status = SuggestedMatchStatus
+		match.Confidence = confidence
+	}
+}
+
+type MatchFilter struct {
+	AssetID string `form:"assetId"`
+	Status  string `form:"status"`
+}
+
+// MatchRequest links existing transactions with existing cash flows
+type MatchRequest struct {
+	TransactionIDs []uuid.UUID `json:"transactionIds,omitempty"`
+	CashFlowIDs    []uuid.UUID `json:"cashFlowIds,omitempty"`
+}
+
+// SplitRequest creates cash flows from the parts of one transaction, the parts must sum up to its amount
+type SplitRequest struct {
+	TransactionID uuid.UUID             `json:"transactionId,omitempty"`
+	Parts         []assetModel.CashFlow `json:"parts,omitempty"`
+}
+
+// MergeRequest creates one cash flow from several transactions, the amount is the sum of the transactions
+type MergeRequest struct {
+	TransactionIDs []uuid.UUID         `json:"transactionIds,omitempty"`
+	CashFlow       assetModel.CashFlow `json:"cashFlow,omitempty"`
+}
@@ -0,0 +1,721 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	model "assets/modules/asset/model"
+	json "encoding/json"
+	uuid "github.com/google/uuid"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson1e3597b5DecodeAssetsModulesReconciliationModel(in *jlexer.Lexer, out *SplitRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "transactionId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.TransactionID).UnmarshalText(data))
+			}
+		case "parts":
+			if in.IsNull() {
+				in.Skip()
+				out.Parts = nil
+			} else {
+				in.Delim('[')
+				if out.Parts == nil {
+					if !in.IsDelim(']') {
+						out.Parts = make([]model.CashFlow, 0, 0)
+					} else {
+						out.Parts = []model.CashFlow{}
+					}
+				} else {
+					out.Parts = (out.Parts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 model.CashFlow
+					(v1).UnmarshalEasyJSON(in)
+					out.Parts = append(out.Parts, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1e3597b5EncodeAssetsModulesReconciliationModel(out *jwriter.Writer, in SplitRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"transactionId\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.TransactionID).MarshalText())
+	}
+	if len(in.Parts) != 0 {
+		const prefix string = ",\"parts\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Parts {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SplitRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1e3597b5EncodeAssetsModulesReconciliationModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SplitRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1e3597b5EncodeAssetsModulesReconciliationModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SplitRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1e3597b5DecodeAssetsModulesReconciliationModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SplitRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1e3597b5DecodeAssetsModulesReconciliationModel(l, v)
+}
+func easyjson1e3597b5DecodeAssetsModulesReconciliationModel1(in *jlexer.Lexer, out *ReconciliationMatch) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "assetId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AssetID).UnmarshalText(data))
+			}
+		case "type":
+			out.Type = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "confidence":
+			out.Confidence = float64(in.Float64())
+		case "createDate":
+			if in.IsNull() {
+				in.Skip()
+				out.CreateDate = nil
+			} else {
+				if out.CreateDate == nil {
+					out.CreateDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreateDate).UnmarshalJSON(data))
+				}
+			}
+		case "transactions":
+			if in.IsNull() {
+				in.Skip()
+				out.Transactions = nil
+			} else {
+				in.Delim('[')
+				if out.Transactions == nil {
+					if !in.IsDelim(']') {
+						out.Transactions = make([]*BankTransaction, 0, 8)
+					} else {
+						out.Transactions = []*BankTransaction{}
+					}
+				} else {
+					out.Transactions = (out.Transactions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 *BankTransaction
+					if in.IsNull() {
+						in.Skip()
+						v4 = nil
+					} else {
+						if v4 == nil {
+							v4 = new(BankTransaction)
+						}
+						(*v4).UnmarshalEasyJSON(in)
+					}
+					out.Transactions = append(out.Transactions, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "cashFlows":
+			if in.IsNull() {
+				in.Skip()
+				out.CashFlows = nil
+			} else {
+				in.Delim('[')
+				if out.CashFlows == nil {
+					if !in.IsDelim(']') {
+						out.CashFlows = make([]*model.CashFlow, 0, 8)
+					} else {
+						out.CashFlows = []*model.CashFlow{}
+					}
+				} else {
+					out.CashFlows = (out.CashFlows)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 *model.CashFlow
+					if in.IsNull() {
+						in.Skip()
+						v5 = nil
+					} else {
+						if v5 == nil {
+							v5 = new(model.CashFlow)
+						}
+						(*v5).UnmarshalEasyJSON(in)
+					}
+					out.CashFlows = append(out.CashFlows, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "version":
+			out.Version = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1e3597b5EncodeAssetsModulesReconciliationModel1(out *jwriter.Writer, in ReconciliationMatch) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"assetId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.AssetID).MarshalText())
+	}
+	if in.Type != "" {
+		const prefix string = ",\"type\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Type))
+	}
+	if in.Status != "" {
+		const prefix string = ",\"status\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Status))
+	}
+	if in.Confidence != 0 {
+		const prefix string = ",\"confidence\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.Confidence))
+	}
+	if in.CreateDate != nil {
+		const prefix string = ",\"createDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.CreateDate).MarshalJSON())
+	}
+	if len(in.Transactions) != 0 {
+		const prefix string = ",\"transactions\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v6, v7 := range in.Transactions {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				if v7 == nil {
+					out.RawString("null")
+				} else {
+					(*v7).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.CashFlows) != 0 {
+		const prefix string = ",\"cashFlows\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.CashFlows {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				if v9 == nil {
+					out.RawString("null")
+				} else {
+					(*v9).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReconciliationMatch) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1e3597b5EncodeAssetsModulesReconciliationModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReconciliationMatch) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1e3597b5EncodeAssetsModulesReconciliationModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReconciliationMatch) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1e3597b5DecodeAssetsModulesReconciliationModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReconciliationMatch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1e3597b5DecodeAssetsModulesReconciliationModel1(l, v)
+}
+func easyjson1e3597b5DecodeAssetsModulesReconciliationModel2(in *jlexer.Lexer, out *MergeRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "transactionIds":
+			if in.IsNull() {
+				in.Skip()
+				out.TransactionIDs = nil
+			} else {
+				in.Delim('[')
+				if out.TransactionIDs == nil {
+					if !in.IsDelim(']') {
+						out.TransactionIDs = make([]uuid.UUID, 0, 4)
+					} else {
+						out.TransactionIDs = []uuid.UUID{}
+					}
+				} else {
+					out.TransactionIDs = (out.TransactionIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 uuid.UUID
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((v10).UnmarshalText(data))
+					}
+					out.TransactionIDs = append(out.TransactionIDs, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "cashFlow":
+			(out.CashFlow).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1e3597b5EncodeAssetsModulesReconciliationModel2(out *jwriter.Writer, in MergeRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if len(in.TransactionIDs) != 0 {
+		const prefix string = ",\"transactionIds\":"
+		first = false
+		out.RawString(prefix[1:])
+		{
+			out.RawByte('[')
+			for v11, v12 := range in.TransactionIDs {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v12).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	if true {
+		const prefix string = ",\"cashFlow\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(in.CashFlow).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MergeRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1e3597b5EncodeAssetsModulesReconciliationModel2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MergeRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1e3597b5EncodeAssetsModulesReconciliationModel2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MergeRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1e3597b5DecodeAssetsModulesReconciliationModel2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MergeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1e3597b5DecodeAssetsModulesReconciliationModel2(l, v)
+}
+func easyjson1e3597b5DecodeAssetsModulesReconciliationModel3(in *jlexer.Lexer, out *MatchRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "transactionIds":
+			if in.IsNull() {
+				in.Skip()
+				out.TransactionIDs = nil
+			} else {
+				in.Delim('[')
+				if out.TransactionIDs == nil {
+					if !in.IsDelim(']') {
+						out.TransactionIDs = make([]uuid.UUID, 0, 4)
+					} else {
+						out.TransactionIDs = []uuid.UUID{}
+					}
+				} else {
+					out.TransactionIDs = (out.TransactionIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 uuid.UUID
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((v13).UnmarshalText(data))
+					}
+					out.TransactionIDs = append(out.TransactionIDs, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "cashFlowIds":
+			if in.IsNull() {
+				in.Skip()
+				out.CashFlowIDs = nil
+			} else {
+				in.Delim('[')
+				if out.CashFlowIDs == nil {
+					if !in.IsDelim(']') {
+						out.CashFlowIDs = make([]uuid.UUID, 0, 4)
+					} else {
+						out.CashFlowIDs = []uuid.UUID{}
+					}
+				} else {
+					out.CashFlowIDs = (out.CashFlowIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v14 uuid.UUID
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((v14).UnmarshalText(data))
+					}
+					out.CashFlowIDs = append(out.CashFlowIDs, v14)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1e3597b5EncodeAssetsModulesReconciliationModel3(out *jwriter.Writer, in MatchRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if len(in.TransactionIDs) != 0 {
+		const prefix string = ",\"transactionIds\":"
+		first = false
+		out.RawString(prefix[1:])
+		{
+			out.RawByte('[')
+			for v15, v16 := range in.TransactionIDs {
+				if v15 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v16).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.CashFlowIDs) != 0 {
+		const prefix string = ",\"cashFlowIds\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v17, v18 := range in.CashFlowIDs {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v18).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MatchRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1e3597b5EncodeAssetsModulesReconciliationModel3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MatchRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1e3597b5EncodeAssetsModulesReconciliationModel3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MatchRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1e3597b5DecodeAssetsModulesReconciliationModel3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MatchRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1e3597b5DecodeAssetsModulesReconciliationModel3(l, v)
+}
+func easyjson1e3597b5DecodeAssetsModulesReconciliationModel4(in *jlexer.Lexer, out *MatchFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "AssetID":
+			out.AssetID = string(in.String())
+		case "Status":
+			out.Status = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1e3597b5EncodeAssetsModulesReconciliationModel4(out *jwriter.Writer, in MatchFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"AssetID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AssetID))
+	}
+	{
+		const prefix string = ",\"Status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MatchFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1e3597b5EncodeAssetsModulesReconciliationModel4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MatchFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1e3597b5EncodeAssetsModulesReconciliationModel4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MatchFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1e3597b5DecodeAssetsModulesReconciliationModel4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MatchFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1e3597b5DecodeAssetsModulesReconciliationModel4(l, v)
+}
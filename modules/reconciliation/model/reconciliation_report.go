@@ -0,0 +1,47 @@
+package model
+
+import (
+	assetModel "assets/modules/asset/model"
+	"github.com/google/uuid"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+// ReconciliationReport lists the asset's items which are not reconciled yet for the period
+type ReconciliationReport struct {
+	AssetID               uuid.UUID             `json:"assetId,omitempty"`
+	AssetName             string                `json:"assetName,omitempty"`
+	From                  *time.Time            `json:"from,omitempty"`
+	To                    *time.Time            `json:"to,omitempty"`
+	MatchedCount          int64                 `json:"matchedCount,omitempty"`
+	UnmatchedTransactions []BankTransaction     `json:"unmatchedTransactions,omitempty"`
+	UnmatchedIncomes      []assetModel.CashFlow `json:"unmatchedIncomes,omitempty"`
+	UnmatchedExpenses     []assetModel.CashFlow `json:"unmatchedExpenses,omitempty"`
+}
+
+func (report ReconciliationReport) HasUnmatched() bool {
+	return len(report.UnmatchedTransactions) != 0 || len(report.UnmatchedIncomes) != 0 || len(report.UnmatchedExpenses) != 0
+}
@@ -0,0 +1,284 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	model "assets/modules/asset/model"
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson3f7d68aeDecodeAssetsModulesReconciliationModel(in *jlexer.Lexer, out *ReconciliationReport) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "assetId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AssetID).UnmarshalText(data))
+			}
+		case "assetName":
+			out.AssetName = string(in.String())
+		case "from":
+			if in.IsNull() {
+				in.Skip()
+				out.From = nil
+			} else {
+				if out.From == nil {
+					out.From = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.From).UnmarshalJSON(data))
+				}
+			}
+		case "to":
+			if in.IsNull() {
+				in.Skip()
+				out.To = nil
+			} else {
+				if out.To == nil {
+					out.To = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.To).UnmarshalJSON(data))
+				}
+			}
+		case "matchedCount":
+			out.MatchedCount = int64(in.Int64())
+		case "unmatchedTransactions":
+			if in.IsNull() {
+				in.Skip()
+				out.UnmatchedTransactions = nil
+			} else {
+				in.Delim('[')
+				if out.UnmatchedTransactions == nil {
+					if !in.IsDelim(']') {
+						out.UnmatchedTransactions = make([]BankTransaction, 0, 0)
+					} else {
+						out.UnmatchedTransactions = []BankTransaction{}
+					}
+				} else {
+					out.UnmatchedTransactions = (out.UnmatchedTransactions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 BankTransaction
+					(v1).UnmarshalEasyJSON(in)
+					out.UnmatchedTransactions = append(out.UnmatchedTransactions, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "unmatchedIncomes":
+			if in.IsNull() {
+				in.Skip()
+				out.UnmatchedIncomes = nil
+			} else {
+				in.Delim('[')
+				if out.UnmatchedIncomes == nil {
+					if !in.IsDelim(']') {
+						out.UnmatchedIncomes = make([]model.CashFlow, 0, 0)
+					} else {
+						out.UnmatchedIncomes = []model.CashFlow{}
+					}
+				} else {
+					out.UnmatchedIncomes = (out.UnmatchedIncomes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 model.CashFlow
+					(v2).UnmarshalEasyJSON(in)
+					out.UnmatchedIncomes = append(out.UnmatchedIncomes, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "unmatchedExpenses":
+			if in.IsNull() {
+				in.Skip()
+				out.UnmatchedExpenses = nil
+			} else {
+				in.Delim('[')
+				if out.UnmatchedExpenses == nil {
+					if !in.IsDelim(']') {
+						out.UnmatchedExpenses = make([]model.CashFlow, 0, 0)
+					} else {
+						out.UnmatchedExpenses = []model.CashFlow{}
+					}
+				} else {
+					out.UnmatchedExpenses = (out.UnmatchedExpenses)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 model.CashFlow
+					(v3).UnmarshalEasyJSON(in)
+					out.UnmatchedExpenses = append(out.UnmatchedExpenses, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3f7d68aeEncodeAssetsModulesReconciliationModel(out *jwriter.Writer, in ReconciliationReport) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"assetId\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.AssetID).MarshalText())
+	}
+	if in.AssetName != "" {
+		const prefix string = ",\"assetName\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.AssetName))
+	}
+	if in.From != nil {
+		const prefix string = ",\"from\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.From).MarshalJSON())
+	}
+	if in.To != nil {
+		const prefix string = ",\"to\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.To).MarshalJSON())
+	}
+	if in.MatchedCount != 0 {
+		const prefix string = ",\"matchedCount\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.MatchedCount))
+	}
+	if len(in.UnmatchedTransactions) != 0 {
+		const prefix string = ",\"unmatchedTransactions\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v4, v5 := range in.UnmatchedTransactions {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				(v5).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.UnmatchedIncomes) != 0 {
+		const prefix string = ",\"unmatchedIncomes\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v6, v7 := range in.UnmatchedIncomes {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				(v7).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.UnmatchedExpenses) != 0 {
+		const prefix string = ",\"unmatchedExpenses\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.UnmatchedExpenses {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReconciliationReport) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3f7d68aeEncodeAssetsModulesReconciliationModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReconciliationReport) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3f7d68aeEncodeAssetsModulesReconciliationModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReconciliationReport) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3f7d68aeDecodeAssetsModulesReconciliationModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReconciliationReport) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3f7d68aeDecodeAssetsModulesReconciliationModel(l, v)
+}
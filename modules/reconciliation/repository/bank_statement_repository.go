@@ -0,0 +1,84 @@
+package repository
+
+import (
+	commonDB "assets/common/db"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	assetRepository "assets/modules/asset/repository"
+	"assets/modules/reconciliation/model"
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var bankStatementRepo BankStatementRepository
+
+type BankStatementRepository interface {
+	commonRepository.Repository[model.BankStatement]
+	DeleteWithTransactions(ctx context.Context, id uuid.UUID)
+}
+
+type bankStatementRepository struct {
+	commonRepository.Repository[model.BankStatement]
+	*commonDB.DataSource
+}
+
+func GetBankStatementRepository() BankStatementRepository {
+	if bankStatementRepo != nil {
+		return bankStatementRepo
+	}
+	bankStatementRepo = &bankStatementRepository{
+		commonRepository.NewBaseRepository[model.BankStatement](
+			commonDB.GetDataSource(),
+			commonRepository.WithReadScope[model.BankStatement](assetRepository.AssetIdScope("asset_id", false)),
+			commonRepository.WithWriteScope[model.BankStatement](assetRepository.AssetIdScope("asset_id", true)),
+		),
+		commonDB.GetDataSource(),
+	}
+	return bankStatementRepo
+}
+
+// DeleteWithTransactions deletes the statement, its transactions and the matches they are part of,
+// so the matched cash flows become unmatched again
+func (repo *bankStatementRepository) DeleteWithTransactions(ctx context.Context, id uuid.UUID) {
+	commonUtil.Must(repo.DataSource.Transaction(func(tx *gorm.DB) error {
+		var matchIds []uuid.UUID
+		if err := tx.Model(&model.BankTransaction{}).Distinct().
+			Where("statement_id = ? and match_id is not null", id).
+			Pluck("match_id", &matchIds).Error; err != nil {
+			return err
+		}
+		if err := deleteMatches(tx, matchIds); err != nil {
+			return err
+		}
+		if err := tx.Where("statement_id = ?", id).Delete(&model.BankTransaction{}).Error; err != nil {
+			return err
+		}
+		query, locked := commonRepository.LockDeleteVersion(ctx, tx.Where("id = ?", id), []uuid.UUID{id})
+		return commonRepository.CheckDeleted(query.Delete(&model.BankStatement{}), locked)
+	}))
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	commonDB "assets/common/db"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	assetRepository "assets/modules/asset/repository"
+	"assets/modules/reconciliation/model"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var bankTransactionRepo BankTransactionRepository
+
+type BankTransactionRepository interface {
+	commonRepository.Repository[model.BankTransaction]
+	FindUnmatched(ctx context.Context, assetId uuid.UUID, from *time.Time, to *time.Time) []model.BankTransaction
+}
+
+type bankTransactionRepository struct {
+	commonRepository.Repository[model.BankTransaction]
+	*commonDB.DataSource
+}
+
+func GetBankTransactionRepository() BankTransactionRepository {
+	if bankTransactionRepo != nil {
+		return bankTransactionRepo
+	}
+	bankTransactionRepo = &bankTransactionRepository{
+		commonRepository.NewBaseRepository[model.BankTransaction](
+			commonDB.GetDataSource(),
+			commonRepository.WithReadScope[model.BankTransaction](assetRepository.AssetIdScope("asset_id", false)),
+			commonRepository.WithWriteScope[model.BankTransaction](assetRepository.AssetIdScope("asset_id", true)),
+		),
+		commonDB.GetDataSource(),
+	}
+	return bankTransactionRepo
+}
+
+func (repo *bankTransactionRepository) FindUnmatched(ctx context.Context, assetId uuid.UUID, from *time.Time, to *time.Time) []model.BankTransaction {
+	query := assetRepository.AssetIdScope("asset_id", false)(ctx, repo.DataSource.DB).
+		Where("asset_id = ? and match_id is null", assetId)
+	if from != nil {
+		query = query.Where("date >= ?", from)
+	}
+	if to != nil {
+		query = query.Where("date < ?", to)
+	}
+
+	var result []model.BankTransaction
+	commonUtil.Must(query.Order("date").Find(&result).Error)
+	return result
+}
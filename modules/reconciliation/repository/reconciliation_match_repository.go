@@ -0,0 +1,179 @@
+package repository
+
+import (
+	commonError "assets/common/custom_error"
+	commonDB "assets/common/db"
+	commonModel "assets/common/model"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	assetModel "assets/modules/asset/model"
+	assetRepository "assets/modules/asset/repository"
+	"assets/modules/reconciliation/model"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var reconciliationMatchRepo ReconciliationMatchRepository
+
+type ReconciliationMatchRepository interface {
+	commonRepository.Repository[model.ReconciliationMatch]
+	FindAllWithPage(ctx context.Context, filter model.MatchFilter, page commonModel.Pageable) commonModel.Page[model.ReconciliationMatch]
+	FindUnmatchedCashFlows(ctx context.Context, assetId uuid.UUID, from *time.Time, to *time.Time) (incomes []assetModel.CashFlow, expenses []assetModel.CashFlow)
+	CountMatched(ctx context.Context, assetId uuid.UUID, from *time.Time, to *time.Time) int64
+	SaveMatch(ctx context.Context, match model.ReconciliationMatch, transactionIds []uuid.UUID) model.ReconciliationMatch
+	Confirm(ctx context.Context, id uuid.UUID)
+	DeleteMatch(ctx context.Context, id uuid.UUID)
+	InTransaction(ctx context.Context, fn func(ctx context.Context))
+}
+
+type reconciliationMatchRepository struct {
+	commonRepository.Repository[model.ReconciliationMatch]
+	*commonDB.DataSource
+}
+
+func GetReconciliationMatchRepository() ReconciliationMatchRepository {
+	if reconciliationMatchRepo != nil {
+		return reconciliationMatchRepo
+	}
+	reconciliationMatchRepo = &reconciliationMatchRepository{
+		commonRepository.NewBaseRepository[model.ReconciliationMatch](
+			commonDB.GetDataSource(),
+			commonRepository.WithReadScope[model.ReconciliationMatch](assetRepository.AssetIdScope("asset_id", false)),
+			commonRepository.WithWriteScope[model.ReconciliationMatch](assetRepository.AssetIdScope("asset_id", true)),
+		),
+		commonDB.GetDataSource(),
+	}
+	return reconciliationMatchRepo
+}
+
+func (repo *reconciliationMatchRepository) FindAllWithPage(ctx context.Context, filter model.MatchFilter, page commonModel.Pageable) commonModel.Page[model.ReconciliationMatch] {
+	query := assetRepository.AssetIdScope("asset_id", false)(ctx, repo.DataSource.Model(&model.ReconciliationMatch{}))
+	if filter.AssetID != "" {
+		query = query.Where("asset_id = ?", uuid.MustParse(filter.AssetID))
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var total int64
+	commonUtil.Must(query.Count(&total).Error)
+
+	var result []model.ReconciliationMatch
+	query = query.Preload(clause.Associations).Limit(page.Size).Offset(page.Page * page.Size)
+	query = commonRepository.OrderBy[model.ReconciliationMatch](query, page.Sort, "create_date desc")
+	commonUtil.Must(query.Find(&result).Error)
+	return commonModel.NewPage[model.ReconciliationMatch](result, page).WithTotal(int(total))
+}
+
+// FindUnmatchedCashFlows returns dated incomes and expenses of the asset which are not part of any match
+func (repo *reconciliationMatchRepository) FindUnmatchedCashFlows(ctx context.Context, assetId uuid.UUID, from *time.Time, to *time.Time) ([]assetModel.CashFlow, []assetModel.CashFlow) {
+	find := func(joinTable string) []assetModel.CashFlow {
+		query := repo.DataSource.Model(&assetModel.CashFlow{}).
+			Where(fmt.Sprintf("id in (select cash_flow_id from %s where asset_id = ?)", joinTable), assetId).
+			Where("id not in (select cash_flow_id from reconciliation_match_cash_flow)").
+			Where("date is not null")
+		if from != nil {
+			query = query.Where("date >= ?", from)
+		}
+		if to != nil {
+			query = query.Where("date < ?", to)
+		}
+
+		var result []assetModel.CashFlow
+		commonUtil.Must(query.Order("date").Find(&result).Error)
+		return result
+	}
+	return find("asset_income_cash_flow"), find("asset_expense_cash_flow")
+}
+
+func (repo *reconciliationMatchRepository) CountMatched(ctx context.Context, assetId uuid.UUID, from *time.Time, to *time.Time) int64 {
+	query := repo.DataSource.Model(&model.BankTransaction{}).
+		Where("asset_id = ? and status = ?", assetId, model.MatchedTransactionStatus)
+	if from != nil {
+		query = query.Where("date >= ?", from)
+	}
+	if to != nil {
+		query = query.Where("date < ?", to)
+	}
+
+	var count int64
+	commonUtil.Must(query.Count(&count).Error)
+	return count
+}
+
+// SaveMatch stores the match with its cash flows and links the transactions to it, it panics with AlreadyExists
+// when any transaction got matched in the meantime, e.g. by a concurrent request
+func (repo *reconciliationMatchRepository) SaveMatch(ctx context.Context, match model.ReconciliationMatch, transactionIds []uuid.UUID) model.ReconciliationMatch {
+	transactionIds = commonUtil.Unique(transactionIds)
+	repo.DataSource.InTransaction(ctx, func(ctx context.Context) {
+		tx := repo.DataSource.For(ctx)
+		commonUtil.Must(tx.Omit("Transactions", "CashFlows.*").Create(&match).Error)
+		result := tx.Model(&model.BankTransaction{}).Where("id in ? and match_id is null", transactionIds).
+			Updates(map[string]any{"match_id": match.ID, "status": match.TransactionStatus()})
+		commonUtil.Must(result.Error)
+		if result.RowsAffected < int64(len(transactionIds)) {
+			panic(commonError.AlreadyExists)
+		}
+	})
+	return repo.GetById(ctx, []uuid.UUID{match.ID})[0]
+}
+
+func (repo *reconciliationMatchRepository) Confirm(ctx context.Context, id uuid.UUID) {
+	commonUtil.Must(repo.DataSource.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.ReconciliationMatch{}).Where("id = ?", id).
+			Update("status", model.ConfirmedMatchStatus).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.BankTransaction{}).Where("match_id = ?", id).
+			Update("status", model.MatchedTransactionStatus).Error
+	}))
+}
+
+// DeleteMatch deletes the match, its transactions and cash flows become unmatched
+func (repo *reconciliationMatchRepository) DeleteMatch(ctx context.Context, id uuid.UUID) {
+	commonUtil.Must(repo.DataSource.Transaction(func(tx *gorm.DB) error {
+		return deleteMatches(tx, []uuid.UUID{id})
+	}))
+}
+
+func deleteMatches(tx *gorm.DB, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := tx.Exec("delete from reconciliation_match_cash_flow where reconciliation_match_id in ?", ids).Error; err != nil {
+		return err
+	}
+	if err := tx.Model(&model.BankTransaction{}).Where("match_id in ?", ids).
+		Updates(map[string]any{"match_id": nil, "status": model.UnmatchedTransactionStatus}).Error; err != nil {
+		return err
+	}
+	return tx.Where("id in ?", ids).Delete(&model.ReconciliationMatch{}).Error
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	commonCache "assets/common/cache"
+	commonError "assets/common/custom_error"
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	assetService "assets/modules/asset/service"
+	"assets/modules/reconciliation/model"
+	"assets/modules/reconciliation/repository"
+	"context"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"mime/multipart"
+	"strings"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var bankStatementSrv BankStatementService
+
+type BankStatementService interface {
+	GetById(ctx context.Context, id uuid.UUID) model.BankStatement
+	GetAll(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.BankStatement]
+	Import(ctx context.Context, assetId uuid.UUID, currency string, fileHeader *multipart.FileHeader) model.BankStatement
+	DeleteById(ctx context.Context, id uuid.UUID)
+}
+
+type bankStatementService struct {
+	repository            repository.BankStatementRepository
+	assetService          assetService.AssetService
+	reconciliationService ReconciliationService
+	cache                 *commonCache.Cache[commonModel.Page[model.BankStatement]]
+}
+
+func GetBankStatementService() BankStatementService {
+	if bankStatementSrv != nil {
+		return bankStatementSrv
+	}
+
+	bankStatementSrv = &bankStatementService{
+		repository:            repository.GetBankStatementRepository(),
+		assetService:          assetService.GetAssetService(),
+		reconciliationService: GetReconciliationService(),
+		cache:                 commonCache.NewCache[commonModel.Page[model.BankStatement]]("bankStatements", 24*time.Hour),
+	}
+
+	return bankStatementSrv
+}
+
+func (service *bankStatementService) GetById(ctx context.Context, id uuid.UUID) model.BankStatement {
+	result := service.repository.GetById(ctx, []uuid.UUID{id})
+	if len(result) == 0 {
+		panic(commonError.NotFoundError)
+	}
+	return result[0]
+}
+
+func (service *bankStatementService) GetAll(ctx context.Context, page commonModel.Pageable) commonModel.Page[model.BankStatement] {
+	result, err := service.cache.Get(ctx)
+	if err == nil {
+		return result
+	}
+	return service.cache.Set(ctx, service.repository.GetAllWithPage(ctx, page))
+}
+
+// Import stores transactions of the CSV statement and reconciles them with the asset's cash flows.
+// The currency is used for the transactions if the statement has no currency column
+func (service *bankStatementService) Import(ctx context.Context, assetId uuid.UUID, currency string, fileHeader *multipart.FileHeader) model.BankStatement {
+	transactions, err := parseStatement(commonUtil.GetData(fileHeader), strings.ToUpper(currency))
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Warnf("BankStatementService: couldn't parse statement %s", fileHeader.Filename)
+		panic(commonError.InvalidStatementFileError)
+	}
+
+	service.assetService.CheckWritable(ctx, assetId)
+	defer service.cache.Evict(ctx)
+	statement := service.repository.Create(ctx, []model.BankStatement{model.NewBankStatement(assetId, fileHeader.Filename, transactions)})[0]
+
+	matches := service.reconciliationService.Reconcile(ctx, assetId)
+	log.WithContext(ctx).Infof("BankStatementService: %d transactions imported, %d matched", len(transactions), len(matches))
+	return service.GetById(ctx, statement.ID)
+}
+
+func (service *bankStatementService) DeleteById(ctx context.Context, id uuid.UUID) {
+	service.assetService.CheckWritable(ctx, service.GetById(ctx, id).AssetID)
+	defer service.cache.Evict(ctx)
+	service.repository.DeleteWithTransactions(ctx, id)
+}
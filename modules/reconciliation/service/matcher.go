@@ -0,0 +1,154 @@
+package service
+
+import (
+	"assets/common/config"
+	assetModel "assets/modules/asset/model"
+	"assets/modules/reconciliation/model"
+	"github.com/google/uuid"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const (
+	amountWeight       = 0.5
+	dateWeight         = 0.3
+	counterpartyWeight = 0.2
+)
+
+type matcher struct {
+	dateWindow        time.Duration
+	amountTolerance   float64
+	suggestConfidence float64
+}
+
+type candidate struct {
+	transaction *model.BankTransaction
+	cashFlow    *assetModel.CashFlow
+	confidence  float64
+}
+
+func newMatcher(property config.ReconciliationProperty) *matcher {
+	result := &matcher{
+		dateWindow:        time.Duration(property.DateWindowDays) * 24 * time.Hour,
+		amountTolerance:   property.AmountTolerance,
+		suggestConfidence: property.SuggestConfidence,
+	}
+	if result.dateWindow == 0 {
+		result.dateWindow = 5 * 24 * time.Hour
+	}
+	if result.suggestConfidence == 0 {
+		result.suggestConfidence = 0.5
+	}
+	return result
+}
+
+// match pairs transactions with cash flows one to one, the most confident pairs are taken first
+func (matcher *matcher) match(transactions []model.BankTransaction, incomes []assetModel.CashFlow, expenses []assetModel.CashFlow) []candidate {
+	var candidates []candidate
+	for i := range transactions {
+		cashFlows := expenses
+		if transactions[i].IsIncome() {
+			cashFlows = incomes
+		}
+		for j := range cashFlows {
+			confidence := matcher.score(transactions[i], cashFlows[j])
+			if confidence >= matcher.suggestConfidence {
+				candidates = append(candidates, candidate{&transactions[i], &cashFlows[j], confidence})
+			}
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].confidence > candidates[j].confidence })
+
+	var result []candidate
+	usedTransactions, usedCashFlows := make(map[uuid.UUID]bool), make(map[uuid.UUID]bool)
+	for _, it := range candidates {
+		if usedTransactions[it.transaction.ID] || usedCashFlows[it.cashFlow.ID] {
+			continue
+		}
+		usedTransactions[it.transaction.ID], usedCashFlows[it.cashFlow.ID] = true, true
+		result = append(result, it)
+	}
+	return result
+}
+
+// score estimates the probability the transaction is the cash flow by amount, date and counterparty,
+// a pair in a different currency, with a too different amount or out of the date window scores zero
+func (matcher *matcher) score(transaction model.BankTransaction, cashFlow assetModel.CashFlow) float64 {
+	if transaction.Date == nil || cashFlow.Date == nil || !strings.EqualFold(transaction.Currency, cashFlow.Currency) {
+		return 0
+	}
+
+	amount := math.Abs(transaction.Amount)
+	amountDiff := math.Abs(amount - math.Abs(cashFlow.Amount))
+	allowedDiff := math.Max(amount*matcher.amountTolerance, 0.005)
+	if amountDiff > allowedDiff {
+		return 0
+	}
+
+	dateDiff := transaction.Date.Sub(*cashFlow.Date).Abs()
+	if dateDiff > matcher.dateWindow {
+		return 0
+	}
+
+	return amountWeight*(1-amountDiff/allowedDiff/2) +
+		dateWeight*(1-float64(dateDiff)/float64(matcher.dateWindow)/2) +
+		counterpartyWeight*similarity(transaction.Counterparty+" "+transaction.Description, cashFlow.Counterparty+" "+cashFlow.Description)
+}
+
+// similarity is a share of the shorter text words found in the other one
+func similarity(first string, second string) float64 {
+	firstWords, secondWords := words(first), words(second)
+	if len(firstWords) == 0 || len(secondWords) == 0 {
+		return 0
+	}
+	if len(firstWords) > len(secondWords) {
+		firstWords, secondWords = secondWords, firstWords
+	}
+
+	var found int
+	for word := range firstWords {
+		if secondWords[word] {
+			found++
+		}
+	}
+	return float64(found) / float64(len(firstWords))
+}
+
+func words(text string) map[string]bool {
+	result := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if len([]rune(word)) > 2 {
+			result[word] = true
+		}
+	}
+	return result
+}
@@ -0,0 +1,312 @@
+package service
+
+import (
+	"assets/common/config"
+	commonError "assets/common/custom_error"
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	assetModel "assets/modules/asset/model"
+	assetRepository "assets/modules/asset/repository"
+	assetService "assets/modules/asset/service"
+	"assets/modules/reconciliation/model"
+	"assets/modules/reconciliation/repository"
+	"context"
+	"github.com/google/uuid"
+	"math"
+	"strings"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const amountEpsilon = 0.005
+
+var reconciliationSrv ReconciliationService
+
+type ReconciliationService interface {
+	Reconcile(ctx context.Context, assetId uuid.UUID) []model.ReconciliationMatch
+	GetAllMatches(ctx context.Context, filter model.MatchFilter, page commonModel.Pageable) commonModel.Page[model.ReconciliationMatch]
+	Confirm(ctx context.Context, id uuid.UUID) model.ReconciliationMatch
+	DeleteMatchById(ctx context.Context, id uuid.UUID)
+
+	Match(ctx context.Context, request model.MatchRequest) model.ReconciliationMatch
+	Split(ctx context.Context, request model.SplitRequest) model.ReconciliationMatch
+	Merge(ctx context.Context, request model.MergeRequest) model.ReconciliationMatch
+
+	GetReport(ctx context.Context, assetId *uuid.UUID, from *time.Time, to *time.Time) []model.ReconciliationReport
+}
+
+type reconciliationService struct {
+	repository            repository.ReconciliationMatchRepository
+	transactionRepository repository.BankTransactionRepository
+	assetRepository       assetRepository.AssetRepository
+	assetService          assetService.AssetService
+	matcher               *matcher
+	autoConfirmConfidence float64
+}
+
+func GetReconciliationService() ReconciliationService {
+	if reconciliationSrv != nil {
+		return reconciliationSrv
+	}
+
+	property := config.CoreConfig.Reconciliation
+	reconciliationSrv = &reconciliationService{
+		repository:            repository.GetReconciliationMatchRepository(),
+		transactionRepository: repository.GetBankTransactionRepository(),
+		assetRepository:       assetRepository.GetAssetRepository(),
+		assetService:          assetService.GetAssetService(),
+		matcher:               newMatcher(property),
+		autoConfirmConfidence: property.AutoConfirmConfidence,
+	}
+
+	return reconciliationSrv
+}
+
+// Reconcile matches unmatched transactions of the asset with its unmatched cash flows. Confident matches
+// are confirmed right away, the other ones are suggested to be confirmed manually
+func (service *reconciliationService) Reconcile(ctx context.Context, assetId uuid.UUID) []model.ReconciliationMatch {
+	service.assetService.CheckWritable(ctx, assetId)
+
+	transactions := service.transactionRepository.FindUnmatched(ctx, assetId, nil, nil)
+	if len(transactions) == 0 {
+		return []model.ReconciliationMatch{}
+	}
+	from := transactions[0].Date.Add(-service.matcher.dateWindow)
+	to := transactions[len(transactions)-1].Date.Add(service.matcher.dateWindow + time.Nanosecond)
+	incomes, expenses := service.repository.FindUnmatchedCashFlows(ctx, assetId, &from, &to)
+
+	result := make([]model.ReconciliationMatch, 0)
+	for _, candidate := range service.matcher.match(transactions, incomes, expenses) {
+		var opts []model.ReconciliationMatchOption
+		if candidate.confidence < service.getAutoConfirmConfidence() {
+			opts = append(opts, model.ReconciliationMatchSuggested(candidate.confidence))
+		}
+		match := model.NewReconciliationMatch(assetId, model.AutoMatchType, []*assetModel.CashFlow{candidate.cashFlow}, opts...)
+		match.Confidence = candidate.confidence
+		result = append(result, service.repository.SaveMatch(ctx, match, []uuid.UUID{candidate.transaction.ID}))
+	}
+	return result
+}
+
+func (service *reconciliationService) GetAllMatches(ctx context.Context, filter model.MatchFilter, page commonModel.Pageable) commonModel.Page[model.ReconciliationMatch] {
+	return service.repository.FindAllWithPage(ctx, filter, page)
+}
+
+func (service *reconciliationService) Confirm(ctx context.Context, id uuid.UUID) model.ReconciliationMatch {
+	match := service.getMatchById(ctx, id)
+	service.assetService.CheckWritable(ctx, match.AssetID)
+	service.repository.Confirm(ctx, id)
+	return service.getMatchById(ctx, id)
+}
+
+func (service *reconciliationService) DeleteMatchById(ctx context.Context, id uuid.UUID) {
+	service.assetService.CheckWritable(ctx, service.getMatchById(ctx, id).AssetID)
+	service.repository.DeleteMatch(ctx, id)
+}
+
+// Match links existing transactions with existing cash flows of the same asset, amounts must be equal
+func (service *reconciliationService) Match(ctx context.Context, request model.MatchRequest) model.ReconciliationMatch {
+	transactions := service.getUnmatchedTransactions(ctx, request.TransactionIDs)
+	assetId := transactions[0].AssetID
+	if len(request.CashFlowIDs) == 0 {
+		panic(commonError.IllegalArgumentError)
+	}
+
+	incomes, expenses := service.repository.FindUnmatchedCashFlows(ctx, assetId, nil, nil)
+	var cashFlows []*assetModel.CashFlow
+	var cashFlowsAmount float64
+	for _, id := range commonUtil.Unique(request.CashFlowIDs) {
+		if ok, income := commonUtil.ArrayFindFirst(incomes, func(it assetModel.CashFlow) bool { return it.ID == id }); ok {
+			cashFlows, cashFlowsAmount = append(cashFlows, &income), cashFlowsAmount+income.Amount
+		} else if ok, expense := commonUtil.ArrayFindFirst(expenses, func(it assetModel.CashFlow) bool { return it.ID == id }); ok {
+			cashFlows, cashFlowsAmount = append(cashFlows, &expense), cashFlowsAmount-expense.Amount
+		} else {
+			// the cash flow is either matched already or doesn't belong to the asset
+			panic(commonError.NotFoundError)
+		}
+	}
+
+	if math.Abs(sumAmounts(transactions)-cashFlowsAmount) > amountEpsilon {
+		panic(commonError.UnbalancedMatchError)
+	}
+
+	match := model.NewReconciliationMatch(assetId, model.ManualMatchType, cashFlows)
+	return service.repository.SaveMatch(ctx, match, request.TransactionIDs)
+}
+
+// Split registers the parts of one transaction as separate cash flows of the asset, the cash flows are
+// created in the transaction of the match, so they are rolled back if the match fails
+func (service *reconciliationService) Split(ctx context.Context, request model.SplitRequest) (result model.ReconciliationMatch) {
+	transaction := service.getUnmatchedTransactions(ctx, []uuid.UUID{request.TransactionID})[0]
+	if len(request.Parts) == 0 {
+		panic(commonError.IllegalArgumentError)
+	}
+
+	var partsAmount float64
+	for _, part := range request.Parts {
+		if part.Amount <= 0 {
+			panic(commonError.IllegalArgumentError)
+		}
+		partsAmount += part.Amount
+	}
+	if math.Abs(math.Abs(transaction.Amount)-partsAmount) > amountEpsilon {
+		panic(commonError.UnbalancedMatchError)
+	}
+
+	service.repository.InTransaction(ctx, func(ctx context.Context) {
+		cashFlows := commonUtil.Map(request.Parts, func(part assetModel.CashFlow) *assetModel.CashFlow {
+			cashFlow := service.addCashFlow(ctx, transaction, fillFromTransaction(part, transaction))
+			return &cashFlow
+		})
+		match := model.NewReconciliationMatch(transaction.AssetID, model.SplitMatchType, cashFlows)
+		result = service.repository.SaveMatch(ctx, match, []uuid.UUID{transaction.ID})
+	})
+	return result
+}
+
+// Merge registers several transactions of the same direction and currency as one cash flow of the asset,
+// the cash flow is created in the transaction of the match
+func (service *reconciliationService) Merge(ctx context.Context, request model.MergeRequest) (result model.ReconciliationMatch) {
+	transactions := service.getUnmatchedTransactions(ctx, request.TransactionIDs)
+	last := transactions[0]
+	for _, transaction := range transactions {
+		if transaction.IsIncome() != last.IsIncome() || !strings.EqualFold(transaction.Currency, last.Currency) {
+			panic(commonError.IllegalArgumentError)
+		}
+		if transaction.Date.After(*last.Date) {
+			last = transaction
+		}
+	}
+
+	cashFlow := request.CashFlow
+	cashFlow.Amount = math.Abs(sumAmounts(transactions))
+	service.repository.InTransaction(ctx, func(ctx context.Context) {
+		cashFlow = service.addCashFlow(ctx, last, fillFromTransaction(cashFlow, last))
+		match := model.NewReconciliationMatch(last.AssetID, model.MergeMatchType, []*assetModel.CashFlow{&cashFlow})
+		result = service.repository.SaveMatch(ctx, match, request.TransactionIDs)
+	})
+	return result
+}
+
+// GetReport returns not reconciled items of the asset, or of every available asset having any, for the period
+func (service *reconciliationService) GetReport(ctx context.Context, assetId *uuid.UUID, from *time.Time, to *time.Time) []model.ReconciliationReport {
+	var assets []assetModel.Asset
+	if assetId != nil {
+		assets = append(assets, service.assetService.GetById(ctx, *assetId))
+	} else {
+		assets = service.assetRepository.GetAll(ctx)
+	}
+
+	result := make([]model.ReconciliationReport, 0)
+	for _, asset := range assets {
+		incomes, expenses := service.repository.FindUnmatchedCashFlows(ctx, asset.ID, from, to)
+		report := model.ReconciliationReport{
+			AssetID:               asset.ID,
+			AssetName:             asset.Name,
+			From:                  from,
+			To:                    to,
+			MatchedCount:          service.repository.CountMatched(ctx, asset.ID, from, to),
+			UnmatchedTransactions: service.transactionRepository.FindUnmatched(ctx, asset.ID, from, to),
+			UnmatchedIncomes:      incomes,
+			UnmatchedExpenses:     expenses,
+		}
+		if assetId != nil || report.HasUnmatched() {
+			result = append(result, report)
+		}
+	}
+	return result
+}
+
+func (service *reconciliationService) getMatchById(ctx context.Context, id uuid.UUID) model.ReconciliationMatch {
+	result := service.repository.GetById(ctx, []uuid.UUID{id})
+	if len(result) == 0 {
+		panic(commonError.NotFoundError)
+	}
+	return result[0]
+}
+
+// getUnmatchedTransactions returns the transactions checking they are not matched yet
+// and belong to the same asset the current user may edit
+func (service *reconciliationService) getUnmatchedTransactions(ctx context.Context, ids []uuid.UUID) []model.BankTransaction {
+	ids = commonUtil.Unique(ids)
+	if len(ids) == 0 {
+		panic(commonError.IllegalArgumentError)
+	}
+
+	transactions := service.transactionRepository.GetById(ctx, ids)
+	if len(transactions) != len(ids) {
+		panic(commonError.NotFoundError)
+	}
+	for _, transaction := range transactions {
+		if transaction.IsMatched() {
+			panic(commonError.AlreadyExists)
+		}
+		if transaction.AssetID != transactions[0].AssetID {
+			panic(commonError.IllegalArgumentError)
+		}
+	}
+	service.assetService.CheckWritable(ctx, transactions[0].AssetID)
+	return transactions
+}
+
+func (service *reconciliationService) addCashFlow(ctx context.Context, transaction model.BankTransaction, cashFlow assetModel.CashFlow) assetModel.CashFlow {
+	if transaction.IsIncome() {
+		return service.assetService.AddIncome(ctx, transaction.AssetID, cashFlow)
+	}
+	return service.assetService.AddExpense(ctx, transaction.AssetID, cashFlow)
+}
+
+func (service *reconciliationService) getAutoConfirmConfidence() float64 {
+	if service.autoConfirmConfidence == 0 {
+		return 0.9
+	}
+	return service.autoConfirmConfidence
+}
+
+func fillFromTransaction(cashFlow assetModel.CashFlow, transaction model.BankTransaction) assetModel.CashFlow {
+	cashFlow.ID = uuid.Nil
+	if cashFlow.Date == nil {
+		cashFlow.Date = transaction.Date
+	}
+	if cashFlow.Currency == "" {
+		cashFlow.Currency = transaction.Currency
+	}
+	if cashFlow.Counterparty == "" {
+		cashFlow.Counterparty = transaction.Counterparty
+	}
+	if cashFlow.Description == "" {
+		cashFlow.Description = transaction.Description
+	}
+	return cashFlow
+}
+
+func sumAmounts(transactions []model.BankTransaction) float64 {
+	var result float64
+	for _, transaction := range transactions {
+		result += transaction.Amount
+	}
+	return result
+}
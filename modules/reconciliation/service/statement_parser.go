@@ -0,0 +1,183 @@
+package service
+
+import (
+	"assets/modules/reconciliation/model"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var statementColumns = map[string][]string{
+	"date":         {"date", "transaction date", "operation date", "booking date", "дата", "дата операции"},
+	"amount":       {"amount", "sum", "сумма", "сумма операции"},
+	"debit":        {"debit", "withdrawal", "списание", "расход"},
+	"credit":       {"credit", "deposit", "зачисление", "приход"},
+	"currency":     {"currency", "валюта"},
+	"counterparty": {"counterparty", "payee", "payer", "beneficiary", "контрагент", "получатель", "плательщик"},
+	"description":  {"description", "details", "purpose", "назначение платежа", "описание"},
+	"reference":    {"reference", "id", "transaction id", "номер", "номер документа"},
+}
+
+var statementDateLayouts = []string{time.DateOnly, "02.01.2006", "02/01/2006", time.RFC3339, "2006-01-02 15:04:05"}
+
+// parseStatement reads a CSV bank statement with a header row. Columns are recognized by the common names,
+// the amount is either a signed amount column or a pair of debit and credit columns
+func parseStatement(data []byte, defaultCurrency string) ([]*model.BankTransaction, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = detectDelimiter(data)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) < 2 {
+		return nil, errors.New("statement has no transactions")
+	}
+
+	columns := mapColumns(records[0])
+	if _, ok := columns["date"]; !ok {
+		return nil, errors.New("date column not found")
+	}
+	_, hasAmount := columns["amount"]
+	_, hasDebit := columns["debit"]
+	_, hasCredit := columns["credit"]
+	if !hasAmount && !hasDebit && !hasCredit {
+		return nil, errors.New("amount column not found")
+	}
+
+	var transactions []*model.BankTransaction
+	for i, record := range records[1:] {
+		value := func(column string) string {
+			if index, ok := columns[column]; ok && index < len(record) {
+				return strings.TrimSpace(record[index])
+			}
+			return ""
+		}
+		if strings.Join(record, "") == "" {
+			continue
+		}
+
+		date, err := parseDate(value("date"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+2, err)
+		}
+
+		var amount float64
+		if hasAmount {
+			amount, err = parseAmount(value("amount"))
+		} else {
+			var debit, credit float64
+			if debit, err = parseAmount(value("debit")); err == nil {
+				credit, err = parseAmount(value("credit"))
+			}
+			amount = credit - debit
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+2, err)
+		}
+
+		currency := strings.ToUpper(value("currency"))
+		if currency == "" {
+			currency = defaultCurrency
+		}
+
+		transactions = append(transactions, &model.BankTransaction{
+			Date:         &date,
+			Amount:       amount,
+			Currency:     currency,
+			Counterparty: value("counterparty"),
+			Description:  value("description"),
+			Reference:    value("reference"),
+		})
+	}
+	return transactions, nil
+}
+
+func detectDelimiter(data []byte) rune {
+	header, _, _ := bytes.Cut(data, []byte("\n"))
+	delimiter, maxCount := ',', 0
+	for _, candidate := range []rune{',', ';', '\t'} {
+		if count := bytes.Count(header, []byte(string(candidate))); count > maxCount {
+			delimiter, maxCount = candidate, count
+		}
+	}
+	return delimiter
+}
+
+func mapColumns(header []string) map[string]int {
+	result := make(map[string]int)
+	for index, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		for column, aliases := range statementColumns {
+			if _, ok := result[column]; ok {
+				continue
+			}
+			for _, alias := range aliases {
+				if name == alias {
+					result[column] = index
+				}
+			}
+		}
+	}
+	return result
+}
+
+func parseDate(value string) (time.Time, error) {
+	for _, layout := range statementDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("couldn't parse date %q", value)
+}
+
+// parseAmount accepts both decimal separators and spaces as thousands separators, e.g. "-1 234,56"
+func parseAmount(value string) (float64, error) {
+	value = strings.NewReplacer(" ", "", " ", "", "'", "").Replace(value)
+	if value == "" {
+		return 0, nil
+	}
+	if strings.Contains(value, ",") {
+		if strings.Contains(value, ".") {
+			value = strings.ReplaceAll(value, ",", "")
+		} else {
+			value = strings.ReplaceAll(value, ",", ".")
+		}
+	}
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't parse amount %q", value)
+	}
+	return amount, nil
+}
@@ -0,0 +1,226 @@
+package controller
+
+import (
+	commonController "assets/common/controller"
+	commonMiddleware "assets/common/middleware"
+	commonResolver "assets/common/resolver"
+	commonUtil "assets/common/util"
+	"assets/modules/view/model"
+	"assets/modules/view/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var savedViewCntr commonController.HttpController
+
+type savedViewController struct {
+	service service.SavedViewService
+}
+
+func GetSavedViewController() commonController.HttpController {
+	if savedViewCntr != nil {
+		return savedViewCntr
+	}
+	savedViewCntr = &savedViewController{service: service.GetSavedViewService()}
+	return savedViewCntr
+}
+
+func (controller *savedViewController) RegisterHttpController(router *gin.Engine) {
+	savedViewRouter := router.Group("/api/view/views", commonMiddleware.SecurityHandler)
+
+	savedViewRouter.GET(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("READ_VIEW"),
+		controller.getById,
+	)
+
+	savedViewRouter.GET(
+		"",
+		commonMiddleware.HasAnyAuthorities("READ_VIEW"),
+		commonMiddleware.PaginationHandler,
+		commonMiddleware.FilterHandler[model.SavedViewFilter],
+		controller.getAll,
+	)
+
+	savedViewRouter.POST(
+		"",
+		commonMiddleware.HasAnyAuthorities("EDIT_VIEW"),
+		commonResolver.Resolver[model.SavedView],
+		controller.create,
+	)
+
+	savedViewRouter.PUT(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_VIEW"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.UpdateResolver[model.SavedView],
+		controller.update,
+	)
+
+	savedViewRouter.PATCH(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_VIEW"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		commonResolver.PatchResolver(controller.service.GetById),
+		controller.update,
+	)
+
+	savedViewRouter.DELETE(
+		"/:id",
+		commonMiddleware.HasAnyAuthorities("EDIT_VIEW"),
+		commonMiddleware.IfMatchHandler(controller.service.GetById),
+		controller.deleteById,
+	)
+
+	savedViewRouter.PUT(
+		"/:id/shares",
+		commonMiddleware.HasAnyAuthorities("EDIT_VIEW"),
+		commonResolver.Resolver[[]uuid.UUID],
+		controller.updateShares,
+	)
+}
+
+// savedViewController godoc
+// @Security BearerAuth
+// @Summary      getById
+// @Description  Get own or shared with current user saved view by id
+// @Tags         SavedView controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "SavedView.ID"
+// @Success      200	{object}  model.SavedView
+// @Failure      400
+// @Failure      500
+// @Router       /api/view/views/{id} [GET]
+func (controller *savedViewController) getById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("SavedViewController: GetById(id: %s): Start", id)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.GetById(ctx, id))
+	log.WithContext(ctx).Info("SavedViewController: GetById(): End")
+}
+
+// savedViewController godoc
+// @Security BearerAuth
+// @Summary      getAll
+// @Description  Get own and shared with current user saved views
+// @Tags         SavedView controller
+// @Accept       json
+// @Produce      json
+// @Param        entity	query	string  false  "SavedView.Entity (ASSET, CASH_FLOW, DOCUMENT, WORK_ORDER, HOLDING)"
+// @Success      200	{array}  model.SavedView
+// @Failure      400
+// @Failure      500
+// @Router       /api/view/views/ [GET]
+func (controller *savedViewController) getAll(ctx *gin.Context) {
+	page := commonUtil.MustGetPageable(ctx)
+	filter := commonUtil.MustGetFilterObject[model.SavedViewFilter](ctx)
+	log.WithContext(ctx).Info("SavedViewController: GetAll(): Start")
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.GetAll(ctx, filter, page))
+	log.WithContext(ctx).Info("SavedViewController: GetAll(): End")
+}
+
+// savedViewController godoc
+// @Security BearerAuth
+// @Summary      create
+// @Description  Create saved view of current user
+// @Tags         SavedView controller
+// @Accept       json
+// @Produce      json
+// @Param        view	body	  model.SavedView  true  "Create SavedView"
+// @Success      201	{object}  model.SavedView
+// @Failure      400
+// @Failure      500
+// @Router       /api/view/views/ [POST]
+func (controller *savedViewController) create(ctx *gin.Context) {
+	log.WithContext(ctx).Info("SavedViewController: Create(): Start")
+	view := ctx.MustGet("RequestBody").(model.SavedView)
+	commonUtil.AbortWithEntity(ctx, http.StatusCreated, controller.service.Create(ctx, view))
+	log.WithContext(ctx).Info("SavedViewController: Create(): End")
+}
+
+// savedViewController godoc
+// @Security BearerAuth
+// @Summary      update
+// @Description  Update own saved view
+// @Tags         SavedView controller
+// @Accept       json
+// @Produce      json
+// @Param        view	body	  model.SavedView  true  "Update SavedView"
+// @Success      200	{object}  model.SavedView
+// @Failure      400
+// @Failure      500
+// @Router       /api/view/views/{id} [PUT]
+// @Router       /api/view/views/{id} [PATCH]
+func (controller *savedViewController) update(ctx *gin.Context) {
+	log.WithContext(ctx).Info("SavedViewController: Update(): Start")
+	view := ctx.MustGet("RequestBody").(model.SavedView)
+	commonUtil.AbortWithEntity(ctx, http.StatusOK, controller.service.Update(ctx, view))
+	log.WithContext(ctx).Info("SavedViewController: Update(): End")
+}
+
+// savedViewController godoc
+// @Security BearerAuth
+// @Summary      deleteById
+// @Description  Delete own saved view
+// @Tags         SavedView controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "SavedView.ID"
+// @Success      200
+// @Failure      400
+// @Failure      500
+// @Router       /api/view/views/{id} [DELETE]
+func (controller *savedViewController) deleteById(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("SavedViewController: DeleteById(id: %s): Start", id)
+	controller.service.DeleteById(ctx, id)
+	ctx.AbortWithStatusJSON(http.StatusOK, gin.H{"message": "OK"})
+	log.WithContext(ctx).Info("SavedViewController: DeleteById(): End")
+}
+
+// savedViewController godoc
+// @Security BearerAuth
+// @Summary      updateShares
+// @Description  Replace users the own saved view is shared with
+// @Tags         SavedView controller
+// @Accept       json
+// @Produce      json
+// @Param        id		path     string  true  "SavedView.ID"
+// @Param        userIds	body	  []string  true  "User ids"
+// @Success      200	{object}  model.SavedView
+// @Failure      400
+// @Failure      500
+// @Router       /api/view/views/{id}/shares [PUT]
+func (controller *savedViewController) updateShares(ctx *gin.Context) {
+	id := uuid.MustParse(ctx.Param("id"))
+	log.WithContext(ctx).Infof("SavedViewController: UpdateShares(id: %s): Start", id)
+	userIds := ctx.MustGet("RequestBody").([]uuid.UUID)
+	ctx.AbortWithStatusJSON(http.StatusOK, controller.service.UpdateShares(ctx, id, userIds))
+	log.WithContext(ctx).Info("SavedViewController: UpdateShares(): End")
+}
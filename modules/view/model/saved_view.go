@@ -0,0 +1,102 @@
+package model
+
+import (
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+const (
+	AssetView     = "ASSET"
+	CashFlowView  = "CASH_FLOW"
+	DocumentView  = "DOCUMENT"
+	WorkOrderView = "WORK_ORDER"
+	HoldingView   = "HOLDING"
+)
+
+var ViewEntities = []string{AssetView, CashFlowView, DocumentView, WorkOrderView, HoldingView}
+
+// SavedView keeps list settings of the entity: Filter holds the query parameters of the entity list
+// endpoint, e.g. {"tagId": ["..."]}, Columns are the visible columns in their order
+type SavedView struct {
+	ID         uuid.UUID           `json:"id,omitempty" gorm:"type:uuid;primaryKey"`
+	OwnerID    uuid.UUID           `json:"ownerId,omitempty" gorm:"type:uuid;index"`
+	Name       string              `json:"name,omitempty"`
+	Entity     string              `json:"entity,omitempty" gorm:"index"`
+	Filter     map[string][]string `json:"filter,omitempty" gorm:"serializer:json"`
+	Sort       *commonModel.Sort   `json:"sort,omitempty" gorm:"serializer:json"`
+	Columns    []string            `json:"columns,omitempty" gorm:"serializer:json"`
+	Shares     []*SavedViewShare   `json:"shares,omitempty" gorm:"foreignKey:ViewID"`
+	CreateDate *time.Time          `json:"createDate,omitempty"`
+	commonModel.Versioned
+}
+
+func (view SavedView) GetID() uuid.UUID {
+	return view.ID
+}
+
+func (view *SavedView) BeforeCreate(tx *gorm.DB) error {
+	if commonUtil.IsZeroObject(view.ID) {
+		view.ID = uuid.New()
+	}
+	if view.CreateDate == nil {
+		now := time.Now()
+		view.CreateDate = &now
+	}
+	return nil
+}
+
+func (view SavedView) IsValid() bool {
+	if strings.TrimSpace(view.Name) == "" || !commonUtil.ArrayContains(ViewEntities, view.Entity) {
+		return false
+	}
+	if view.Sort != nil && (view.Sort.Field == "" || view.Sort.Order != commonModel.Asc && view.Sort.Order != commonModel.Desc) {
+		return false
+	}
+	for key := range view.Filter {
+		if key == "" {
+			return false
+		}
+	}
+	return true
+}
+
+func (view SavedView) IsSharedWith(userId uuid.UUID) bool {
+	exists, _ := commonUtil.ArrayFindFirst(view.Shares, func(it *SavedViewShare) bool { return it.UserID == userId })
+	return exists
+}
+
+type SavedViewFilter struct {
+	Entity string `form:"entity"`
+}
+
+func NewSavedView(ownerId uuid.UUID, name string, entity string) SavedView {
+	return SavedView{OwnerID: ownerId, Name: name, Entity: entity}
+}
@@ -0,0 +1,420 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package model
+
+import (
+	model "assets/common/model"
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson850e307bDecodeAssetsModulesViewModel(in *jlexer.Lexer, out *SavedViewFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Entity":
+			out.Entity = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson850e307bEncodeAssetsModulesViewModel(out *jwriter.Writer, in SavedViewFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Entity\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Entity))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SavedViewFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson850e307bEncodeAssetsModulesViewModel(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SavedViewFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson850e307bEncodeAssetsModulesViewModel(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SavedViewFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson850e307bDecodeAssetsModulesViewModel(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SavedViewFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson850e307bDecodeAssetsModulesViewModel(l, v)
+}
+func easyjson850e307bDecodeAssetsModulesViewModel1(in *jlexer.Lexer, out *SavedView) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "ownerId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.OwnerID).UnmarshalText(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "entity":
+			out.Entity = string(in.String())
+		case "filter":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Filter = make(map[string][]string)
+				} else {
+					out.Filter = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v1 []string
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						in.Delim('[')
+						if v1 == nil {
+							if !in.IsDelim(']') {
+								v1 = make([]string, 0, 4)
+							} else {
+								v1 = []string{}
+							}
+						} else {
+							v1 = (v1)[:0]
+						}
+						for !in.IsDelim(']') {
+							var v2 string
+							v2 = string(in.String())
+							v1 = append(v1, v2)
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					(out.Filter)[key] = v1
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "sort":
+			if in.IsNull() {
+				in.Skip()
+				out.Sort = nil
+			} else {
+				if out.Sort == nil {
+					out.Sort = new(model.Sort)
+				}
+				(*out.Sort).UnmarshalEasyJSON(in)
+			}
+		case "columns":
+			if in.IsNull() {
+				in.Skip()
+				out.Columns = nil
+			} else {
+				in.Delim('[')
+				if out.Columns == nil {
+					if !in.IsDelim(']') {
+						out.Columns = make([]string, 0, 4)
+					} else {
+						out.Columns = []string{}
+					}
+				} else {
+					out.Columns = (out.Columns)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 string
+					v3 = string(in.String())
+					out.Columns = append(out.Columns, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "shares":
+			if in.IsNull() {
+				in.Skip()
+				out.Shares = nil
+			} else {
+				in.Delim('[')
+				if out.Shares == nil {
+					if !in.IsDelim(']') {
+						out.Shares = make([]*SavedViewShare, 0, 8)
+					} else {
+						out.Shares = []*SavedViewShare{}
+					}
+				} else {
+					out.Shares = (out.Shares)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 *SavedViewShare
+					if in.IsNull() {
+						in.Skip()
+						v4 = nil
+					} else {
+						if v4 == nil {
+							v4 = new(SavedViewShare)
+						}
+						(*v4).UnmarshalEasyJSON(in)
+					}
+					out.Shares = append(out.Shares, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "createDate":
+			if in.IsNull() {
+				in.Skip()
+				out.CreateDate = nil
+			} else {
+				if out.CreateDate == nil {
+					out.CreateDate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreateDate).UnmarshalJSON(data))
+				}
+			}
+		case "version":
+			out.Version = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson850e307bEncodeAssetsModulesViewModel1(out *jwriter.Writer, in SavedView) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if true {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"ownerId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.OwnerID).MarshalText())
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	if in.Entity != "" {
+		const prefix string = ",\"entity\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Entity))
+	}
+	if len(in.Filter) != 0 {
+		const prefix string = ",\"filter\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('{')
+			v5First := true
+			for v5Name, v5Value := range in.Filter {
+				if v5First {
+					v5First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v5Name))
+				out.RawByte(':')
+				if v5Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					for v6, v7 := range v5Value {
+						if v6 > 0 {
+							out.RawByte(',')
+						}
+						out.String(string(v7))
+					}
+					out.RawByte(']')
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if in.Sort != nil {
+		const prefix string = ",\"sort\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Sort).MarshalEasyJSON(out)
+	}
+	if len(in.Columns) != 0 {
+		const prefix string = ",\"columns\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.Columns {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Shares) != 0 {
+		const prefix string = ",\"shares\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v10, v11 := range in.Shares {
+				if v10 > 0 {
+					out.RawByte(',')
+				}
+				if v11 == nil {
+					out.RawString("null")
+				} else {
+					(*v11).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.CreateDate != nil {
+		const prefix string = ",\"createDate\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.CreateDate).MarshalJSON())
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SavedView) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson850e307bEncodeAssetsModulesViewModel1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SavedView) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson850e307bEncodeAssetsModulesViewModel1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SavedView) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson850e307bDecodeAssetsModulesViewModel1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SavedView) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson850e307bDecodeAssetsModulesViewModel1(l, v)
+}
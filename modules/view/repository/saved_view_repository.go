@@ -0,0 +1,97 @@
+package repository
+
+import (
+	commonDB "assets/common/db"
+	commonModel "assets/common/model"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/view/model"
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var savedViewRepo SavedViewRepository
+
+type SavedViewRepository interface {
+	commonRepository.Repository[model.SavedView]
+	FindAllWithPage(ctx context.Context, filter model.SavedViewFilter, page commonModel.Pageable) commonModel.Page[model.SavedView]
+}
+
+type savedViewRepository struct {
+	commonRepository.Repository[model.SavedView]
+	*commonDB.DataSource
+}
+
+func GetSavedViewRepository() SavedViewRepository {
+	if savedViewRepo != nil {
+		return savedViewRepo
+	}
+	savedViewRepo = &savedViewRepository{
+		commonRepository.NewBaseRepository[model.SavedView](
+			commonDB.GetDataSource(),
+			commonRepository.WithReadScope[model.SavedView](readableViewScope),
+			commonRepository.WithWriteScope[model.SavedView](writableViewScope),
+		),
+		commonDB.GetDataSource(),
+	}
+	return savedViewRepo
+}
+
+// FindAllWithPage returns own views of the current user and the views shared with the user
+func (repo *savedViewRepository) FindAllWithPage(ctx context.Context, filter model.SavedViewFilter, page commonModel.Pageable) commonModel.Page[model.SavedView] {
+	query := readableViewScope(ctx, repo.DataSource.Model(&model.SavedView{}))
+	if filter.Entity != "" {
+		query = query.Where("entity = ?", filter.Entity)
+	}
+
+	var total int64
+	commonUtil.Must(query.Count(&total).Error)
+
+	var result []model.SavedView
+	query = query.Preload(clause.Associations).Limit(page.Size).Offset(page.Page * page.Size)
+	query = commonRepository.OrderBy[model.SavedView](query, page.Sort, "name")
+	commonUtil.Must(query.Find(&result).Error)
+	return commonModel.NewPage[model.SavedView](result, page).WithTotal(int(total))
+}
+
+// readableViewScope restricts views to own and shared with the current user ones, views are private even for unrestricted users
+func readableViewScope(ctx context.Context, db *gorm.DB) *gorm.DB {
+	tokenInfo, ok := commonUtil.GetCurrentTokenInfo(ctx)
+	if !ok {
+		return db
+	}
+	return db.Where("owner_id = ? or id in (select view_id from saved_view_shares where user_id = ?)", tokenInfo.UserId, tokenInfo.UserId)
+}
+
+func writableViewScope(ctx context.Context, db *gorm.DB) *gorm.DB {
+	tokenInfo, ok := commonUtil.GetCurrentTokenInfo(ctx)
+	if !ok {
+		return db
+	}
+	return db.Where("owner_id = ?", tokenInfo.UserId)
+}
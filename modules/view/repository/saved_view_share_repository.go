@@ -0,0 +1,77 @@
+package repository
+
+import (
+	commonDB "assets/common/db"
+	commonRepository "assets/common/repository"
+	commonUtil "assets/common/util"
+	"assets/modules/view/model"
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var savedViewShareRepo SavedViewShareRepository
+
+type SavedViewShareRepository interface {
+	commonRepository.Repository[model.SavedViewShare]
+	ReplaceByViewId(ctx context.Context, viewId uuid.UUID, userIds []uuid.UUID) []model.SavedViewShare
+	DeleteByViewId(ctx context.Context, viewId uuid.UUID)
+}
+
+type savedViewShareRepository struct {
+	commonRepository.Repository[model.SavedViewShare]
+	*commonDB.DataSource
+}
+
+func GetSavedViewShareRepository() SavedViewShareRepository {
+	if savedViewShareRepo != nil {
+		return savedViewShareRepo
+	}
+	savedViewShareRepo = &savedViewShareRepository{
+		commonRepository.NewBaseRepository[model.SavedViewShare](commonDB.GetDataSource()),
+		commonDB.GetDataSource(),
+	}
+	return savedViewShareRepo
+}
+
+func (repo *savedViewShareRepository) ReplaceByViewId(ctx context.Context, viewId uuid.UUID, userIds []uuid.UUID) []model.SavedViewShare {
+	shares := commonUtil.Map(userIds, func(it uuid.UUID) model.SavedViewShare { return model.NewSavedViewShare(viewId, it) })
+	commonUtil.Must(repo.DataSource.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("view_id = ?", viewId).Delete(&model.SavedViewShare{}).Error; err != nil {
+			return err
+		}
+		if len(shares) == 0 {
+			return nil
+		}
+		return tx.Create(&shares).Error
+	}))
+	return shares
+}
+
+func (repo *savedViewShareRepository) DeleteByViewId(ctx context.Context, viewId uuid.UUID) {
+	commonUtil.Must(repo.DataSource.Where("view_id = ?", viewId).Delete(&model.SavedViewShare{}).Error)
+}
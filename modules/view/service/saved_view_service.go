@@ -0,0 +1,142 @@
+package service
+
+import (
+	commonCache "assets/common/cache"
+	commonError "assets/common/custom_error"
+	commonModel "assets/common/model"
+	commonUtil "assets/common/util"
+	authorizationRepository "assets/modules/authorization/repository"
+	"assets/modules/view/model"
+	"assets/modules/view/repository"
+	"context"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+/*
+ * Copyright © 2024, "DEADLINE TEAM" LLC
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are not permitted.
+ *
+ * THIS SOFTWARE IS PROVIDED BY "DEADLINE TEAM" LLC "AS IS" AND ANY
+ * EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+ * DISCLAIMED. IN NO EVENT SHALL "DEADLINE TEAM" LLC BE LIABLE FOR ANY
+ * DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES
+ * (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES;
+ * LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+ * ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+ * (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS
+ * SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+ *
+ * No reproductions or distributions of this code is permitted without
+ * written permission from "DEADLINE TEAM" LLC.
+ * Do not reverse engineer or modify this code.
+ *
+ * © "DEADLINE TEAM" LLC, All rights reserved.
+ */
+
+var savedViewSrv SavedViewService
+
+type SavedViewService interface {
+	GetById(ctx context.Context, id uuid.UUID) model.SavedView
+	GetAll(ctx context.Context, filter model.SavedViewFilter, page commonModel.Pageable) commonModel.Page[model.SavedView]
+	Create(ctx context.Context, view model.SavedView) model.SavedView
+	Update(ctx context.Context, view model.SavedView) model.SavedView
+	DeleteById(ctx context.Context, id uuid.UUID)
+	UpdateShares(ctx context.Context, id uuid.UUID, userIds []uuid.UUID) model.SavedView
+}
+
+type savedViewService struct {
+	repository      repository.SavedViewRepository
+	shareRepository repository.SavedViewShareRepository
+	userRepository  authorizationRepository.UserRepository
+	cache           *commonCache.Cache[commonModel.Page[model.SavedView]]
+}
+
+func GetSavedViewService() SavedViewService {
+	if savedViewSrv != nil {
+		return savedViewSrv
+	}
+
+	savedViewSrv = &savedViewService{
+		repository:      repository.GetSavedViewRepository(),
+		shareRepository: repository.GetSavedViewShareRepository(),
+		userRepository:  authorizationRepository.GetUserRepository(),
+		cache:           commonCache.NewCache[commonModel.Page[model.SavedView]]("savedViews", 24*time.Hour),
+	}
+
+	return savedViewSrv
+}
+
+func (service *savedViewService) GetById(ctx context.Context, id uuid.UUID) model.SavedView {
+	result := service.repository.GetById(ctx, []uuid.UUID{id})
+	if len(result) == 0 {
+		panic(commonError.NotFoundError)
+	}
+	return result[0]
+}
+
+func (service *savedViewService) GetAll(ctx context.Context, filter model.SavedViewFilter, page commonModel.Pageable) commonModel.Page[model.SavedView] {
+	result, err := service.cache.Get(ctx)
+	if err == nil {
+		return result
+	}
+	return service.cache.Set(ctx, service.repository.FindAllWithPage(ctx, filter, page))
+}
+
+func (service *savedViewService) Create(ctx context.Context, view model.SavedView) model.SavedView {
+	if !view.IsValid() {
+		panic(commonError.IllegalArgumentError)
+	}
+	view.OwnerID = commonUtil.MustGetCurrentTokenInfo(ctx).UserId
+	view.Name = strings.TrimSpace(view.Name)
+	// shares are managed by UpdateShares only
+	view.Shares = nil
+	defer service.cache.Evict(ctx)
+	return service.repository.Create(ctx, []model.SavedView{view})[0]
+}
+
+func (service *savedViewService) Update(ctx context.Context, view model.SavedView) model.SavedView {
+	if !view.IsValid() {
+		panic(commonError.IllegalArgumentError)
+	}
+	stored := service.GetById(ctx, view.ID)
+	service.checkOwner(ctx, stored)
+	view.OwnerID, view.CreateDate = stored.OwnerID, stored.CreateDate
+	view.Name = strings.TrimSpace(view.Name)
+	view.Shares = nil
+	defer service.cache.Evict(ctx)
+	return service.repository.Update(ctx, []model.SavedView{view})[0]
+}
+
+func (service *savedViewService) DeleteById(ctx context.Context, id uuid.UUID) {
+	service.checkOwner(ctx, service.GetById(ctx, id))
+	defer service.cache.Evict(ctx)
+	service.shareRepository.DeleteByViewId(ctx, id)
+	service.repository.DeleteById(ctx, id)
+}
+
+// UpdateShares replaces the users the view is shared with, they can read the view but not change it
+func (service *savedViewService) UpdateShares(ctx context.Context, id uuid.UUID, userIds []uuid.UUID) model.SavedView {
+	view := service.GetById(ctx, id)
+	service.checkOwner(ctx, view)
+
+	userIds = commonUtil.ArrayFilter(commonUtil.Unique(userIds), func(it uuid.UUID) bool { return it != view.OwnerID })
+	if len(userIds) != 0 && len(service.userRepository.GetById(ctx, userIds)) != len(userIds) {
+		panic(commonError.NotFoundError)
+	}
+
+	defer service.cache.Evict(ctx)
+	service.shareRepository.ReplaceByViewId(ctx, id, userIds)
+	return service.GetById(ctx, id)
+}
+
+// checkOwner allows to change the view only to its owner, the users the view is shared with may only read it
+func (service *savedViewService) checkOwner(ctx context.Context, view model.SavedView) {
+	if tokenInfo, ok := commonUtil.GetCurrentTokenInfo(ctx); ok && view.OwnerID != tokenInfo.UserId {
+		panic(commonError.NotEnoughRightsError)
+	}
+}